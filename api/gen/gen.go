@@ -857,6 +857,15 @@ type DeleteMySessionsCount struct {
 // largest representable duration to approximately 290 years.
 type Duration = int64
 
+// Evaluation defines model for Evaluation.
+type Evaluation struct {
+	// Fen Evaluated position FEN
+	Fen string `json:"fen"`
+
+	// ScoreCp Score in centipawns from the side to move point of view
+	ScoreCp int32 `json:"score_cp"`
+}
+
 // FollowUserBody defines model for FollowUserBody.
 type FollowUserBody struct {
 	UserID openapi_types.UUID `json:"user_id"`
@@ -874,6 +883,9 @@ type FriendRequestStatus string
 
 // Game defines model for Game.
 type Game struct {
+	// BlackBotLevel Level of the built-in bot playing black
+	BlackBotLevel *int32 `json:"black_bot_level,omitempty"`
+
 	// BlackGameClock Black game clock
 	BlackGameClock int64 `json:"black_game_clock"`
 
@@ -889,6 +901,9 @@ type Game struct {
 	// CreatedAt Created at timestamp
 	CreatedAt time.Time `json:"created_at"`
 
+	// Eco ECO code of the opening
+	Eco *string `json:"eco,omitempty"`
+
 	// EndTime End time
 	EndTime *time.Time `json:"end_time,omitempty"`
 
@@ -923,6 +938,12 @@ type Game struct {
 	LastMove *time.Time  `json:"last_move,omitempty"`
 	Moves    *[]GameMove `json:"moves,omitempty"`
 
+	// OpeningName Opening name
+	OpeningName *string `json:"opening_name,omitempty"`
+
+	// OpeningVariation Opening variation
+	OpeningVariation *string `json:"opening_variation,omitempty"`
+
 	// Pgn game pgn
 	Pgn *string `json:"pgn,omitempty"`
 
@@ -944,6 +965,9 @@ type Game struct {
 	// UpdatedAt Updated at timestamp
 	UpdatedAt time.Time `json:"updated_at"`
 
+	// WhiteBotLevel Level of the built-in bot playing white
+	WhiteBotLevel *int32 `json:"white_bot_level,omitempty"`
+
 	// WhiteGameClock White game clock
 	WhiteGameClock int64 `json:"white_game_clock"`
 
@@ -2015,6 +2039,12 @@ type UnauthorizedErrorResponse = APIError
 // UnexpectedErrorResponse defines model for UnexpectedErrorResponse.
 type UnexpectedErrorResponse = APIError
 
+// GetAnalysisEvalParams defines parameters for GetAnalysisEval.
+type GetAnalysisEvalParams struct {
+	// Fen Position FEN
+	Fen string `form:"fen" json:"fen"`
+}
+
 // ListCourierMessagesParams defines parameters for ListCourierMessages.
 type ListCourierMessagesParams struct {
 	// PageSize This is the number of items per page to return.
@@ -2130,6 +2160,12 @@ type ListGamesParams struct {
 	// Rated Filter games by rated flag
 	Rated *bool `form:"rated,omitempty" json:"rated,omitempty"`
 
+	// Eco Filter games by ECO opening codes (e.g. B90)
+	Eco *[]string `form:"eco,omitempty" json:"eco,omitempty"`
+
+	// OpeningName Filter games by opening names (e.g. Sicilian Defense)
+	OpeningName *[]string `form:"opening_name,omitempty" json:"opening_name,omitempty"`
+
 	// CreatedAtFrom Filter games by from timestamp
 	CreatedAtFrom *time.Time `form:"created_at_from,omitempty" json:"created_at_from,omitempty"`
 
@@ -2155,6 +2191,21 @@ type GetGameParams struct {
 // GetGameParamsEmbed defines parameters for GetGame.
 type GetGameParamsEmbed string
 
+// GetGameAnimationGifParams defines parameters for GetGameAnimationGif.
+type GetGameAnimationGifParams struct {
+	// Flip Whether to draw the board from the black side
+	Flip *bool `form:"flip,omitempty" json:"flip,omitempty"`
+}
+
+// GetGamePositionSvgParams defines parameters for GetGamePositionSvg.
+type GetGamePositionSvgParams struct {
+	// Ply Position after the ply, 0 is the start position (defaults to the current position)
+	Ply *int32 `form:"ply,omitempty" json:"ply,omitempty"`
+
+	// Flip Whether to draw the board from the black side
+	Flip *bool `form:"flip,omitempty" json:"flip,omitempty"`
+}
+
 // ListIdentitiesParams defines parameters for ListIdentities.
 type ListIdentitiesParams struct {
 	// PageSize Page Size
@@ -2414,6 +2465,9 @@ type CreateRecoveryLinkForIdentityJSONRequestBody = CreateRecoveryLinkForIdentit
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// GetAnalysisEval Evaluate position
+	// (GET /analysis/eval)
+	GetAnalysisEval(w http.ResponseWriter, r *http.Request, params GetAnalysisEvalParams)
 	// ListCourierMessages List Messages
 	// (GET /courier/messages)
 	ListCourierMessages(w http.ResponseWriter, r *http.Request, params ListCourierMessagesParams)
@@ -2438,6 +2492,12 @@ type ServerInterface interface {
 	// GetGame Get game
 	// (GET /games/{id})
 	GetGame(w http.ResponseWriter, r *http.Request, id int64, params GetGameParams)
+	// GetGameAnimationGif Get game animation
+	// (GET /games/{id}/animation.gif)
+	GetGameAnimationGif(w http.ResponseWriter, r *http.Request, id int64, params GetGameAnimationGifParams)
+	// GetGamePositionSvg Get game position image
+	// (GET /games/{id}/position.svg)
+	GetGamePositionSvg(w http.ResponseWriter, r *http.Request, id int64, params GetGamePositionSvgParams)
 	// GetHealthAlive Check if server is healthy
 	// (GET /health/alive)
 	GetHealthAlive(w http.ResponseWriter, r *http.Request)
@@ -2572,6 +2632,39 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// GetAnalysisEval operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalysisEval(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnalysisEvalParams
+
+	// ------------- Required query parameter "fen" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "fen", r.URL.Query(), &params.Fen, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "fen"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fen", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAnalysisEval(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListCourierMessages operation middleware
 func (siw *ServerInterfaceWrapper) ListCourierMessages(w http.ResponseWriter, r *http.Request) {
 
@@ -3132,6 +3225,32 @@ func (siw *ServerInterfaceWrapper) ListGames(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// ------------- Optional query parameter "eco" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "eco", r.URL.Query(), &params.Eco, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "eco"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eco", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "opening_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "opening_name", r.URL.Query(), &params.OpeningName, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "opening_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "opening_name", Err: err})
+		}
+		return
+	}
+
 	// ------------- Optional query parameter "created_at_from" -------------
 
 	err = runtime.BindQueryParameterWithOptions("form", true, false, "created_at_from", r.URL.Query(), &params.CreatedAtFrom, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
@@ -3237,6 +3356,103 @@ func (siw *ServerInterfaceWrapper) GetGame(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
+// GetGameAnimationGif operation middleware
+func (siw *ServerInterfaceWrapper) GetGameAnimationGif(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int64", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGameAnimationGifParams
+
+	// ------------- Optional query parameter "flip" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "flip", r.URL.Query(), &params.Flip, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "flip"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flip", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGameAnimationGif(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetGamePositionSvg operation middleware
+func (siw *ServerInterfaceWrapper) GetGamePositionSvg(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int64", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGamePositionSvgParams
+
+	// ------------- Optional query parameter "ply" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "ply", r.URL.Query(), &params.Ply, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "ply"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ply", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "flip" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "flip", r.URL.Query(), &params.Flip, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "flip"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flip", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGamePositionSvg(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetHealthAlive operation middleware
 func (siw *ServerInterfaceWrapper) GetHealthAlive(w http.ResponseWriter, r *http.Request) {
 
@@ -4866,7 +5082,10 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/quick-games", wrapper.ListQuickGames)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games", wrapper.ListGames)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{id}", wrapper.GetGame)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{id}/position.svg", wrapper.GetGamePositionSvg)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/games/{id}/animation.gif", wrapper.GetGameAnimationGif)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/game-stats/{user_id}", wrapper.GetGameStats)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/analysis/eval", wrapper.GetAnalysisEval)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/me/friend-requests", wrapper.ListFriendRequests)
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/me/friend-requests", wrapper.CreateFriendRequest)
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/me/friend-requests/{id}/accept", wrapper.AcceptFriendRequest)
@@ -4923,6 +5142,61 @@ type UnauthorizedErrorResponseJSONResponse APIError
 
 type UnexpectedErrorResponseJSONResponse APIError
 
+type GetAnalysisEvalRequestObject struct {
+	Params GetAnalysisEvalParams
+}
+
+type GetAnalysisEvalResponseObject interface {
+	VisitGetAnalysisEvalResponse(w http.ResponseWriter) error
+}
+
+type GetAnalysisEval200JSONResponse Evaluation
+
+func (response GetAnalysisEval200JSONResponse) VisitGetAnalysisEvalResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetAnalysisEval400JSONResponse struct {
+	GenericErrorResponseJSONResponse
+}
+
+func (response GetAnalysisEval400JSONResponse) VisitGetAnalysisEvalResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetAnalysisEvaldefaultJSONResponse struct {
+	Body       APIError
+	StatusCode int
+}
+
+func (response GetAnalysisEvaldefaultJSONResponse) VisitGetAnalysisEvalResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type ListCourierMessagesRequestObject struct {
 	Params ListCourierMessagesParams
 }
@@ -5569,6 +5843,162 @@ func (response GetGamedefaultJSONResponse) VisitGetGameResponse(w http.ResponseW
 	return err
 }
 
+type GetGameAnimationGifRequestObject struct {
+	ID     int64 `json:"id"`
+	Params GetGameAnimationGifParams
+}
+
+type GetGameAnimationGifResponseObject interface {
+	VisitGetGameAnimationGifResponse(w http.ResponseWriter) error
+}
+
+type GetGameAnimationGif200ImageGifResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetGameAnimationGif200ImageGifResponse) VisitGetGameAnimationGifResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "image/gif")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetGameAnimationGif400JSONResponse struct {
+	GenericErrorResponseJSONResponse
+}
+
+func (response GetGameAnimationGif400JSONResponse) VisitGetGameAnimationGifResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetGameAnimationGif404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response GetGameAnimationGif404JSONResponse) VisitGetGameAnimationGifResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetGameAnimationGifdefaultJSONResponse struct {
+	Body       APIError
+	StatusCode int
+}
+
+func (response GetGameAnimationGifdefaultJSONResponse) VisitGetGameAnimationGifResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetGamePositionSvgRequestObject struct {
+	ID     int64 `json:"id"`
+	Params GetGamePositionSvgParams
+}
+
+type GetGamePositionSvgResponseObject interface {
+	VisitGetGamePositionSvgResponse(w http.ResponseWriter) error
+}
+
+type GetGamePositionSvg200ImageSvgXMLResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetGamePositionSvg200ImageSvgXMLResponse) VisitGetGamePositionSvgResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "image/svg+xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetGamePositionSvg400JSONResponse struct {
+	GenericErrorResponseJSONResponse
+}
+
+func (response GetGamePositionSvg400JSONResponse) VisitGetGamePositionSvgResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetGamePositionSvg404JSONResponse struct {
+	NotFoundErrorResponseJSONResponse
+}
+
+func (response GetGamePositionSvg404JSONResponse) VisitGetGamePositionSvgResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetGamePositionSvgdefaultJSONResponse struct {
+	Body       APIError
+	StatusCode int
+}
+
+func (response GetGamePositionSvgdefaultJSONResponse) VisitGetGamePositionSvgResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
 type GetHealthAliveRequestObject struct {
 }
 
@@ -9020,6 +9450,9 @@ func (response ExtendSessiondefaultJSONResponse) VisitExtendSessionResponse(w ht
 
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// GetAnalysisEval Evaluate position
+	// (GET /analysis/eval)
+	GetAnalysisEval(ctx context.Context, request GetAnalysisEvalRequestObject) (GetAnalysisEvalResponseObject, error)
 	// ListCourierMessages List Messages
 	// (GET /courier/messages)
 	ListCourierMessages(ctx context.Context, request ListCourierMessagesRequestObject) (ListCourierMessagesResponseObject, error)
@@ -9044,6 +9477,12 @@ type StrictServerInterface interface {
 	// GetGame Get game
 	// (GET /games/{id})
 	GetGame(ctx context.Context, request GetGameRequestObject) (GetGameResponseObject, error)
+	// GetGameAnimationGif Get game animation
+	// (GET /games/{id}/animation.gif)
+	GetGameAnimationGif(ctx context.Context, request GetGameAnimationGifRequestObject) (GetGameAnimationGifResponseObject, error)
+	// GetGamePositionSvg Get game position image
+	// (GET /games/{id}/position.svg)
+	GetGamePositionSvg(ctx context.Context, request GetGamePositionSvgRequestObject) (GetGamePositionSvgResponseObject, error)
 	// GetHealthAlive Check if server is healthy
 	// (GET /health/alive)
 	GetHealthAlive(ctx context.Context, request GetHealthAliveRequestObject) (GetHealthAliveResponseObject, error)
@@ -9208,6 +9647,32 @@ type strictHandler struct {
 	options     StrictHTTPServerOptions
 }
 
+// GetAnalysisEval operation middleware
+func (sh *strictHandler) GetAnalysisEval(w http.ResponseWriter, r *http.Request, params GetAnalysisEvalParams) {
+	var request GetAnalysisEvalRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalysisEval(ctx, request.(GetAnalysisEvalRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalysisEval")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAnalysisEvalResponseObject); ok {
+		if err := validResponse.VisitGetAnalysisEvalResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ListCourierMessages operation middleware
 func (sh *strictHandler) ListCourierMessages(w http.ResponseWriter, r *http.Request, params ListCourierMessagesParams) {
 	var request ListCourierMessagesRequestObject
@@ -9418,6 +9883,60 @@ func (sh *strictHandler) GetGame(w http.ResponseWriter, r *http.Request, id int6
 	}
 }
 
+// GetGameAnimationGif operation middleware
+func (sh *strictHandler) GetGameAnimationGif(w http.ResponseWriter, r *http.Request, id int64, params GetGameAnimationGifParams) {
+	var request GetGameAnimationGifRequestObject
+
+	request.ID = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGameAnimationGif(ctx, request.(GetGameAnimationGifRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGameAnimationGif")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetGameAnimationGifResponseObject); ok {
+		if err := validResponse.VisitGetGameAnimationGifResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetGamePositionSvg operation middleware
+func (sh *strictHandler) GetGamePositionSvg(w http.ResponseWriter, r *http.Request, id int64, params GetGamePositionSvgParams) {
+	var request GetGamePositionSvgRequestObject
+
+	request.ID = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGamePositionSvg(ctx, request.(GetGamePositionSvgRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGamePositionSvg")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetGamePositionSvgResponseObject); ok {
+		if err := validResponse.VisitGetGamePositionSvgResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetHealthAlive operation middleware
 func (sh *strictHandler) GetHealthAlive(w http.ResponseWriter, r *http.Request) {
 	var request GetHealthAliveRequestObject
@@ -10590,290 +11109,299 @@ func (sh *strictHandler) ExtendSession(w http.ResponseWriter, r *http.Request, i
 // const string: with thousands of chunks the chained `+` fold is several
 // times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-	"7L1pcxq71jD6V1Scp+rE9zUY4yF2qt56LgZj4xEDnhJysegWINMttVtqMN4n//2Whh4ANUPixN77+Hw4",
-	"O6Y1LElr1tJaf2Us6nqUIMJZ5stfGQ/60EUc+fKvGuxhAjmmpAZ7SPxiI2b52BM/Zb6I7wiQwO0gH3za",
-	"zHYgQ/ZaZj2DxcenAPnjzHqGQBdlvmQ8McJ6hll95EIx1P/4qJv5kvnXRgzBhvrKNqYm/vFjfQqWBn4x",
-	"wHOhQKFdgDlyGfCQD/S8aSC1mRjp5+CSQPwQwPmIeZQwJHft0PX4uK5/mQVSfgZRDwB9BBgiHIz6iKyD",
-	"LvUBeoau56B10YoGvqVb2chBHNk50OwjcNxs1gDjkAcMWNRGquPU2JgBPvawBR1nDAr5TUB9UMhvZ36s",
-	"Z44QQT62Dn2f+klgLUo4Ilz8E3qegy253I1HJoD/a8l9KtaqcmC1O5PrL9aqAKmP65kLyis0IPafB+OC",
-	"ctAVUwswrgkMeB8RLuZBbwTNBAgxVNTHL28JkppfwYOePWS9yQbFc4fI8yMkWkl0UV/BxHzqIZ9jRY6C",
-	"OAxUKBqDgOCnAEn6yaxn+NgTbIFxH5NeRoLAIXbYbO9L3ke+AgSEjX6sZ1zEmJFRqtnCz4aZfAT1Vpk6",
-	"qq8g+ckwhmIGbfN6p9lFZj3Tpb4LeeZLBhO+VYhHxISjHlI77KOnAPvIznz5ltHdktPES/4edaedR2Rx",
-	"AVDRtn3EmOFIMB/PglgSvxrWZdGAcH+8CHtKupno4SNBQ23IDbOobwBywLGLGIeul9wMG3KUFV9MoGDb",
-	"MKCaF2B7ak93tw17up5xFFTzlnIGOeaBjWRzhRZzm1PSi9oTyg3H36AuAph0KYAdGnAA1dEA3AUEIRvZ",
-	"ptV6lHHopCBUTX5MJR0f9bAJoevqdyP6+gjxNoyRZmoJ8nsI+ZwRlEKSOoD+bOgfEJze+5rguX09OxXl",
-	"rtW3n0G5KRKUODa1UeuKnGI6mcD/CciMRBpLHeoXGQt8SCx0hobImV2JUDxgsgOAYQ/giC7AggR0EKAE",
-	"CTWslYHQ2Wxl1tW/CuJf1Fd/bLUyOVAEfdwTvFRrkS6ChAHehxxgLtSXPvRt5LeI0G4gAZBzaA2QDzgF",
-	"ghJ86mKGABdwWXIDci3SIlK5EUrPegQCwK7nYKQHF/AlFoIpAV1oiQWNIAMBQzYY9bGDQLF4VpjsykdU",
-	"t2XgE8r1ci3iQcZG1LfB/wHNy2ZtDfThEIEOQkQOJUFqUuAg6BPgUh9pKuR9xPTGMeA5CDIE+gjagA7l",
-	"Er+APuce+7KxMRqNctQf51h/Y+BDTtmGTS22YVFiIY+zDctHtlgLdARCIBK4Al0gdPKZdbkB6j8F9Z8t",
-	"iQmYOwIVJhAARBgAJAqAT8Xi2ZoJ4Q8gt/o18X9VObPg7elKr2wIcNQy0lEz61OyIW5jRj9PjhSruAIx",
-	"xPFjYuMhtgPoJKbJCc1fmAKLWKhew1jCGS3jR7Ru6PtwLMlxhoAOHGoNrhnyD6g9npV1AUN+W8mNiOCD",
-	"ANsLaT3saCLaEiUcWnx2tgT3nKtp6Wa/U1YiF2IDCznMnovfTeKmT4kJdcTP74PzKgjDpa1Hu20+okhr",
-	"mVJ+3rNyghltQ8frw0L6WAXgIM6RD0TjUA2Ez2eI9Hg/86WwnnExSfw1C2g4yVb6JFvzJ9mamGQrZRIS",
-	"uMLGTZ9FN/jpSZQjIXV0aD6oP4ixGoTEsU5s/+Q2raY4lGjgY+SfKwOgIa2C2fUUgW7wbwZ0m1g+PQUo",
-	"kKonE7ajlAQWYkysZj0DO5DYlKAkB4z3cHL2JnI9B3LUlM1mxIb+CuQo8fQ+soSgHbcxGUJH7lf008wP",
-	"Aj1MDeXv4a9D5OOuVigSjSd+Nv44Nfrst/AL40EnI6yCHp76IjRuxv2ZPkvsnnHXqlwqcwhLW7eDwINk",
-	"ew9CgXuQnPAhsZchS1QsMqleJBCgqfZ/FhyJdloomcXoXJMxQaWb6QZk+zUY5M+bVwuAXMHYWjDSQtNr",
-	"Qf+VDbHlxlvWLFsw2gpG2tyRprjlAqMqVRGTmKuRyIy5HyL19UXqKie7kghMP+OKjxGx6+gpQIz/SVVf",
-	"zh7aJ+HEJrURhI2AbLU+q3dGJuKS1tAt5v1Sopt0cXJoQw7b0HYxMVGzMG/DVrGdKwxhX9jUwgxkgBJn",
-	"LCx2Ie07jjDgfRr0+kCOCoq1KgMssPoAMvBwdNgEG7FNt9EK8vktC9vyv+ghNwGWF3QcE3qmwoXDTVOw",
-	"TfyEOUNOV4pBhpC8oAEWdBxMesl7GtGpJYxRhikBiNgexYTnQJkCQjlgcmqGCMMcD5WcENghGksHAhBH",
-	"gzlgFvXRmgk2TADvYwa6GDm2XDD1e5DgF61p2ItO9CJwnOvralnJB626aGZnsrTruk0xbAIsYXJiwgB0",
-	"HOVviT5Jt4h2/EjXCadATwIgiVYh3SDX0luDGWDcDywe+Ei0xq5HfR52GifG1n6faAxQU26SAUKe2BYX",
-	"E7tFlGMmBkpKXSZG7iDgI89HQsNEttrIBKU05P4A6UMI9xeMsONIJ9YQ+SMfc45Ii1DVk6BnHp+LUpGX",
-	"9jKEuxpOnzDDJ/0M4dWGUWVRMFfL8nZPLKYMaFf+66RxeREuSa1dHofYQ6kLQi4wN4lZ/2aA+xBz6Skx",
-	"3iiYtB3xczh7YiTZPNci0JJ4LpsV5b9bBE/8WtV/JX1V4Q9hS6PiqoA1qPjy9/iokzgTr1FenMZExQCU",
-	"vIcCZfesA5fauDteB5DY+rZVdxULABAIdpBlyB9iCwEXEoJ8PSjxAq4QBzojOGZi8/WeC2uuJ0iHzxyS",
-	"jbqYIFuO/qCPPPCdh8RpxJJAGQUC5HmUexO1Wpl21QTIBp1xxLIXE23U6x9KtPGGLiTbaZ0youEIcdMl",
-	"fMgbStRGFeovJfDDPqAUBgBEe6QVFbMqgJ497CPWNglwOdShagCqRLqu+yhmzTLWQG62GgXArtAp5WFC",
-	"V6hpkhthF4E+ZMCDjCE7B8qoCwOHy+MVR2JR0sW9QJmrglICBGi3RR4EiWkKy7mI96nNcmLOnOqRc3AX",
-	"MQ8SSSMeFAqtAPv/+/Qtn93//n8+EfafgP3HZf9h/3H/019b+3/+x8TZug4dtbm2e+edfgM53YYCp+LQ",
-	"kbRgpa9N7bORQUeHwGl4ROE2JnhStQzGNAAjzPoJgZlLmqFLKY9JUBaj1xkmg5XRS3R6JfSSQ81BL0d8",
-	"f4foZcau/zH7Yf9muBFzuFjZn2vohED8ZXBj+JR2l2ep8YQ12VGzVGU4h9x5VguYuZTRzqal1hOLQy4x",
-	"T2FyR3yRe63+hiCWtyC2nYySWWNfGIAifxHKg7JaMUrxaWGbpVm6GDGAlTBUekhSWi3hpporl8S8343L",
-	"EDMpQ3cVkFWPt4L3fNxQlheTWzcLnmpmA90MqGYz5rG5syBMEoUVahtPq00j5Av8GdIBsnPLuA9N14dl",
-	"zaRMrvPwW6wNKZxFDvSknSXYYAfxEUJE3ksLJRMSoa5CJjUwAQggkFCGLEpsoK7IgWK5ekw1hYNdrIZv",
-	"EQf6PUEDcRNBBHYIDacAep5Pn7ELOXLGoLCfB2MEfZZbzolaoY5DR3/4znTCgWPgafLzcrOGQVBS2Gn7",
-	"xUPE1hcXloU8Ln2+NrIcnHaF8VtWeaTdZ5OL6zjQGrR70EVty6HWYBbZDkQLIFoA1WKpg9Tjih01Srvb",
-	"PhaWor1YcIVjvdIoTAFlGgvJ+4yRGpMB2U6aOvFwHUodBMnvvR4ndlt+m70hJ4qylx6qiwzco4sI0E1M",
-	"XbDPeNulQySBoAFvuybOLpoB0QzoZsp8cxysGApbDk8k5vmIBY4ZT+ry09KXMMnhdDjinFF11ONKg0tX",
-	"htn7opwfqwwmtq5tQY56NOUWqikY+QBLIbrqwKLbaw46hD6GxHxOgrsA3WD5mILXGsiBGmcNZgXUeLo0",
-	"0YjGk2rmPI1VwHsuhje46ryegfwkJxVfTBd0MsA7lTXJrpgB1czElIQ9QAiy+FzirYetfo12GYc+T2FV",
-	"DfFtNWaliIES7lNHCSMj8BJ5dbNVJNLE8JhYPnIR4YuniJouN81vCuFYz0i5NFdSK2m4qqTW476KpFZj",
-	"vdIorySpTcEv0+zMxDXTWPS0HJiBd0bVSEPteTiZQspp8tmAHuuzul3IYJRisFpQT8TnZgMw+siMjeqU",
-	"MJeRsxCodiauZVRTKocXc9QUuapU+fHLAkgqNsuO4jlwHFH8csTMoOmGtHgRSqpZk8DCBn5SqqZ0SEV6",
-	"HJ++GFJBsq7PMO3clbr0xgGL8lz81dRBc8iAHElayCFdv6OAvFVpUp1NHGT3cULv7YTE2cyejO3D0bQL",
-	"zPjWal3+0/cDT+uGS/RwKGNLNuWUQ2fJtiPlt1/1eZjopmFaV+ueXNO8jUPvAafZSpbdHJRWA/29UdnA",
-	"ZaDjLGMmSUKQnhjMX1bqEDgO4qv0sBzIGLbgSnD1xx7yV5/Lh97iMJu4w9RxJCeNVhruUTh4ckHrcrvT",
-	"TkiYLiXNM98D6Uwy8b+7VBADe8hXRoH0i7cZsthSoMqe6jfZ8yfe2f4K6QrEOMXE/oNIgQjsOPP8GVAZ",
-	"qzx2RzHAAs+jvpjzk+6/ZrQZ5mPcSs6tRdgmB3s/TDvc1tVx4EZZvO8QBSKH3ysgwKrOwznHHw71dz/8",
-	"YwQd3h+bJDdWJv0Cr4lqZxq6Wl7yIjeMbzBcZxLwLYxH+P7J8LBVvmjVr1ujh61hj2zAkJ91qY2cteR1",
-	"KASf+oELyZoKMMYEXPrjnCn4eRHqFyVeQtBHjmDhKtRNB5d1qQ96tBN0u9ChOY96uaVpYyrsGto2FvPK",
-	"gIcEgMuEY0+FYs+sIvw4sT+Ok4heMMTqyli9xEtoGSCKWRy8Zwp4MEa2lA0hoTrBhvqli3U8YTMZzVct",
-	"S3gI5QImqw9JT9ATsSd/pgyRHGgK0BBhgY8Y6AY88FGLiC2DHHewI8YTPanHsaujo+UKbSxOpBMIUpXx",
-	"2HF8eYlaA59Cq18+yC3jt5yNgF8UeH3CKKnDkX4HlRKuvvogf8cQ8NeOlF7PPGepK4b0BM/hfoDeTfB0",
-	"FM6bBsV1/SwEQ/xTwxG+HwCjPvKReUKgBg93vIu41Rew+tSVBKaQ+AsQk79eXLcgXMx0NF0fMmAFvo8I",
-	"d8aAUIC6XWTx3xv+rdzxmke0FydSETgvVOLJ0PFleK0KKF9S+v8m0fE2Ad+vHxdtIlJjYpNEvHSCeiaC",
-	"p3WgXyQ9kqJOyn5wKjWICH9N4sskUE1qgVGgAuYhC3exlZCr4cvi6ZAy0sW9Rfso+M4kY387ZSWW0ywt",
-	"dFV+nNwQBzMZlTsj7JlSJab2CbhQsKvJ5BwLYjzDv2fucMV4NhY/uZhAjlgUD2fjbhcJ9iQnZQLAhCKU",
-	"zNqSOGkxXk3/3iIU29b018tqudQinHJv+kvzsllrEYfSQeC1GbJ8xKebnMmPLTJCHaFzkenvt6hTFL8L",
-	"ncZG019L1EbiswJ9gMYmyAdo3CKeT7vYmRmgpn5uEQZdZ/pjo3h+1iIOJoN2FI091SQZRD73G47oBDoz",
-	"xy+mUBqC4Dky4Fu+W0e9MfgUTd116GgtB0BVYrpQA1VWHsz7+j1IUq1VtK0f7OXU9i1cRkk9T40i+PS5",
-	"Z9Yz4uAz0mvuSZ924kgz65nw9OTzYDmEPg/FAcQWyys3V/CuiQ3VPeK/v/+cufn7BA4zhqLeqA/AR11J",
-	"1erodPNQcUkESYNrhrqBo19MCnEd6k6KJ2r95aeDZifS9ZiyDY0dCtVWyHxBYvaEGppiHi7MMDf7FPeH",
-	"fCRg9c0BWAn9EjMFSWT/qDxGShGlnjIJc6DaDcULstdDBTV82uQjHvgkehDVImEWpHXAqNhZFrjieMIJ",
-	"i7WqlPQW9X2kEmDIuF/VSZKSGkdBttTrgvlHkZ4AKvyi7E3AMOk5ibd4EoKZYxGaoAkdZRoyKzLu1NO0",
-	"UCrLoVpEHSkoymb6QQvQ/500cFtEJXJUTVXGRaD+g4kt03GGqb+ic+tC7Kig75B7aBRaz6j0lCbCRmGC",
-	"ynlINvGWIPGaxLwLOGFFexrrJ/BtGYt2NQwGMdbhrqAqgaGQxXgbvtd7DaxqRIlDp9AJjpIGW2bhECWl",
-	"EZuSRhRJ7IxIGoFxl9kcZfO3ChkHTDcSUzE8gM6CocL1Lt5EZnqMpRQ30xRs1dRp0/ts0OGmjKt//ive",
-	"xJpnzLS0jEkpDYEbMC71IPRsIWSDzV0hWX1occHzYZg1kWmtysX6YYemxsbV2Rw8TMv4sDDVhNjjqnoI",
-	"XJrIQThJMVKh+rmsE5ei64/1WD37uWFCrV7FZbnOTw7TEF3nUpsJ/FW2sUGl1tzAPQKqZO6uLmdgzgGs",
-	"pAZYdT2laN5JeDyfDrFtNB6LkZV46SFSLQvuKmOTa1GfFflNKlzhiMulb1x+uNc5RFBKPk2dJZSEU3cV",
-	"j66XCqVgbFN7HjYWTFPaX4JBsEDZUJwK9T2QKeMZdRGX2rODBwg89CjtOUilAOth3g86D2bfpxrLDEw4",
-	"0acHFnQe1kKROQWjDovFlMTgiGaykzZ1QmFbBk06QMQISsBQGwactsVCDU6NLmCIr884KqDj0BGLM9dw",
-	"Cpg4TExAwEJjJm1bhWZNAy5GjpzG4aplZG9ucQRzuIWJo/2+PPrWEsxyaaStGRwir8tzwhl+gu9Mdf3V",
-	"dS0gwz5kfWS3vdRtFIisGoHIj4QJ+FY7LgGl7y6843QhgT2UTWQ2Uhk11FWnTmfMEt//pSbMhhOyNbM2",
-	"Pw/mJLCeA4VyIJNjdAGhckFSoRpC7AgNJJWiwmHaLu7plIN9SlPpS3oM/ACta8NAwxAatmoQZHcTtBU1",
-	"imYAYoYUylkWixpa8pv9BXFSk0bx/AxYv4sMBBQ/QQKJbqlLoF0Fu5cQqz8hpOUYryWaY7hfRTQbhpu3",
-	"HZFjYGJf/pDgnZgzTdyuLEG1oz0cQotBhbMJsZm4rZPGQwcB7LqBes2t3RmqvkxuYXTKkuJo6jLjy1/R",
-	"jc3kl8kLhEdGSS7xUb2up/6AgRFyHOWpEgOsCzNGGXaNEez11HXVLBSMkhTXYFGOo9KR29QKXGlmstCG",
-	"A50xqFdKYHc/X5hBka5PXdOBRDmIMAsvv3v67ZIAXAyhGFhLvjpsZeQCAoaYMiNrVMZJC+NOtpM+wrM4",
-	"Wfw3lREu2ZbF0sWGHHJfZsXPYcS7Oer3hJzZ6HPX2fC71u5+fvNfTCFFdmdNOq1U4jomTnZDxhyZ/FXU",
-	"M6NfvCB1T+4hX4g8ZOfAZZT337ZV2n8fqTWrf3sOtPQf8c8W9cZRZQCOGG9lkp41aKv0tvoNjB4ko55w",
-	"So+6J2/5EJNhUcm1hW2/m71e/dQU8/3Qx82h30Nc/iS92vrIvHd6ZJLazatSjCAR2ZC4SolOlP3ZhXQp",
-	"7UA/832a21Avow/o+zzyLmsCnkvmYaMws8uywixmIgZPlvh4izqnyBht1zOfQEt8amXAJ+j0qI95310D",
-	"UcG1+NpUWR1RGyA2ndgqFKVFgoTnHgzQOAdAdLqa/bA+DRw7kblZpYZGfuLqoFq8KIKW5MrgFnWkpQpl",
-	"ZjfBmw6J5Y8l6KAYwsFaGT2QP24RxIQYwVIB7ozBt5Pb4ndBwB0EYIhqMtIgikQAJeo4mAlEaJE6Ylim",
-	"KgEX0EXTaFFvFHZ2jfht+UOVYSpuXMumNbanmzbbF3vV7O4x2jrfg5/vNoe3fLd5VH3efj5oH9W2zjvb",
-	"Dfa1UdweZqk/PHl5qeOr/plTr+9tEnh7/9y9Kt7zHczK1d12qXC237Fv6XalUns8uqoML2j9LttmByfj",
-	"g2rbd7LNgx77ek+vneLj1sl+4da9L2DSKWZro/FJl8FqtWyh+4NSFt1ln3Ep2H06fxp8xVvno6ti5Wy3",
-	"c27XDs/zL9snB9ao0ty6sfFtsVq/Doql26uRf3f+tPu58Py5ex7AKjzGn+/KRz2+iXm/sFU668L6oXsy",
-	"CPZrV5bV7/B2cHif7T/5lftdwpu8se3B29urx73d57Oz/e1GttndHe4+D872N/dq3bPGZfNp96708jx0",
-	"KqOXg5OnokeK/c7o6ezRq/V710f57cNy3X/yGwfWzv3mwRkakt2qt9PfLPYRYwfe1uhscNV7afsUWQOe",
-	"HXeGL6fNx8PGeQB7xHs6G142P9/vD1E16BXOR7WTr9XCzaP1Urc2hy/nrLBz51eu9sre9TjbubC9vf2m",
-	"OyzejSx+fY5P7N6xQ09Gha3SML/Vq14XB2XCmk9f3YHXqXazlu/1Lk4r7lWtfVg+pWjPa9fuvn7tdTt1",
-	"H29d0MObo9rn88Hu+DDYO3s86Zec/ld4cPEY3I4K2VPnoHtZ3Op93nf73QMyqBKEdk4v992j+k7+qTbY",
-	"vMmeU//6/qJZOf9adfODyuEuur+5rRyMTo7PTu/7x9db22WKTzdvalk2/OqVCkHxvHJRbF+fHI7O90tX",
-	"hc7eE9rO7qB9OB6dD0dWUPTrg+LBjntQ7V7CyxNIt9xuMDgtHpoR2pvG6KNtVrsbWLv3cH+8R09u99vV",
-	"s8ft58DzgrzzgtvHn2+ag8be8+NOw77bsuhhnmL3fvRcxQXkNosByl9fwh3b61WOxgcn29YevyrcVLbz",
-	"hbt60DstN2t7cHDfr9AdXix+/nyF2hcuD+6/WlulLXerWtg+KhwN6zusUX6+HhcvCi9Pe2ddsn947bJd",
-	"/37rsrN3j/DpoIkPHvPmFT1Nr4jtO8Xj/W6vd8Bopb53Ca1CvX1Y6I0KewW/WRgcXRb7w6rjHDYPN1HX",
-	"L+72g+vr4Xn3wDr3yJ7zhG53hy8v5P5+p9G4qnz2zu1SG/aqW+Ror9rxNg+uO/mTa+zD+sXTdfesb111",
-	"2vb+UWW73P+MPm/fduqMduqUBI/N+4vN5xKs7TYvdzcfh7f+XfZsc48/342297ev2lZvYL7km15Q8ap4",
-	"YGw5mDlMOOr1gsr4yL89hcPPxbvtm9Prnrlv2hVUS3wSMmaAxqBanhAwkXaqInCSoUyhIMGsRXQ7VYpS",
-	"ZZSyhOVOgdWnlCEAXUp68h5F2h4DNGahMgHBye0paCDeInYggBVfgU8dRyawU7IqziKqLZcQaCU3BAwB",
-	"ia4TcwDc9hGZbKQKYibVmGjm9Tjyp0WSsMlrG9UGNI4vr8/KYgAZGoyJxacmyAHw6ZKgFgmTPGOiU0TH",
-	"gUVydBf3+jI4RdlT0J1dD8DdFuF9NFaFoeIBWpkBH4enJQ55LVxeJ+ByiRYlTFhb6tw6Ap6nAA+hI+0W",
-	"hyOfQI6HoofyjybKL8beFDe3prZeQ9YiEWgQWJChbJyeWiHZtEje3M1v2V2Uh9297vbOvlngDtKuqQ3r",
-	"TFV8pOZBez70+tiK1aAW6UIXO+P40EMNaD0K5W5l6o1iK6MMicNSK5OLZtbbqlSjFpmjG4Fp1egUjWXl",
-	"it+pAEWnEwO7wuHUG0XjeZBpDjNsPvnP1+OrmtMu5OFTd2dwdzwqf/WRkz2lw6q3xz6j0Qm1C4d3x84e",
-	"v3XqB1vtOnK3tk9HB90n5/TqyNskT7Cf3dk83j558YMnlLcqtZ29fu2wyndvn/whcU9Kd8/kIqge7Gzh",
-	"u3ZwfX13d1w+PkCwVmrU6cXJy8WYPdKTrXyzes1ODzD2+wfwc7ewtVO765ziYzuwztDwZrdmnT6f7Fj3",
-	"ey+Xhb3dp5Ozg9tGzc1eVtFw9MSqrFE93t6+4u4+w7SCB/1B52xEn0a3l/Wj4n2eOLt3w5tLp18s22eP",
-	"B42nRhE1Nyu1oFQukbvRS6lc37vYr1YO2tVHu8Erg1LWv+GF052D+27Nzltb44rXHh7XN3dQ3c6/nOzd",
-	"XX0e0YPS3g1h0No95JveaWNn37vbLezslmu3wd512T68pM5psdaz7HavcOEVP+9aRVhpP1rNvfzj/ql/",
-	"+DLaaw7z5OTgCLGg9Fi7eDxi7dMXe3DbvL7jha1jsn91wmxr8/xrAG/z+OnuAHnH9917+nSBnJcbjjY3",
-	"P/Ptg9GNlx9cn++OUL76NL4rOfBr77K618jelg9Ghfbl0Hb30M6F278vdpzDGzruWXd799u79Hi3c4ph",
-	"6ap7WqqUz616r9R30eciPdwcf/16z2odeNTeqj7W/NL22cH58dVozz/ft28fT/aq7iO2hl83vaKbt5+z",
-	"p8el2tZ4p3Zz6j8flLubL41LdlAfXDYeD/aatcsyOTl/2c0+2jv9Jv/67H2tjWq0apcgaX7d+lreDeoH",
-	"XtMtj7hXP3J3t66v2Ka7U7mtjTr5ZqFaMasLM/rP7kVncDcq3173cRbVd3ZKvU638LkyuCqXb6vQvYRl",
-	"P+8/bj7lu5vdyuHL5u1OsZ3fvx8e5fcreLhZvCxky3t1Z6/X2LwZvBRw/qXUeCLj8V4xX9i5u7TqQ3x5",
-	"fvqZVJ8Pty+P27VDNqB7Nq/6na2me1joX98NSwP3ZbR/yJqVzbOrg8uj0i4O2FnzDnnV0ubzfjAonVa+",
-	"Xg3tHj+87FztDF7s/Yv+U9ayn9wGOr85o8+1M3uz4wzxTXP/xt3LjjcLJX7voZPjS1gt85vamXPQP8EH",
-	"tHZ629saPjfc7bvnqoMvLlH3CfWqDnObVbh17jV2b2+d0unW+LgPef4q69efy8/2GN/YR+3Ry8nQy1dH",
-	"7cLIZZ89lK31Lmr3w+vb4/0Tr39b2znd2rt/OjzAJ5W7K+Pez2hq+eJmxfUub+v7m+168dZ78tFtAw4v",
-	"4NfOPrlDp/iA5stXRwcvW4Vy5+n06WqvsQ0PTtxOvX9iWfyxdPYIMcniYcC3P3/mxetz9+VkdHNSLj8V",
-	"zr9WRqX9q+z2zf1Xt3K9fXyC+fgqaLwc36Pd7frjRfawfXGVLzRv+0dbV0e3uz592rV2Plv7+75/zQ6b",
-	"o5MTPGrsne94mzvnOHjZ2SrDy8dhNnh6qhQr3fHoYud28Hzc8eGB9XjMz/FVML7qXD0NSpV+lkJyPBjd",
-	"X6AxZxf9ZlBwr9xRfadcL/j0a4HUcOWxtEuYPcgWPx9uNbZG5y/395XR58dh5/aW3t/S/eHBdr59fl+4",
-	"z1furxpPVvnFOqhaT+08JwSy7tbN7XbFft519/L1l8tOocKIQz4PT++KKXuPZ3TF8Xnb2z3x78YN/LLJ",
-	"aaV32rm9yZ7Y1a3Hq+2xF+z7nfPb5y3/6uSg6/J8hd6/9K6rXw9vKofW5dMIufWLvc0XWi5CmD0Y5E9v",
-	"L47KjyfHX8t22a30b7eKF5+datYLngdt9/jr0cnmpv88ru9d7uzcnTXQVqPm1runo6/V3fF1Yfvr8/Dq",
-	"tHJf5bZjX58evVzuVuHuS/O0X7ypX6clvzREtzIEPrUy6lGU1BwDhlqZtWkVIfKICK2LKt0KxL1yWpjK",
-	"zpMKMHI9h46VMhUG94GReqXaIjAxSKQuy0If2h9CekCWD6G+ejsxjm53Q/eJzDkv2uTATayoWtR1ZcmT",
-	"VoZh6QKKOqwpbQURS/yMIsfLjK+MYbMp8DyNGt29rcuTrXLhubJ50NsbBp19foY2e8fnLzefd9FeM2D7",
-	"Qe14WL85NB/N846VpsU970go73I7+X1gIZ+rKmXy2R4mSaUuofbIb0LVp0Kdpr5yLNZOq3fJIRj4Vq+U",
-	"dgp7+e9aG5oZX1oGycIAMuGU1NOkY06G+Cc6KTVKLUzo84fQ6reI+jtU9lRHqWp1IEO721kkg8dt8Kmh",
-	"3JZgW4wrgNve3d77DrLZFpGvEmXzwHfCHmugfFgH36rN69zd7n4+t7m/v/19Zp0KqpwKAp75qLctRCuB",
-	"h2oV59eNptAtxa8yhKBFEv1We0oxnsaY53bl8KUe7Ltbu8dnF20eoN2d/bML7+521yuNGxwP7h9Pq7fV",
-	"HWgSy7PZaTPKFFBm6rp0iJr8uFGZa0M6R/0lGblOg46jy0lhN3AzX/bzshKU+iMr/wocjj0HXXYzX/K5",
-	"vPhfXB9K1wUTE0cFs2dnjj7NnXpzb2Ju9edykyfuxqaS9OpcyLNOzz4kRJVjNj0vfqO3QjZmnnrIY0i5",
-	"HX3T1Y+SNY/i+kaQyzdhMmLdRg4WPFV6FnRV+RY5h1G6dgCJrrgvw46FLe15iCBbXhw9Bjow84EFsqzU",
-	"g362uKy7Xx9LCLmJbpbMDe0jC3tYX0vMXuwiYrejZOPL5d/UcaILqt/PVuOcvEee5Qu6LuZShTDmFNxM",
-	"vM5aYQTd861eu5grq+sCpfo5X3yS64o61xP34JO7N3Gwq6VtmMa8mW2YajD15lETkUpPL3OQC5mr9jom",
-	"pCqfLHugkyJrB1w4xif1rkEQlCajbuCsySsh+TQ5SX7UsgJf5qh4L6kWlnpfMfvccomHD3obQcjzlssT",
-	"IPu0Fz8WCEfvIKlghrxzqSyqLCWKvDl1xphFA7fIofJ+tTLquEOPmT7yVqZFNB5MkmyIgIq7VFRfonvN",
-	"bdtQbRKX+moCSVDq03t6CWfiDYnjTDCKVej8InCcA0oTcvxbFMO2niGB42S+62bJohArFKH5lolSqU4M",
-	"V03Kom+RcJlttbu9RLupTBQzJyPb/J5oH/lvzMDF9dkZ7Dgom1g6DRnzBLANtSGJVZm3qKnzW89Bj7Su",
-	"MhJsWjHYntutBnuYyBM+RxwaAun1xs5jZPEYNc3IRK82wy8rdm2IHmk5ItOeZCbpQ0KbnD4cy0QFU2BL",
-	"7JEv3mQp1+kovl5UfeXTZlZYXDJNVKR4b5oUJsPqkrPs5NdnEDas7yI1RSAYiV5TrPDn8/lVJvahy97F",
-	"uS46K/MZ+QyF1Yymi3uRhS9wGjKmsEaZzHSUkIVmhZhDn6865A8j1NzqV6NoaXNpMxX5F7cyVzCLY65T",
-	"K3iJvn3q2OF7KxU3G1o9qkSOM26RFom2f8Uo2pTgI9PSrwJsDcylX1QecnP+wpL4BszVAFKz1Ibpy81D",
-	"VsPvqw1rzs4ml6VSx5lzs5lLPSeWPAOwCd3rMrXPH8yXt2RVkF9IrdlzsDWgv2HIwquO+Vr5pX9fItC4",
-	"RtOMq9pfqtCBSZcMR51TfECdX7zrq6madQRNdbX88OcFb49UO/PAE0nBmtrsD4O8DZ9f792qMU4+pWyq",
-	"8cBslY9C2My2kOQy6wj19bthoZpOVjmdLmObWujShHZhjctitLYI+4SgkJDwZOXLrkNHuuglC5NExF1m",
-	"20sQdfvlbeQo85vMXJKa9E1WgdVwy4nmJXiTHgQrdH2kT2l+gTeROEbq/mInomxj8sEE9VBUrlEv/7qq",
-	"GkMCZF4p8CDgfAhLpC+iw0mgpvclkWkqvcZuZg59TKfE+hnZ8ttyPC3lxXzDLDRhDPvsFwyXTRyYZFBG",
-	"HqxmUWPO43RTBXN/lbE4YSndn2Ysk1V5YzazDM+Qs/88z5hPwBKgSbpdzDBWpVRTDrgJAMxUKf51WQx4",
-	"v5BaADLyHs460pTfU4fVd2XxSvX+V46oP6u9BJ9QrpcDD5jIvBFt/ejgYR08OLSHSTtc2sOafGqRLFz8",
-	"oBu3bUQwss1Pq+VkbRt1gp6p1Gkn6MWO3uStC6eSToVmRZ2hukj0fNpxkKvuUW00RI7YkPi9tZLaHmXI",
-	"bhH9IEbfkHcCDuSNthbTDPniZB3aY3PBTkA7C3z0V5QLU2fhEUwmcCEBQjORz9fUwuZM1cemdyHHmHDj",
-	"VshOuXQPa6q8nCjMetxs1kK3qxSbE+tQqLGd3wTUB9v5rbVpBNjO55fNiKVpoJmoYBzm/wmpTdUhBRBI",
-	"xBNzWpSwxEsY49s5U8FzI1FIfUUmdBNUHgdUPkAPqwwBHZ+OGPIVHocAiw5asYhGMO67LtdretsTfjLl",
-	"izJVB1RZSPWFIKh2QRc6YbyuLgosc8wBhxLpZZTtc8aE5IlcySnSUb771MMWE5mVKQHNkDeLk79V7Bmz",
-	"CAhZQSs5PoBcwiuvlrNdaPGpbM26kzryMHo7VDZjAeBAxoHuPjuJwkvRrnnZrCnEtfrQcRDpIdleCFpZ",
-	"RHlteakxCWZbF1tfXG9fbsXkvp3rvpOjUr8NGQt8SCzUdgT3WjR4Mdm5GPY9k11lQm2B9abrbPVB5rzt",
-	"Y8aFiUu7MrlqmK2XJdL1Jo9TnMyyjh+9djWb6QJ6nlqQxDqpFIzNOKbHAIp5/nzdj3CuanmZa6pkFrNl",
-	"fF+yD2PBEiRWZSwQx7gMcakhJVWFMg49BdCRzNGhTL6PfJgm8Yfld4rTASL4xVQLoRl+iuzB6AdFgCe3",
-	"zbXpnIoaeKkqqEyYUugyxGPifggHegBPgVCD4igsmZdNpV+AKpFVNCsI76+BfqhhQcdRasiGnnVj1KfQ",
-	"xQ+5Zbwa342yJJWYTWydYdILHDjB4aivmJsGcor3bSjZNqtAQ/irzCBieUYEvA03fwqgFLa5PAK50e6E",
-	"N6XzE4iuJ9OW6mykOm2pTmKayFY6nch0mM/tth3Ug9a4rc88qWA35d20gCdkY7NPxKeyJhhSDyVaAGyb",
-	"iw5QY53c9NQKl9VySdDsZHqFVUZP6FAm9ExGnAqzbvKU9SGthvFz821MYDzTD41GyI9tpyRTmmANK0oX",
-	"IzEmdiPMCfhJ2LVrwLySWdE0KbtSJKjKRMo8qsJUJFdK0+VMMkePIpDft5eVPF6YPN1wWVHTboIoha2j",
-	"o31mhnGolYLkR4iCMxrS//QC5YOiGgj9QGkuZtgzPmO/Zsgvik+LAFySH0/eos2YwGeYoCWj0izqBC5Z",
-	"9rZ4FpIlruVVm4aDLXTSuLyo6Hn+mhfpqpHY2DUZhfDtu+r01w9Na7KsiAr85XSj61NXBRYLXtK4OpNh",
-	"jLCXtAki1F8idCABomhdJRz5QyWijLerBhcEidKdSdXeQz6mK8iV6JbVGCbk818YfDpTjJxpXS7FhITG",
-	"cAkTyNek41BrIGggusyd5hHMTDNSjwlU/yR/XDjnzFXrJFWZ7w+vifIJ/QKkaoA/AKr03GpuZAZVc8u2",
-	"gwlqbxqElmaZ4jvYVNEYZ4j0eD8ZFpGwA5PjFRaMVwCf5OsAG3GIHbaWMryJXVhG72xJOeoXwChdkf5C",
-	"60TtXkk1DvN/Oyb98HcEsH8Lg8gTi3ZMEuk3xbCb5ifUWEiGuirkW8d6axSQWeYQsqUqueyxepRx6KS4",
-	"32ryIwj13tRBfNQzyu66+n15aBj3EeLpGkVDfg8XbGbEcgS9lWkDRDu9LGABwemDXhO88pA/5kXWzRLC",
-	"LMdjtA0drw8NJK87ggJwEFcvs2hbH6ILn0PoChOwFkzKXTjJVvokW/Mn2ZqYZCtlEhK4yMdW+iy6wU9P",
-	"Yg6AiUZXgS1zudiPVHY/UbEg5TIvTpxpDIiaqmL3E0kAzaXTppFf8P6wVeKhiEzBGilq0gUCZdgwlqm/",
-	"+z4Nen11+QaKtWpc3O3h6LAJNhIpNltBPr9lYVv+F6kU3YZqbEvCFWUhV7BN/KRqkUjnOEPaF2tBxxGG",
-	"gXxTqPNKyHRMkXdOuxNzoEzl9Yt6PhMnAEhe6Si3kTgazAGzqI/WTLBh7QjTN+NvUR8NqDSgLSIzfqqr",
-	"5Yk8+f9mIJw594oVy35jKTL+z89hjyeuIBOVBibU/UTRLr0n4XEZFVCGDEGlZu4nY6yWD/wzTXejMZOS",
-	"stQoDXKKGC1JneDP+M1HkFFTt2klXHo19EBRNyOYUc7/mGPWZEmrXrKkyhRPbhNKLHOkhP7WDm8MMFnS",
-	"ro9uwRddPC93w7vgcnbmczcy8hfdw65yS7rE9k6+jpKbp96IW05go6ikiU9pN0u7WY+ykGWf3DaNF6nm",
-	"SSntzp7k48i8ZDld9Dov3YOZOtXkquRw0tsI4tKCybJNyy5jDk5Gbdq2D7u8nc+vds4LVmU+rp9ZjSFC",
-	"a0EdDsmmJ5h5Yt5Y418+ziu+o0JSy5NXBqr91Nv/Qn5zO5vfzOY3m4WtL4W9Lzu7uc97ha+Cmfz8FZ56",
-	"hbbaK7NlNL+0UicLospM2yEvrnWnV9+TOclSQ4tVNmmROAtYl9L/V5bJtKibXrPTdP9XjWpJ4W6yJqe8",
-	"VYaOjLkFHYRIVJZzcsHcD9B3U0RC2HzFeqg6mG527fol9ji+5gg1I+QKEbqeYS6bzrSrPi1MDhBF3cVL",
-	"FHBEGPbdpG4wZAV+WGEoTL9OB1gWQwxluP4prKH+JUP9cVtl2o/utmKnmYdP0Tjz44d80dClE4mqA2wh",
-	"X1eoyySK4mU2c/lcXiVGRgR6OPMls5XbzOV1rloJ2IZ+ebuhn7zIH3uIm4svqQKtYVPQGYMeHiIShhAJ",
-	"5S96gyz4WZSnt2rrESZfXUr+E97+inMxJqvW+jJJefakIvV44Ku7JdFN3ivHW5t86ZWsYKVfWBV28oYo",
-	"psjvtLPoJdWPdRNaEvTMQ/h0sY9U2GSLCeBm0NJkSgTCSHJkXLqwouJzgcLSoeG55FqkKuslRHna9BWk",
-	"vQ4wBzZFjPybAw4HSFdFFn3VyGlgRy9KY5BXf+4/u6p6iD0LFhZj2W9YW/IZffqJfBecQkl3STQFpToI",
-	"Qa/vxhKpDTcetQYej7dKlgeD//yH4fGhrCgbvYIG4YNVeUUa6UA/1jPbClTTxNGSNo4QQT625LO6yc6b",
-	"iztfk4mgFMMgW8sOQn38Yhghot7Fo6BnD1mzUCQ4teQ8SR797bs4Xxa4LvTH4S1zgmlx2JNi5FEyX+V+",
-	"nGGlG39h+0cqPz1Ck9WZk1xVsDvFWZV/YZKPHqEpNrqIi+pmJi+JnjUiBZnEPKIErDLZh/JQlkT5jSSx",
-	"FCXMYr4bE8k/AbO389uLR7igvEID8tZ0cYQ4gCBGQyNd9KCLskJgsI2/9FOyuWShXlDKDrr8qqIF0ddE",
-	"DEfQlSLFoE2YtiBuMvt2ecUe+in6jCPoOiawsBpXF3GrHy/KTG/xQ7t0olv4fm/2dsvngqlIfyoDn6Bt",
-	"A89HXfwMHrIPcodFB52LRfpos7EZuCa1es+RbgwZh5yiEFB/Ul4um9/rt3KNGDUMfKMRpYyJC0r84yRc",
-	"RKo9xBUyxtQ1l2CFFZrVrzzxArtAjZl8U4ulmJzV/8WBCIuulGz2Loi2InVC40oE9WCbpaiLKsPKLN4v",
-	"4b2cjolYESYBAPjkQV+WAJRJ0tdSgNRu6VUMjH8425j0e9mQL6+VTyHx2BT77upEKcvlv5BpVaadDxIm",
-	"PZLB0fBfzs/m8Z0FXG2Aib00Q1ON5/GyU93ifbIxCf/74WAROB/M622Zl8DaD8b19owr5C/pPGsIfQwJ",
-	"X4ZjRU3T+NVN3ODdsasQ+HfBrJLAfLCqt2NVGmE/ONWbcqoEX0nlU0vwp3S+9B4Z0rtgROqWLXEGKouT",
-	"CSCZnEm3av9p6CJZtgA+mTxKtHsbCCdTfs2HcjLF1R+ENJlNLBVE1eitYNM3vkuBqCOh/jSkTAVbzoVQ",
-	"tvmTkMm68aDrwF7a5aMOHZnRIBLJzxZNIp+DJZPHmSaKdYi2LBRt9DHPfUa1CIzAUy8KpzLZLQCG01cA",
-	"5b0rVeuGYCKZkIRTgNwOsgFBTGCKj1TFcwYwAaH8TtlE2dEMTxgR49IhMueufl9a34e695bq3nw1b+G1",
-	"tkyM4CA5VNp93eLAH9U/wb9XuppeItz2n06Ci2lsFuHDYFEVZf9xKW4mlPCOOo1M+gg6vL8BHZ3dyUgo",
-	"pT6yBlFwpc5HhhlQnccmwjmWn4qOeu7x25DjWEOwAkN85Q2WeyO2xrQt87Y8yhi70parXqkbXteff9uG",
-	"qwne1XaHW2Lc7Ml04wuiRb/Frb9/6nPusS8bG6PRKEf9cY71N2xqsQ0V+LphUWIhj7NwhnE2YMjPutRG",
-	"zlqUum/MOHJz4IJy9CWKE7SgqnImi9d1MFFJbGadDXE69EUiSGbzb6io0XcRVXqBnjmQUDV1vOgKAaUR",
-	"XJlNc0WCdGVaoCcoUcIw44hYYyCTDoFPlkMDW75uTPOGWnGnCWBCWci4T+UjXjREhAfQHJE9Cw/3MRoi",
-	"EL7+BjGK6eg57INqmeV0bs84wVQf6lR+Mn+B0P3jDM7sS4uUA0XDCFAfEEqy6FnCz8VwsjQi7hHqI1sX",
-	"xKO+rUKSVQAysmU7F44FIsZ1s5VBFuc+DAFTyCujVNXrycDzhOXiRWpsDtzTQOWkxq7nqFT1Yxr4gI5I",
-	"oh3QC9P4NRMsHcEn90e+WVAvNyWwiXTXUNb3g5LWfMT61LHBJyvwxTqcMdjJ59dyqQ4x9krWUOIdbjVM",
-	"bORPvpuUv3wS7EHMvg5kQP9alHcmHkDocw6lA2GOqgLj6BlaXLnxcy1ySZwxoETmvjTPC4lt/tLALnag",
-	"n0wbm0s3cMP+7XgBq90UhMHwkIDDu9phvXp+eNEsniXQW9rat9WzM1A6Ll4cHcpnuReXTeAjuUgQESRf",
-	"B7bootL/Ygt0UB8OMfUFah5XG6BWrBfPD5uHdTXewSGoH55f3hyWQfUCFC/Ada10eV69OAL1w7PDYuMQ",
-	"3Fabx5fXTVC8uAfn1aN6sVm9vAC1YvM41yJzt0+fa3jBsvbrJ8zUyJiPAUPQ/4Pn7PloiNGobT7vtgZs",
-	"tXOv6kd9CdiELAyFeYuEDSAZJ/ZlfeLZ9kOYd03lOqXYth7WQ5EaKgw5IB816RR8YSOJd/KFruIiqn4t",
-	"Jlheh8mkwqCQy4OiKqwlhdN64vc66gpOoj5ExdkuPUSqZSFXCLIEf9XfcRfAIcQO7DgoldOoBSd2+ZUY",
-	"jzSBE9JEElQHOTRM/xWFayczyaWBmWwz7WR8k+cEyXSVy78niFItGB4S/Le+BZhQI2eUZPnAylQasaQz",
-	"rIeaS4u8mnoc6hSRYBfcCjqMJjOaf8OuUDBaJMGfFk7rQgJ7KJvISqFGUbPrxOgs8X2tRboyDzbjMtNp",
-	"yHrYOmDUEkyD4Z6smGFR0sU9XT2OCc4kd0an/tU5eGcV+QOxu1P1k7Q7CDEe5g15FdvMVKVJUstzlvpY",
-	"kIgjq2ddKJIPsyz9NlvRtPQEHs+QcWdu+3/Ki4n9n1/Dn2UeigGYLJcUPkJZasUNoQiG7GP8i8wDLOQd",
-	"glVJsgd/b96h9i5RFOV3cI3JSX6SaWy+Gjix3E93+X4wg7diBpBM1OiZ73GLbl9Ulh0Db9A5myatfOzr",
-	"FMyw44ylDu4h34VEWfVqLGWGvRJH0W+2MGegWtbsBVoq6SwkQPvqAmJTgmZUF2VnMFDIb8d5w+PkVZBp",
-	"iGXlZ3MDMUGXBsSWRo7Kd2VBhgDmALMWgYwFrqxCoKp59SFT2QzCkXWKg1kGUpYNEic214sYP7pMJOJQ",
-	"rzp/x4PLJW5JDl2Pjz/ePf4S5SocWES562bfeF1i9ytKbxmnpgntngaSwKicDTrOuEXCrEBJn4lKxjYr",
-	"UoUxLuTp2rRvQEZ0sDBn2sOsCT6Tzt/4cnkFupFezw4K3RHxw+WI0Mc0ACOoSuT0ZIr4X6epD/fLq7lf",
-	"Qjd/IuW/TvKvU/5PJ/hP5P7XSSlF1wHSCR67WGaEZdAVE84vNPDGF+zLa1wfvPdn3pwvYrwp/peacjE7",
-	"Y52kiU1w4X//6g2lqiWouOQ3ma5QWt7xqAKJJGg5i7oba/oWSUepPWD7YR08yJjEUh+SHrKL/EFS7EOC",
-	"dT9MKFC6WmzImTHTls8s8006AV6f/SpAXk+reX2b7IRRIvegTK3AFcO9pR/nv8sk+xXu8jcy6FS19sXM",
-	"KTCWNkhaQCb29OsuHgS6geNEBNwiYQ2+T+hZFt9LsJk1WWNC70kOqGpOHg1zGNNkWtyEaZPUMSEDI+Q4",
-	"s6xoMs/zfyEvMiS6/mBGH8zoVZmRTpS+undpI0HDG38JipjrcIpt4dcyZ+P5Q8tWVt1sEW3cCt1HJ5kO",
-	"TQtVLtRWBc4mgwN0tnWpGxVr1UUOnWQO+Lf07cyGfyRKkco6prQ7HQWhNiUn+LrelsRqRP+a/r1FhCE2",
-	"/fWyWi61iLDNpr80L5u1Fpkw16abnMmPLRLacNPfb1FH4ClpybJF019L1JZorEAfoLEJ8gESIkvZgTPf",
-	"1c8tIqzD6Y+N4vlZi0wYjNNNJsq6z/uGo6xt0EliqjwSMYW6aZVlTEV7WVwb9cbg00Tp/LUc0DJVYLO8",
-	"Yxlh3tcegeS5qucJOj9/Tm3fwmWUlPlsQEOJZ/MQ8X1Z7QZ/zLyIMB0dJAvuJQ8nQRsVrF0giW6dcVTr",
-	"QHq3psswCLb4v7Nuj/8rXTRxFYTUwLilAr4+vLlv7M1NYoxKPBeF++AVRahO4ct+102N+F3XbFd2guOE",
-	"FRZnopYS2SQTFfznycBGCP0/4XLjIyPkG9BSK8jnC7ugGqFoUg/9NwMJDFv22sR8RfiriJ94E7A02kcv",
-	"A/S95fIJqkFFvjyVxeIAnQjg9hwEGQJ9BG0gyF8voEW+JRrZ2nUl/0rXspFF1SuJDejhrI0Y7pF/xcOs",
-	"5d7pkwblDp3OnP132LRXfXDxZ+0KVWhI6bX6kbl8oK6oKZmHO6KzKA837+t39rJkkjkN9wSFQh9FbxHS",
-	"NjQqaDTnDfwfCZcNa/0uES2bDAodR9wNfEiiN47TXV7qCC3ORRuyBquDw6g7s+amS73KfKqzOW2ShWB/",
-	"U3zZTK3ZHz9+/PjQl97GzzaFDMvqMwo/HSdKE631FwzkePId+ayucqC+hcj1nnImabD1WmS4iHq7s2Tu",
-	"tLD5aq9j0ib/hcRNy5Yw/kjltrQolXX03ndSjzeQTu54Em9XiQFXTAcSKpNWGHPjH4Qy4jdJoWj8dBG0",
-	"+WGyv3tFKRZfy+hFCwOQk/Jwnm60TAqYJHX8ciqYlIIJH36mt9ebfiJ6NvbNTiBJR6f2mwk8TShO7xfz",
-	"Xu9KXwnc5TL8fLDbPx2/aETZNNYbJatg89muapdqlKrPv9cqTczxYZa+NXudwoeV7VIPUVXwXRqlajSj",
-	"TVqJEfR9WaQx5fxpc3Ry5g9b9MMW/RvYot0kHS9tiCrqn2+JViK58JtETzzBhy36d1aOKrHMWkojWsIa",
-	"7U4Mma4VLWMVxHnDPozRf6629EvWaIwiqaZopC+9U5T7sEL/O6zQWVRNZbg+RsTOasm9oNKH0CRkexC1",
-	"N1oNsk09bvKuLIfJBfxx82F2ehv76mk2+ISJRV1xbNQHNOA9ikkvDYyomzEBZziSOCA90FIxuBNgfpg4",
-	"787EmaCtD1tn2taZZU+rpl6aHCElydDkKfzOTEMTMy2wgF7H3ppEsFVKJ3zI+TfIMTSDr0tKehXNDi0L",
-	"eRJiM1EU5fdFRKFaTRPFYgVYjfpntd8PN8G7xuo0hFsJqy1ILOTMf6EhWixk9rLVB15/4PWvc+sUhFsJ",
-	"r21kOZigdHZdVg0W4bVu9oHYH4j9Cs+CUlBuPmazZR6eO07scmApL9sq0dffoYRPzPFx8f0uXqDF+LDy",
-	"xfccbIodV+/TY/VGnqoPF9DHLfff5pY7nTFMyp6Fd4vRu/H48keJN/PNT1JKvEc96kM8/XHxlH7xsvx9",
-	"4zyUO0L8/eLbxzXjf8k14wJUF1z3KcDWIKuKz869XJQNoyq1s8rZlfh+pD///ifC0XTLPBL+LywnPHlc",
-	"xqMX+6Bjm9OPPWxkOvJ69O096eMa4l9UjH+58nwCDFlPmWMXAYFBPSoBSS2NLxq2w4bT1at+orzyh6qe",
-	"Xv9VntFHzfO3YlIxczEzKJ2dbENmN0v1qk5V0NGVviCIUrXJvHeqEgXr08CxQSfMT6OT1ajHRkSXFRUk",
-	"wfvIVflj5CAt8on6so7GEHK0pkud6jI6abVukjnhKtT/I6VvUuZ880o4aXthIAQ/remHFvlWhXJAPUp7",
-	"qBINzqdXB5PBL9CrTN/4hvR6hslgkl6n9BuTAFTJddqcZt4grfFc+N88y3Havs6h/ZmmH7T/9rQvDiWN",
-	"9vVZpxoT9TBtHHCE5Kdd6fCPsojr7iAueI2JoPIFeeP0pB8F5Y35zf5EnZXwCBam5pJVOXTrj1q2spaK",
-	"4yQyl0WoPI+8Is/4kj7KKfqaWxhK++p+oSaAmuS3FIf6c6i8rDvzQ578BMqbuEEqyicy+ab6pzDpGTKR",
-	"omfMuFl0LJ1hN8okKvj0yvlGW2Tl3Jngvz7f6N9h02bl8T8zz+fMKg6fPUjsS08V/ZWLmSo7CJBMkW9P",
-	"aHixd04tNxQeSA6H7LAUn6bLXIukrlM0U91gx0HJkcWi2QB7XvqaVcf55fISucZtNMQWYn+imt1rZ0TV",
-	"TYFUv+Jiic1kSUllTMsCro7mohEGYVkhE9qCwGUNBYG8cjXPPPdx9fzTERBFx1mYBzU8hJ8sNmyj0OOh",
-	"BJXWA5Ednm4uhADYkMOwCIauvGtITo+ZILUQ+ZZOSq9n+8hJ/w8ImggxCkAQ48HPpY7HTJj03UAWefjS",
-	"IkeIKwUuRJiw5MoI874SaxECx4w/qeMtYFYGa2dJXP5j0u5Q16nNgv9Vjf5vqCOrTP76x7KSR38/6bj+",
-	"tlzi9azESALPSlwWC+cPDvMzZuEcxjIjFTfQM0fEltcL5lqzZtmourFEbYqQ6VTLUrl+CGUkgr6DEePt",
-	"sOhiW/V9EIjLEAeYt4isC02JM9YDJzEawK5gEJMSWN7/9yGTldOQnVp6XxiT+gQAlIX4LygoKQyOFThK",
-	"AFP3xTlw6djIB5f+GFwgPqL+QBC/4KMMuHDcIn7oFirk8+DyNB5Estkk3Jo7dag9zgHlTQorM0VLEwvw",
-	"eVjvyY/0S7khHQRs5PlIomY4XDfggY9k5Rx9naNr6PrIETsTeJITTsmKHqE+YoKXC1SSfD16lc/pxK5D",
-	"NwGffOQQ+pq389sACdxUsFABKLMQgT6mLAcmp4QOo1HfqCcDuDuxAzZFSm1SXoYWqSPuYzREE62qZdD1",
-	"qauqWsUYPOpT6OK4chXYAA+cavx/AI3yaWoZ4UO54nepi/1pLvtRkejv4PBTCDtfb1QD+kMzFp/IZsBG",
-	"Q+RQzxUcUDXOrGcC38l8yWxAD28MNzNiZj34jCo3RP6Y96Wi2aEBB3ryCP313z++//j/AwAA//8=",
+	"7L1pcyI70jD6VxTMEzHt+xiM8dJ2R7zxXgzGxisGvHXTF4sqATJVUrmkAuMz/d9vaKkFULF0u9s+Zzwf",
+	"5rQpLSkpM5WZyuWvjEVdjxJEOMt8+SvjQR+6iCNf/lWDPUwgx5TUYA+JX2zELB974qfMF/EdARK4HeSD",
+	"T5vZDmTIXsusZ7D4+BQgf5xZzxDoosyXjCdGWM8wq49cKIb6Hx91M18y/9qIIdhQX9nG1MQ/fqxPwdLA",
+	"LwZ4LhQotAswRy4DHvKBnjcNpDbDLz8LlwTihwDOR8yjhCG5a4eux8d1/csskPIziHoA6CPAEOFg1Edk",
+	"HXSpD9AzdD0HrYtWNPAt3cpGDuLIzoFmH4HjZrMGGIc8YMCiNlIdp8bGDPCxhy3oOGNQyG8C6oNCfjvz",
+	"Yz1zhAjysXXo+9RPAmtRwhHh4p/Q8xxsyeVuPDIB/F9L7lOxVpUDq92ZXH+xVgVIfVzPXFBeoQGx/zwY",
+	"F5SDrphagHFNYMD7iHAxD3ojaCZAiKGiPn55S5DU/Aoe9Owh6002KJ47RJ4fIdFKoov6CibmUw/5HCty",
+	"FMRhoELRGAQEPwVI0k9mPcPHHsp8yTDuY9LLSBA4xA6b7X3J+8hXgICw0Y/1jIsYg73U2cLPhpl8BPVW",
+	"mTqqryD5yTCGYgZt83qn2UVmPdOlvgt55ksGE75ViEfEhKMeUjvso6cA+8jOfPmW0d2S08RL/h51p51H",
+	"ZHEBUNG2fcSY4UgwH8+CWBK/GtZl0YBwf7wIe0q6mejhI0FDbcgNs6hvAHLAsYsYh66X3AwbcpQVX0yg",
+	"YNswoJoXYHtqT3e3DXu6nnEgX7SUM8gxD2wkm1OysDklvag9odxw/A3qIoBJlwLYoQEHUB0NwF1AELKR",
+	"bVqtRxmHTgpC1eTHVNLxUQ+bELqufjeir48Qb8MYaaaWIL+HkM8ZQQkkqQPoz4b+AcHpva8JntvXs1NR",
+	"7tqzfxblpkhQ4tjURq0rcorpZAL/JyAzEml861C/yFjgQ2KhMzREzuxKhOABkx0ADHsAR3QBFiSggwAl",
+	"SIhhrQyEzmYrs67+VRD/or76Y6uVyYEi6OOe4KVainQRJAzwPuQAcyG+9KFvI79FhHQDCYCcQ2uAfMAp",
+	"EJTgUxczBLiAy5IbkGuRFpHCjRB61iMQAHY9ByM9OCUTC8GUgC60xIJGkIGAIRuM+thBoFg8K0x25SOq",
+	"2zLwCeV6uRbxIGMj6tvgf0HzsllbA304RKCDEJFDSZCaFDgI+gS41EeaCnkfMb1xDHgOggyBPoI2oEO5",
+	"xC+gz7nHvmxsjEajHPXHOdbfGPiQU7ZhU4ttWJRYyONsw/KRLdYCHYEQiASuQBcInXxmXW6A+k9B/WdL",
+	"YgLmDhLy2MR5RhgAJAqAT8Xi2ZoJ4Q8gt/o18X9VOTPHiKULvbIhwFHLSEbNrE/dDXEbM/p5cqRYxBWI",
+	"IY4fExsPsR1AJzFNLrOekarAIhaq1zCWcEbL+BGtG/o+HGd+xD/EBHTgUGtwzZB/QO3x7F0XMOS31b0R",
+	"EXwQYHshrYcdTURbooRDi8/OluCecyUt3ex33pXIhdjAQg6z5+J3QwevT4kJdcTP74PzKgjDpa1Hu20+",
+	"okhqmRJ+3rNwghltQ8frw0L6WAXgIM6RD0TjUAyEz2eI9Hg/86WwnnExSfw1C2g4yVb6JFvzJ9mamGQr",
+	"ZRISuMjHVvosusFPT6IMCamjQxe9McZqEBLHOrH9k9u0muBQooGPkX+uFICG1Apm11MEusG/GdBt4vvp",
+	"KUCBFD0ZIlzdBBZiTKxmPQM7kNiUoCQHjPdwcvYmcj0HctSUzWauDf0VyFHi6X1kiYt23MZkCB25X9FP",
+	"Mz8I9DA1lL+Hvw6Rj7taoEg0nvjZ+OPU6LPfwi+MB52M0Ap6eOqLj3qYcX+mzxK7Z9y1KpfCHMJS1+0g",
+	"8CDZ3oMQ4B4kJ3xI7GXIEuWHCfEigQBNtf+z4Ei005eS+RqdqzImqHQzXYFsvwaD/Hn1agGQKyhbC0Za",
+	"qHot6L+yIrbceMuqZQtGW0FJmzvSFLdcoFSlCmISczUSmTH340p9/St1lZNd6QpMP+OKjxGx6+gpQIz/",
+	"SVFfzh7qJ+HEJrERhI2AbLU+K3dGKuKS2tAt5v1Sops0cXJoQw7b0HYxMVEz9REIW8V6rlCEfaFTCzWQ",
+	"AUqcsdDYxW3fcYQC79Og1wdyVFCsVRlggdUHkIGHo8Mm2Ih1uo1WkM9vWdiW/0UPuQmwvKDjYGsFuHC4",
+	"aQq2iZ8wZ8jpymuQISQfaIB4S8Gkl3ynEZ1aQhllwo6AiO1RTHgOlCkglAMmp2aIMMzxUN0TAjtEY2lA",
+	"AOJoMAfMoj5aM8GGCeB9zEAXI8eWC6Z+DxL8oiUNe9GJXgSOc31dLWd+JEQXzexMmnZdtymGTYCw8ENM",
+	"GICOI4GLeiuziDb8SNMJp0BPImw24SqkGeRaWmswA4z7gcUDH4nW2PWoz8NO48TYXTo5BqgpM8kAIU9s",
+	"i4uJ3SISggRQ8tZlYuQOAj7yfMQQ4chWG5mglIbcH0D9xP6CEXYc0VHAMvIx54i0CFU9CXrm8bkoEXlp",
+	"K0O4q+H0CTV80s4QPm0YRRYFc7UsX/fEYsrC1Cb+ddK4vAiXxGl0HGIPpSwIucDcJGb9mwHuQ8ylpcT4",
+	"omCSdsTP4eyJkWTzXItAS+K5bFaU/24RPPFrVf+VtFWFP4QtjYKrAtYg4svf46NO4ky8RvlwGhMVA1Dy",
+	"HgqU3rMOXGrj7ngdQGLr11bdVSwAQCDYQZYhf4gtBFxICPL1oMQLuEIc6IzgmInN13sutLmeIB0+c0g2",
+	"6mKCbDn6gz7ywHcechnDTaCUAgHyPMq9iVqtTLtqAmSDzjhi2YuJNur1DyXaeEMXku20TBnRcIS46Td8",
+	"yBtK1EYV6i914Yd9QCl0AIj2SAsqZlEAPXvYR6xtusDlUIeqAagSabruo5g1S18DudlqFAC7HPkKj6Ar",
+	"xDTJjbCLQB8yIEzjwmWhjLowcLg8XnEkFiVd3AuUuiooJRAPBi3yIEhMU1jORbxPbZYTc+ZUj5yDu4h5",
+	"kEga8SDnyBdg/3+fvuWz+9//9xNh/wnYf1z2H/Yf9z/9tbX/539MnK3r0FGba7133uk3kNNtKHAqDh1J",
+	"DVba2tQ+Gxl0dAichkcUbmOCJ1XLYEwDMMKsn7gwc5n1FYXHJCiL0esMk8HK6CU6vRJ6yaHmoJcjvr9D",
+	"9DJj1/+Y7bB/M9yIOVws7M9VdEIg/ppdvOdT2l2epcYT1mRHzVLlLBF3nplk9lFGG5uWWk98HXKJefID",
+	"6IgvnEZ/QxDftyDWnYw3s8a+0AFF/uKg0C6AUYpNC9ssTdPFiAGsLkMlhyRvqyXMVHPvJTHvd+MyHBQq",
+	"uquArHq8Fbzn44bSvJjculnwVDMb6GZANZtRj82dBWGSyK1Q63habBohHwEfDekA2bllzIem58OyZlIm",
+	"03n4LZaGFM4iB3pSzxJssIP4CCEi36WFkAmJEFchkxKYAAQQSChDFiU2UE/kQLFcPaaawsEuVsO3iAP9",
+	"nqCBuIkgAjuEhlMAPc+nz9iFHAnPwv08GCPos9xyRtRDwZCjVU/xFmRyg1IdkA08yrAEonJ4YVRZLOqj",
+	"tuWZ1CbqIyFLWoKSPTgiDHR96sodZdiWMq1LhwhI9V0c9xCj0U84SoklJCAxoW6FOg4d/eFX4wkTlmHn",
+	"5eflZg3dwETTUIPzELH1041lIY9Lq7eNLAenPeL8llUeaQPi5OI6DrQG7Q7lbcfs2qLcHbQi3Qmww7OY",
+	"gA7lwHPgWOjNcohl0GFdz9aDLmpbwj1gdroD0QKIFkC1WIpw9Lji/IzSxW0fczT1lpF2gGqsVxqFKaBM",
+	"YyH5fjRSYzIg20nVMh6uQ6mDIPm97ggWNfCV0qVSaPS5Uw8RhcKz/YndlmPPDkIUJ14aFCOL6yICdBNT",
+	"F+wz3hbcSQJBA952TTexaKaYmG6m1G3HweoCYMvhmcRcH7HAMeNZXX5a+tEsOZx2H50zqmqx2uCiDzJb",
+	"y5SxapXBxNa1LchRj6a8GjbFxTvAxP6JgUW31xx0CH0MifmcBC8EusHSo77aQA7UOGvgtlDj6dJEIxpP",
+	"qgXzNAwB77kY3mBa1UTeNr80Xaqvqc4bYW+5GWbBLRwibmIYx+sZesr7wOsZO/iC96UzWNkVM6CamVir",
+	"LzgAQRafy0LqYatf4yCMQ5+nMMyG+LYay1QkSQn3qaOuVCPwkoR0s1Xu1YnhMbF85CLCF08RNV1umt/k",
+	"+LOekbfrr0k3cojlpBs12zzpRkkQq0o3etxXkW7UWK80yitJNyYHrWkWbrop0q6l6btvBt4Z8SyNkOZR",
+	"QArjSJNJDOhhkIdDdraulaVVHM8i3j7rJNRH1iD9lDCX3t0QqHYmHmkUzSqHF3NEM7mq1Dvzly9dKcwt",
+	"O4og5oi/LMc6GDS94hcvwtt5pkNgYQP3KlVTOqQiPY5PXwypIFnXZ5h27kpEfGOnWnku/moisFnYkCNx",
+	"eaNoun5HTqOr0qQ6m9gR9OOE3tsJNbiCbfJkbB+Ops20KTe/+KfvB56WRJfo4VDGlmzKKYfOkm1HmCzV",
+	"cmrzRDcN07pa9+Sa5m0ceg84zVbSZuegtBro743KBi4DHWcZ1VD0VtYrzF9W6hA4DuKr9LAcyJiIwF+l",
+	"U3/sIX/1uXzoYXv5DlPHkZw0Wmm4R+HgyQWty+1OOyGhKJU0z3wPpDPJxP/ut4IY2EO+Ugrk202bIYst",
+	"BarsqX6TPX/iieNXSFcgxikm9h9ECkTEC9Yc6wlUyiqPTXDCAdXzqC/m/KT7rxl1hvkYt5JBbxG2ycHe",
+	"D9MOt3V1HLhRGu87RIHIyPkKCLCqwXTO8YdD/d0P/xhBh/fHppsbK5V+gdVEtTMNXS0v6WwQ+uAYntwJ",
+	"+Bb6zHz/ZAi+llHXOgI7Cr4Oe2QDhvysS23krCWf7CH41A9cSNaUEzwm4NIf50wO+otQvyjxEoI+cgQL",
+	"V+6Y2gFS+Gf1aCfodqFDcx71ckvTxlRoALRt+cYunXISAC4TMjAVLjCzivDjxP44TsLDxuBPLpaWjNaX",
+	"TsyYxQ6mJqcco/dV2eC2rJPAqF+6WPu8NpMep9WyhIdQLmCy+pD0BD0Re/JnyhARzhWYAURY4AtX2IAH",
+	"PmoRsWWQ4w52xHiiJ/U4drUHv1yhjcWJdAJBqjJmII6BKFFr4FNo9csHuWXslrNRGouCA04YJXU40rF6",
+	"KSEVqw/ydwxTeG1v/vXMc5a6YkhP8BzuB+jdOPhHLudpUFzXz0IwxD81HGGMiwiJ8ZF5QqAGD3e8i7jV",
+	"F7D61JUEppD4CxCTv17sgSBczLTHp/AQtQLfR4Q7Y0AoQN0usvjvDVFQ5njNI9qQL4PzQiSeDG9Yhteq",
+	"oIclb//fdHW8TVDC6/vum4jUmHwn4dOfoJ4JB3/tjBrdHsmrTt794FRKEBH+mq4v04VqEguMFypgHrJE",
+	"BHniXg2j36fdHoXb86J9FHxnkrG/nbAS39Mszb1afpzcEAcz6dI3c9kzJUpM7RNwoWBXkwlkFvghh3/P",
+	"vBiL8WwsfnIxgRyxyGfTxt0u8hHhclImAIwBYcnMQomTFuPV9O8tQrFtTX+9rJZLLcIp96a/iPRELeJQ",
+	"Ogg8YTDwEZ9uciY/tsgIdYTMRaa/36KOSBlEhExjo+mvInxEfFagD9DYBPkAjVvE82kXOzMD1NTPLcKg",
+	"60x/bBTPz1pExAi0o4iBqSbJQIe533BEJ9CZOX4xhZIQeF/9BWRuBdQbg0/R1CKKZC0HQFViuhADVeYo",
+	"zPs6Zikp1ira1kGlObV9C5dRonbyCgrxIbOeEQefkVZzT9q0E0cqHmH16ckQdjmEPg/FAcQWyyc3V/Cu",
+	"iQ3VPeK/v/+cuvn7Lhxm9Lq5UR+Aj7qSqtXR6eah4JJw5AfXDHUDR0f1ius6lJ0UT9Tyy087dk+klDJl",
+	"xBo7FKqtkDmtxOwJMTRFPVyYBXE2XFy8C4sJzE5nCfkSMwVJpP/Iv4ASRKmnVMIcqHbD6wXZ66GAGobf",
+	"+YgHPomC9lokzNS1DhgVO8sCVxxPOKHIBitueov6PlJJWqRvuuokSUmNoyBbKgJm/lGkJykLvyh9EzBM",
+	"ek4iXlRCMHMs0FK9TRurvukUZZiF22apoVpEHSkoymbq5ADQ/51UcFtEJRtVTVVWUKD+g4ktlOIoPV10",
+	"bl2IHRWYEHIPNZ/4QfQ0EjYKk6jOQ7KJeJdExJN5F3BCi/Y01k/g2zIa7WoYDGKsw11BVQJDIYvxNowp",
+	"fQ2sakTJbafQCY6SCltm4RAlJRGbEpsUSWyMSIwJ4i6zefTmbxUyDpiuJKZiuMi4N3+ocL2LN5GZAgaV",
+	"4Gaagq2a3m96nw0y3JRy9c+PNE+seUZNS8vqldIQuAHjUg5CzxZCNtjcFTerDy0ueD7ULEpr7/LtSZm/",
+	"NDU2rs7m4GFaVpKF6VDEHldVsHqy3zTFSIHq5zKjXIquP9Zj8eznhgmleuWX5To/OUxDdJ1LbSbwV9nG",
+	"BpVScwP3CKiSubu6nII5B7CSGmDV9ZSieSfh8Xw6xLZReSxGWqLw4K6WQUm5PYJa1GdFfpMKVzjicilG",
+	"lx/udQ4RlJLh07OEkjDqrmLR9VKhFIxtas/DxoJpSv1LhukFSofiVIjvgSxrwKiLuJSeHTxA4KFHac9B",
+	"Kk1dD/N+0Hkw2z7VWGZgwok+PbCg87AWXplTMGq3WExJDI5oJjtpVSe8bMugSQeIGEEJGGrDgNO2WKjB",
+	"qCECT/n6jKECihhCFmdX4hQwcZhYpB0OlZm0bRWStfDuZ4hHRuNw1dKzN7fYg1m3zySO9vvy6FtLMMul",
+	"kbZmMIi8Ls8JZ/gJvjPV9VfXtYAM+5D1kd32UrdRILJqBMJGAje+1Y5LQMm7C984XUhgD2UT2bdU1hf1",
+	"1KlTbrPE93+pCbPhhGzNLM3PgzkJrOdAIRzIBC5dYcIX40uBagixIySQVIoKh2m7uKfTYvYpTaUvaTHw",
+	"A7SuFQMNQ6jYqkGQ3U3QVtQomgGIGVIoZ1ksauib32wviBPvCGMYsH4XGQgofoIEEt1Sl0C7CnYvca3+",
+	"xCUtx3itqzmG+1WuZsNw87YjMgxM7Msfungn5ky7ble+QbWhPRxCX4MKZxPXZuK1TioPHQSw6wYq44A2",
+	"Z6gaSLmF3ilLXkdTjxlf/opebCa/TD4giFI2ucRHlQGC+gMGRshxlKVKDLAu1Bil2DVGsNdTz1WzUDBR",
+	"RMpoGizKceRHYFMrcKWayUIdTryE1SslsLufL8ygiHheNR1IlCcLs/Dxu6djlwTgYgjFwFoy0rKVkQsI",
+	"GGJKjaxR6SctlDvZTtoIz+KCBt9U1sJkWxbfLjbkkPuyckMOI97NUb8n7pmNPnedDb9r7e7nN//FFFJk",
+	"d9bEjunkikyc7Ib0OfpuDMc0o1+8IPVO7iFfXHkiV9BlVJvCtlVpCh+pNat/ew609B/xzxb1xlH1Co4Y",
+	"b2WSljVoqxTMOgZGD5JRYavSou7JVz7EpFtUcm1h2+9mq1c/7Y7k/dDGzaHfQ1z+JK3a+si8d3pkktrN",
+	"q5Kfkp4NiaeU6ETZn11Il9IO9DPfp7kN9TL6gL7PI++yJuC5ZB42CrMPLXuZRbOYLFni4y3qnCKjt13P",
+	"fAIt8amVAZ+g06M+5n13DURFAeNnU6V1RG2A2HRiK1eUFgkSlnswQOMcANHpavbD+jRw7ER2cZW+HPmJ",
+	"p4Nq8aIIWpIrg1vUkZoqlNkHBW86JJY/lqCDYggHa2X0QP64RRAT1wiWAnBnDL6d3Ba/CwLuIAAVMNrT",
+	"IPJEACUqAp0FIrRIHTEs0+mAC+iiabSoNwo7u0b8tvyhyoIWN65l0xrb002b7Yu9anb3GG2d78HPd5vD",
+	"W77bPKo+bz8ftI9qW+ed7Qb72ihuD7PUH568vNTxVf/Mqdf3Ngm8vX/uXhXv+Q5m5epuu1Q42+/Yt3S7",
+	"Uqk9Hl1Vhhe0fpdts4OT8UG17TvZ5kGPfb2n107xcetkv3Dr3hcw6RSztdH4pMtgtVq20P1BKYvuss+4",
+	"FOw+nT8NvuKt89FVsXK22zm3a4fn+ZftkwNrVGlu3dj4tlitXwfF0u3VyL87f9r9XHj+3D0PYBUe4893",
+	"5aMe38S8X9gqnXVh/dA9GQT7tSvL6nd4Ozi8z/af/Mr9LuFN3tj24O3t1ePe7vPZ2f52I9vs7g53nwdn",
+	"+5t7te5Z47L5tHtXenkeOpXRy8HJU9EjxX5n9HT26NX6veuj/PZhue4/+Y0Da+d+8+AMDclu1dvpbxb7",
+	"IsW8tzU6G1z1Xto+RdaAZ8ed4ctp8/GwcR7AHvGezoaXzc/3+0NUDXqF81Ht5Gu1cPNovdStzeHLOSvs",
+	"3PmVq72ydz3Odi5sb2+/6Q6LdyOLX5/jE7t37NCTUWGrNMxv9arXxUGZsObTV3fgdardrOV7vYvTintV",
+	"ax+WTyna89q1u69fe91O3cdbF/Tw5qj2+XywOz4M9s4eT/olp/8VHlw8BrejQvbUOeheFrd6n/fdfveA",
+	"DKoEoZ3Ty333qL6Tf6oNNm+y59S/vr9oVs6/Vt38oHK4i+5vbisHo5Pjs9P7/vH11naZ4tPNm1qWDb96",
+	"pUJQPK9cFNvXJ4ej8/3SVaGz94S2sztoH45H58ORFRT9+qB4sOMeVLuX8PIE0i23GwxOi4dmhPamMfpo",
+	"m9XuBtbuPdwf79GT2/129exx+znwvCDvvOD28eeb5qCx9/y407Dvtix6mKfYvR89V3EBuc1igPLXl3DH",
+	"9nqVo/HByba1x68KN5XtfOGuHvROy83aHhzc9yt0hxeLnz9fofaFy4P7r9ZWacvdqha2jwpHw/oOa5Sf",
+	"r8fFi8LL095Zl+wfXrts17/fuuzs3SN8Omjig8e8eUVP0yti+07xeL/b6x0wWqnvXUKrUG8fFnqjwl7B",
+	"bxYGR5fF/rDqOIfNw03U9Yu7/eD6enjePbDOPbLnPKHb3eHLC7m/32k0riqfvXO71Ia96hY52qt2vM2D",
+	"607+5Br7sH7xdN0961tXnba9f1TZLvc/o8/bt506o506JcFj8/5i87kEa7vNy93Nx+Gtf5c929zjz3ej",
+	"7f3tq7bVGxhXhKYXVLwqHhhbDmYOE456vaAyPvJvT+Hwc/Fu++b0umfum/YE1RKfxB0j3EOq5YkLJpJO",
+	"lQdO0pUpvEiweCNR7VS5VJX1zELropvVp5QhkStSvKdLxZ52RV8WChMQnNyeggbiLWIHAljxFfgiI5dI",
+	"sqjuqjjTrdZcQqDVvSFgCEj0nJgD4LaPyGQjVbQ1KcZEM6/Hnj8tkoRNPtuoNqBxfHl9VhYDSNdgTCw+",
+	"NUEOgE+XBLWIPiFxc6o05tHwauUu7vWlc4qcgEF3dj0Ad0VKXjRWxcviAVqZAR+HpyUOeS1cXifgconi",
+	"VR/b8uqWoluLCDFpCB3RHToc+QRyPBQ9lH00USI0tqa4uTW19RqyFolAE06kDGXjFOoKyaav5M3d/Jbd",
+	"RXnY3etu7+ybL9xB2jO1YZ2pgo+UPGjPh14fW7EY1CJd6GJnHB96KAGtR67crUy9UWxllCJxWBJF8MKZ",
+	"9bYq0ahF5shGYFo0OkVjWV3ldwpA0enEwK5wOPVG0XgeZJrDDJtP/vP1+KrmtAt5+NTdGdwdj8pffeRk",
+	"T+mw6u2xz2h0Qu3C4d2xs8dvnfrBVruO3K3t09FB98k5vTryNskT7Gd3No+3T1784AnlrUptZ69fO6zy",
+	"3dsnf0jck9LdM7kIqgc7W/iuHVxf390dl48PEKyVGnV6cfJyMWaP9GQr36xes9MDjP3+AfzcLWzt1O46",
+	"p/jYDqwzNLzZrVmnzyc71v3ey2Vhb/fp5OzgtlFzs5dVNBw9sSprVI+3t6+4u88wreBBf9A5G9Gn0e1l",
+	"/ah4nyfO7t3w5tLpF8v22eNB46lRRM3NSi0olUvkbvRSKtf3LvarlYN29dFu8MqglPVveOF05+C+W7Pz",
+	"1ta44rWHx/XNHVS38y8ne3dXn0f0oLR3Qxi0dg/5pnfa2Nn37nYLO7vl2m2wd122Dy+pc1qs9Sy73Stc",
+	"eMXPu1YRVtqPVnMv/7h/6h++jPaawzw5OThCLCg91i4ej1j79MUe3Dav73hh65jsX50w29o8/xrA2zx+",
+	"ujtA3vF9954+XSDn5Yajzc3PfPtgdOPlB9fnuyOUrz6N70oO/Nq7rO41srflg1GhfTm03T20c+H274sd",
+	"5/CGjnvW3d799i493u2cYli66p6WKuVzq94r9V30uUgPN8dfv96zWgcetbeqjzW/tH12cH58Ndrzz/ft",
+	"28eTvar7iK3h102v6Obt5+zpcam2Nd6p3Zz6zwfl7uZL45Id1AeXjceDvWbtskxOzl92s4/2Tr/Jvz57",
+	"X2ujGq3aJUiaX7e+lneD+oHXdMsj7tWP3N2t6yu26e5UbmujTr5ZqFbM4sKM/LN70Rncjcq3132cRfWd",
+	"nVKv0y18rgyuyuXbKnQvYdnP+4+bT/nuZrdy+LJ5u1Ns5/fvh0f5/QoebhYvC9nyXt3Z6zU2bwYvBZx/",
+	"KTWeyHi8V8wXdu4urfoQX56ffibV58Pty+N27ZAN6J7Nq35nq+keFvrXd8PSwH0Z7R+yZmXz7Org8qi0",
+	"iwN21rxDXrW0+bwfDEqnla9XQ7vHDy87VzuDF3v/ov+Utewnt4HOb87oc+3M3uw4Q3zT3L9x97LjzUKJ",
+	"33vo5PgSVsv8pnbmHPRP8AGtnd72tobPDXf77rnq4ItL1H1CvarD3GYVbp17jd3bW6d0ujU+7kOev8r6",
+	"9efysz3GN/ZRe/RyMvTy1VG7MHLZZw9la72L2v3w+vZ4/8Tr39Z2Trf27p8OD/BJ5e7KuPczklq+uFlx",
+	"vcvb+v5mu1689Z58dNuAwwv4tbNP7tApPqD58tXRwctWodx5On262mtsw4MTt1Pvn1gWfyydPUJMsngY",
+	"8O3Pn3nx+tx9ORndnJTLT4Xzr5VRaf8qu31z/9WtXG8fn2A+vgoaL8f3aHe7/niRPWxfXOULzdv+0dbV",
+	"0e2uT592rZ3P1v6+71+zw+bo5ASPGnvnO97mzjkOXna2yvDycZgNnp4qxUp3PLrYuR08H3d8eGA9HvNz",
+	"fBWMrzpXT4NSpZ+lkBwPRvcXaMzZRb8ZFNwrd1TfKdcLPv1aIDVceSztEmYPssXPh1uNrdH5y/19ZfT5",
+	"cdi5vaX3t3R/eLCdb5/fF+7zlfurxpNVfrEOqtZTO88Jgay7dXO7XbGfd929fP3lslOoMOKQz8PTu2LK",
+	"3uMZWXF83vZ2T/y7cQO/bHJa6Z12bm+yJ3Z16/Fqe+wF+37n/PZ5y786Oei6PF+h9y+96+rXw5vKoXX5",
+	"NEJu/WJv84WWixBmDwb509uLo/LjyfHXsl12K/3breLFZ6ea9YLnQds9/np0srnpP4/re5c7O3dnDbTV",
+	"qLn17unoa3V3fF3Y/vo8vDqt3Fe57djXp0cvl7tVuPvSPO0Xb+rXaelJDd6tDIFPrYwKipKSY8BQK7M2",
+	"LSJEFpGAqdTj4se4V05fprLzpACMXM+hYyVMhc59wkuV95HfIjAxSCQuCykYaXsI6QFZ4kbEBCEfd8fR",
+	"625oPpF1EUSbHLiJBVWLuq4sy9PKMCxNQFGHNSWtIGKJn1FkeJmxlTFsVgWep1Gju7d1ebJVLjxXNg96",
+	"e8Ogs8/P0Gbv+Pzl5vMu2msGbD+oHQ/rN4fmo3nesdKkuOcdCeVdbie/Dyzkc1VJT4btYZIU6hJij/wm",
+	"RH0qxGnqK8Ni7bR6lxyCgW/1SmmnsJf/rqWhmfGlZpAsXiETTkk5TRrmxBzJTkqMUgsT8vwhFI6h6u9Q",
+	"2FMdpajVgQztbmeRdB63waeGMluCbTGuAG57d3vvO8hmW0RGJcrmge+EPdZA+bAOvlWb17m73f18bnN/",
+	"f/v7zDoVVDnlBDzzUW9biFYCD9Uqzq8bTSFbil+lC0GLJPqtFkoxnsaY53bl8KUe7Ltbu8dnF20eoN2d",
+	"/bML7+521yuNGxwP7h9Pq7fVHWi6lmfzB2eUKqDU1HVpEDXZcaNS7IYUlvpL0nOdBh1HlzzDrnga2M/L",
+	"amXqj6z8K3A49hx02c18yefy4n9xDTNdu05MHBV1n505+jR36s29ibk395afPPE2NpVGWWernjV69iEh",
+	"yDF/e7NYIRszTwXyzM5cjr7pCl3JulxxDS7IZUyY9Fi3kQg+l6QJgav2qEXOYVRSQDiDKkdt6XYsdGnP",
+	"QwTZ8uHoMdCOmQ8skKXPHnTY4rLmfn0sIeQmulkye7ePLOxh/Swx85UhYrejhPjLZfvkwULwjRVjJ9+R",
+	"Z/mCrt26VLGWOUVhE9FZK4yge75VtIu5+r8uoivbJk9yXVHneuIdfHL3Jg52tbQN05g3sw1TDaZiHjUR",
+	"qRIKMku8uHPVXseEVOWTpTnUUkMDXDjGJxXXIAhKk1E3cNbkk5BoNkF+1LICsXnvJ9XCUvEVs+GWSwQ+",
+	"6G0EIc9bLk+A7NNeHCwQjt5BUsDUc6ClsqiyFC/y5tQZYxYN3CKHyvrVyqjjDi1m+siFeVB9AJMkGyKg",
+	"4i4V1ZfoXnPbNlSbxKO+mkASlPr0niLhTLwhcZwJRrEKnQuvoQNKE/f4t8iHbT1DApHvSzdLFi5ZoVDS",
+	"t0yUSnViuGryLvoWXS6zrXa3l2g3lYli5mRkm9/j7SP/jRm4uD47E15M2cTSaciYJ4BtqA358teCLWrq",
+	"bNpz0COtq/QEmxYMtud2q8EeJvKEzxGHBkd6vbHzGFk8Rk0zMtGrzfDLil0b+EXd4aYckWkhmUn6kNAm",
+	"pw/HMlHBFNgSe2TEmyw3PO3F14sqBH3azAqNS6aJigTvTZPAZFhdcpad/PoMwoY1iKSkCAQj0WuKBf58",
+	"Pr/KxD502bs410VnZT4jn6Gw4tbUEhBZGIHTkD6FNV1NKHEXmgViDn2+6pA/jFBzq1+NvKXN5fdqKuw2",
+	"amWushf7XKdWmRN9+9Sxw3gr5Tcbaj2qjJMzFt5U0fav6EWb4nxkWvpVgK2BuTiPykNuzl9YEt+AufZA",
+	"apbaMH25echq+H21Yc3Z2eSyVOo4c242cznyxJJnADahe12m9vmD+fKWrITyC6k1ew62BvQ3DFl41TFf",
+	"K7/070sEGlfRmjFV+0sVOjDJkuGoc4oPqPOLd301UbOOoKnymR/+vCD2SLUzDzyRFKw59iacvA2fXy9u",
+	"1egnn1La13hgtspHIXRmW9zkMusI9XXcsBBNJyvxTpdaTi3GakK7sA5rMVpbhH3iopCQ8GR1VpHwRBdm",
+	"ZWGSiLjLbHsJom6/vI4cdm+L7ulJ38R2hnDLieYleOMyWD00faRPaY7Am0gcI2V/sRNRtjHRSdYOY5PL",
+	"v66qxpAAmVcKPAg4H8Iy/ovocBKo6X1JZJpKrwOdmUMf0ymxfuZu+W05npayYr5hFprQh332C4bLJg5M",
+	"MigjD1azqDHncbqpos6/ylicsNzzTzOWycrRMZtZhmfI2X+eZ8wnYAnQJN0uZhirUqopB9wEAGaqFP+6",
+	"FLmzCqklOiPr4awhTX4K3eq7sryoiv+VI+rPai/BJ5Tr5cADJjJvRFsHHTysgweH9jBph0t7WJOhFsni",
+	"2g+6cdtGBCPbHFotJ2vbqBP0TOV4O0EvNvQmX104lXQKfMSoM1QPiZ5POw5y1TuqjYbIERsSx1urW9uj",
+	"TNj5dECMfiEX/onyRVtf0wz54mQd2mNzwU5AOwt89FeUC1Nn4RFMRmT5BT6CtgxfUwubM1Ufm+JCjjHh",
+	"xq2QnXLpFtbU+3KiePBxs1kLza7J2pdqHQo1tvObwty6nd9am0aA7Xx+2YxYmgaaiSrbYf6fkNpUpViR",
+	"n08gnphTOJMmImGMsXOmovxGopDyiuguqTx2qHyAHlYZAjo+HTHkKzwOAR57kWARjWDcd11S2hTbE34y",
+	"5YsyVURUWUj1g6DIsdWFTuivq4ZSOeaAQ4m0Msr2OWNC8kSu5JTbUcZ96mGLcWvxZzPkzeLkbxV7xiwC",
+	"QlbQSo4PIJfwyqflbBdafCpbs+6kjjz03g6FzfgCcCDjQHefnUThpWgnUhgqxLX60HEQ6SHZXly0stD3",
+	"2vK3xiSYbRfxPrUXGj/0xk3u27nuOzkq9duQscCHxEJxbb55gxeTnYthX1m/LyMTagusNz1nqw8y520f",
+	"My5UXNqVyVXDbL0ska43eZziZJY1/Oi1q9lMD9DzxIIk1kmhYGzGMT0GgHz5ozQmUtbjVcvLPFMls5gt",
+	"Y/uSfRgLliCxKmOBOMZliEsNKakqvOPQk0iyJZijQ5mMj3yYJvGH5XeKizwk+MVUC6EZfor0wegHRYAn",
+	"t8216ZyKGngpKqhMmPLSZYjHxP0QDvQAngIhBsVeWGLNOv0CVImsollB+H4NdKCGBR1HiSEbetaNUZ9C",
+	"Fz/klrFqfDfeJanEbGLrIlohcOAEh6O+Ym4ayCnet6HutlkBGsJfZQYRyzMi4G24+VMApbDN5RHIjXYn",
+	"fCmdn0B0PZm2VGcj1WlLdRLTRLbS6USmw3xut+2gHrTGbX3mSQG7Kd+mBTwhG5sBdzprgiH1UKIFwLa5",
+	"6EBKGd/U1AoiCa+g2cn0CquMnpChTOiZ9DgVat3kKetDWg3j5+bbmMB4pgONRsiPdadEi4lbJrfi7WIk",
+	"xsRuhDkBPwm9dg2YVzJ7NU3eXSk3qMpEyjyq3FQkV0qT5bCdOopAft9e9ubxwuTphseKmjYTRClsHe3t",
+	"MzOMQ60UJD9CFJzRkP6nFygDimpAz5NqYoY9Yxi7sDIXxadFAC7Jjydf0WZU4DNM0JJeaRZ1Apcs+1o8",
+	"C8kSz/KqTcPBFhLeABU9z1/zPF01Ehu7Jr0Qvn1Xnf76oWlNlhVRjr+cbohMH8qxWPASYYIWQh/soZwB",
+	"9ZdwHUiAKFpXCUf+EDqzJ6BfV2fZKCJRujMxMvCQj+kK90r0ymp0E/L5Lww+hXtqpnW5FBMSGt0lTCBf",
+	"k454yBM0ED3mTvMIZqYZKccEqn+SPy6cc+apdZKqzO+H10TZhH4BUjXAHwBVWm41NzKDqrmlMLeh9qbh",
+	"0lLfhYEPgU3ljXGGSI/3k24RMZwT4xUWjFcAn2R0gI04xA5bSxnexC4so3W2hPk4ZZAEjNIU6S/UTtTu",
+	"lVTjMP+3A/mfcWD/FjqRJxbtUPLHfNhN8xNqLCRDXeXyrX29NQrILHMI2chOORHTsXqUceikmN9q8iMI",
+	"5d7UQXzUM97ddfX78tAw7iPE0yWKhvweLtjMiOUIeivTBoh2elnAAoLTB70meOUhf8zzrJslhFmOx2gb",
+	"Ol4fGkhedwQF4CCuIrNoWx+iC59D6AoTsBZMwl04yVb6JFvzJ9mamGQrZRISuMjHVvosusFPT2J2gIlG",
+	"h/LincvFfqSy+4mKBSmPeXHiTKND1FQVu59IAmgunTaN/IL3h60SgSIyBWskqEkTCJRuw1im/u77NOj1",
+	"1eObqHoQF3d7ODpsgo1Eis1WkM9vWdiW/0UqRbehGtuScEVZyBVsEz+pWiTSOM6QtsUKG4tQDGRMoc4r",
+	"IdMxRdY5bU7MgTKVzy8qfCZOAJB80lFmI3E0mANmURFMaIANa0OYfhl/i/poQKUBbRGZ8VM9LU/kyf83",
+	"A+HMuVesWPYbS5Hxf34OezzxBJmoNDAh7ieKduk9CY/LKIAyZHAqNXM/0XYFxz/TdDcaM4VlQkiUs1Mr",
+	"PjRre1K57ozffAQZNXWbggqTTDxQ1M0IZpTzP+aYNVnSqpcsqTLFk9uEEsvsKaG/tcMXA0yW1OujV/BF",
+	"D8/LvfAueJyd+dyNlPxF77CrvJIusb2T0VFy81SMuOUENopKmviUdrO0m/UoC1n2yW3T+JBqnpTS7uxJ",
+	"Po7MS5bTRdF56RbM1KkmVyWHk9ZGEJcWTJZtWnYZc3AyatO2fdjl7Xx+tXNesCrzcf3MagweWgvqcEg2",
+	"PcHME/PGEv/yfl7xGxWSUp58MlDtp2L/C/nN7Wx+M5vfbBa2vhT2vuzs5j7vFb5mvsdbufoTnopCWy3K",
+	"bBnJL63UyQKvMtN2yIdr3enV92ROstRQY5VNxOObnhl0Kf1/ZZlMi7rpNTtN73/VqJYU7iZrcspXZehI",
+	"n1vQQYhEZTknF8z9AH03eSSEzVesh6qd6WbXriOxx/EzRygZIVdcoesZ5rLpTLvq08LkAJHXXbxEAUeE",
+	"Yd9N4gZDVuCHFYY0WVE6wLIYYniH65/CGupfMsKXWmXaj962osGhh0Xq1h8/ZERDl04kqg6whXxdoS6T",
+	"KIqX2czlc3mVGBkR6OHMl8xWbjOX17lqJWAbkEBnzDDbQNrA20PcLLhiC4g2AUx6PHnaOC9uHEuQkwdH",
+	"hMnKvvI7w7a8mETuY5WFWPQcYiSMh1Ea36otHyZ4UQNzKGBZz0QPw+LIDLYUNXPl8EIKv5kvGfmeHG9p",
+	"F5FM8jwFSiZrWE2f/XfRWDFsuTkFdRsI3q2fOxLZ6jYetVAVjzcPlQ+jrVPHOLW/UfRynNtYnNx2Pp82",
+	"cATpxhEiyMeWDICKrjo5hQ4hWzTANUHPHrI4sqfGkAH5rgv9sfAQVyuIzzyznuGwJ8npUSKhMsNs6GDu",
+	"DR1FxVKxSrzdqZq/YVORmq6Hh4iEXmlCn4jC2nMzOCNGmAzkZYvwRnp7ahWMpETSKedPHvjqudKAWsng",
+	"wWRRNL3jhZ18gquHIl9kytxZFJz3Y93E6YgofaHhG6D5sMkWmXnYvm4i8kDo3Q6XZRkDnjgXKJRnGp5L",
+	"rkWqsgRHlPpPv2rb6wBzYFPEyL854HCAdKFt0VeNnAZ2FKS8HEGZM0jMrqoeYs+ChcVY9hvWlszM8Pv4",
+	"zyqJQwxPMj8M8ayySHEUWA90ZyBf3ZO85pcY1XZ+cxkmNeHnZBhka9lBqI9fDCO8Dr/Ul7/kPMlr/9v3",
+	"H9+T7FRuYYJpLcVKN/7C9o9UfnqEJgt+J7mqYHeKs1bLs3z0CE2x0UVcVDczGd70rBEpCFkjpgRsv5sr",
+	"OaKEWcx3YyL5J2D2dn578QgXlFdoQN6aLo4QBxDEaGikCxGHmBUXBtv4S0cnziULFZQrO+iKvooWRF8T",
+	"MYigTnGlGKQJ0xbETWbD4VfsobMbzNgWr2MCCwu8dZGIDY8WZaa3OHYznegWhoTOPpj6XDAVaaJn4BO0",
+	"beD5qIufwUP2Qe6w6KDT+0izfza2LKxJRdFzpGVMuranCATUn7wvl00Z91u5RowaK8nx/6AbLiLVHuIK",
+	"GWPqmkuwHLsoqwOH8QK9QI2ZDNPG8pqclf/FgQgjQSnZ7F0QbUXKhMaVCOrBNksRFyUVGvB+CYP4tJvN",
+	"ijAJAMAnD/qyqqTMu7+WAqT8z2oKxj+cbUyaUsXb69JS+RQSj03hFK7OvbNcShWZqWfaniVh0iMZbFf/",
+	"5fxsHt9ZwNUGmNhLMzTVeB4vO9Ut3icbk/C/Hw4WgfPBvN6WeQms/WBcb8+4Qv6SzrOG0MeQ8GU4VtQ0",
+	"jV/dxA3eHbsKgX8XzCoJzAerejtWpRH2g1O9KadK8JVUPrUEf0rnS++RIb0LRqRe2RJnoBKDmQASrdq6",
+	"VftPQxfdZQvgE+3aot3bQDiZRW4+lJNZ0/4gpMkEdakgqkZvBZt+8V0KRNX2j0PKlP/uXAhlmz8JmS9f",
+	"6LoO7KWA5WtvpBkJIpFPb9Ekh6VLmdVMRt5TGzHtzX2wn0+TW5BFf048WAhMCAiBbgRIA1vYwZCAMuoi",
+	"wlAaVLpve0aqej3wlPNLInejCY5Y3mqL9hmjPX5uFOMiMAJPBfRCvgownL4CKO9dAF03+PLJfECcAuR2",
+	"kA0IYoKqfMRkDDIDmIBQ1knDd9HRDE/okCa8oMyp49+XhPwhGr+laDxfJF7oAiDzkjhIDpX2trnYSUr1",
+	"T9x1Kz3jL+Ht/k8nwcU0Novwoa+2CnL5cCAwE0r4nr+YTDYgwSr4LNfD3VSiKcpWyAZH1UqYKIuHFCAR",
+	"Rnp+YeGryKjKHSiruT0k5hIzPKTRWzGE4wh33zft2T5UySI7FPp27MjbcaDIvY7tNNrrOtibL2Qupijs",
+	"wh7a0EdlWFEHE+iPDRLIn3GofZdUACIcX4IeQtfdHBv20u8QMWrYUmW8RqBxc6RRQh5SXNFahjxI9+6w",
+	"7JLVR9YA9HGv7+BeX+keRqoIXbgbw947JIpatANdrpPAes54HeRDlzeViCTaqE92IjWm3IfAl1XTwxZp",
+	"KonnjDNpsMr6A5GncP7vR8xs2PvfZ9eZJOgP8o3JN8IfuV9pNNxH0OH9DejohKFGwi0JwovidXSKW8yA",
+	"6jw2keGx/FR0VATxbxN4jjUEKxz1K++33BuxNaZtmbflURGClbZc9Urd8Lr+/Ns2XE3wrrY73BLjZk9W",
+	"sFkQLfItbv39U59zj33Z2BiNRjnqj3Osv2FTi22oWKoNixILeZyFM4yzAUN+1qU2ctaibNBjxpGbAxeU",
+	"oy9RnIAFVeFcWQ+5g4nKizj72BBX2FkYsyRuzgZ+SWO7fzyq5EJElEiomjpeZIWAkgiuzKa5yFW6gUig",
+	"JyhRwjDjiFhjIPNYgk+WQwNbJsxIuyytuNMEMKF+x7hPZV4YNESEB9Ac5DcLD/cxGiIQJhQCMYpp73ns",
+	"g2qZ5XS6+OiYZTikzA4tU2IJe1ZcFIR9aZFyoGgYAeqLQO0sepbwczGcrLaNe4T6yJZDi1a2CklSAUjI",
+	"lu1cKIItgY27XSSFiuguD5Nja8AU8sooFZWQI/A86suwIW2ayYF7GqgyJ1jEQ8rqR2Ma+ICOSKJdKPlp",
+	"/JoJlorg64zDMFiVDEQCm6igAmXJaChpzUdMVKECn7Rw5IzBTj6/lkt9EGOvZOFLpHaphrky/clUHPKX",
+	"T4I9iNnXgYwRXYtSGcYDCNFK5CYVJtZAWFtEchSLq2f8XItcioQvlMh06uZ5hahs/NLALhYpZROVCHLp",
+	"RtuwfztewGqeAmEwHCTg8K52WK+eH140i2cJ9Jb249vq2RkoHRcvjg5lppeLyybwkVwkiAiSrwNbdFEV",
+	"JUTOfdSHQ0x9gZrH1QaoFevF88PmYV2Nd3AI6ofnlzeHZVC9AMULcF0rXZ5XL45A/fDssNg4BLfV5vHl",
+	"dRMUL+7BefWoXmxWLy9Ardg8zrXI3O3T5xo6WKz9+gkzNTLmY8AQ9P/gOXs+EvGybfN5tzVgq517VeeJ",
+	"SMAm7sLwMm+RsAEk48S+rE9kAnoIU/mq9PkU29bDenilhgJDDsg4eZ3VOWwk8U4mfVFcpEUkGRIsZlF1",
+	"KkAhlwdFVatVXk7rid/rqCs4ifoQKZ6XHiLVsrhXCLIEf9XfRQXgIcSOCPFP5TRqwYldfiXGI826idtE",
+	"ElQHidT9KqNsFK6VTE6cBmayzfQj45uEEyYzoC8fTxj2MgUS/rfGAk6IkTNC8nompdp2SRftCSWXFnk1",
+	"8TiUKaKLXXAraQJNFMn5hl2P+rxFEvxp4bQuJLCHsjGgG2oUNbuutcMS39dapCtLqzAuk+eHrIetA0Yt",
+	"wTQY7qksBJR0cU8XJGaCM8md0dUkdFmHWUH+QOzuVElObVFCjIep6F5FNzMV/pTU8pylPhYk4siCrBeK",
+	"5NX336grmpaewOMZMu7Mbf9PiZjcf+vEC8syD8UATJpLCh+hLLWImxAEQ/Yx/kXmARbyDsGqJNmDvzfv",
+	"UHuXqLP3O7jG5CQ/yTQ2Xw2c+N5Pf8b8YAZvxQwgmSj7ON/iFnkUqMSNBt6g04BOavnY11U9YEfkNyU2",
+	"8JDvQqK0ejWWUsNeiaPomG3MmchhoNgLtFQdA0iAttUFxKYEzYguSs9goJDfjkvRxPlQIdMQ24LqzQ3E",
+	"BF3xNiCVHJVC1YIMqbfhFoGMBS6yowKxwjIkE2SFI+usWbMMpCwbJE5srhUxTrqQyO1WLUcqwysnXFji",
+	"0eRQVDT9yHvwS5SrcGAR5a6bbeN1id2veHtLP3VNaMJcKAiMytlEFaoW0eryhM1EOWLOXqkESSJla9O2",
+	"ATGJsq6IXx9mVfCZClHGzCUr0I20enZQaI6IE5dEhD6mARhBVXVRbPVr0NSH+eXVzC+hmT9RRUrXjdJV",
+	"pKZrRiXKSek856LrAOmc4V0siwww6IoJ59euemOnseUlrg/e+zM5ZxYx3hT7S02ZmJ2xzvvJJrjwv3/1",
+	"hVKVp1Zc8pvMgC0173hUgUQSNJHic2NNvyJpz+sHbItSvjImodSHpCeqYD9Iin1IsO6HCQHKU6m3Q86M",
+	"mdZ8Zplv0gjw+uxXAfJ6Us3r62QnTAR+catfplbgiuHe0o7z36WS/Qp3+RspdBK9lmBOgbFaVlIDMrGn",
+	"XzfxINANHCci4BYJyzp/Eq+/3oRpZ03oLeGe5IAqEOrRsCwGTVZaSKg2iRHEm/QIOc4sK5osHfJfyIsM",
+	"tVM+mNEHM3pVZqRw7CesSxsJGt74S1DEXINTrAu/ljobzx9qtrKQe4to5ZZQHtYtCVULVYHeVjVzJ50D",
+	"dAEfKRsVa9VFBp2Emvemtp1Z949EdXvRWLC/KS8ItSm5Fom2JbEa0b+mf28RoYhNfxWVb1uEU+5NfxG1",
+	"21tkQl2bbnImP7ZIqMNNf79FHYGnpCUrYU5/LVFborECfYDGJsgHSFxZSg+c+a5+bhGhHU5/FKV8W2RC",
+	"YZxuUte/n2EymPsNR1lbRXG4GFPlkYgp1Eur9LYX7Rn3IUe9MfgUTd116GgtB/SdKrBZvrEIL31tEUie",
+	"qwq50yWfcmr7Fi6jRO2UC1Di2TxEfF9au8EeM88jTHsHCUyeOJwEbVQwsae7dcZR+Sxp3Zqu7CXY4v+d",
+	"NXv8H2miiQtrpTrGLeXw9WHNfWNrbhJjVOLZyN0Hr3iF6qoQ7He91IjfMQlLbylP63DOaa+lRDZpvYpF",
+	"d2AjhP6f8LjxkRH6DWhJlEAs7IJqhKJJOVQU5IsxbNlnE/MT4a8ifiImYGm0jyID9Lvl8gUqQEVmU5D1",
+	"hwGdcOD2HAQZEnEuNhDkrxfQIt8SjWxtupJ/pUvZyKIqSmIDejhrI/G+8694mLXcOw1paBFT5Yy/w6a9",
+	"asDFn9UrVO1KJdfqkEGZoEZRU7IOR0RnUR0OHVnJUQ6kluGYoFDooygWIW1DoxqZvxTR+AruspofLOMt",
+	"m3QKHUfcDXzcRG/sp7v8rSOkOBdtyLL+Dg697syS27Wq/i/zqc/mtNNfr/XH32I/TEwRWg9/fMhLb2Nn",
+	"m0KGZeUZhZ86e4XsrJ2RgBzPEIkvehyobyFyvaeciRpsvRbpLqJid5bMnRo2Xy06Jm3yX0jcmFapclH0",
+	"yEcq13SGxUz58t5Voqo3uJ3c8STeruIDLjsCSKjMH2GsjXMQ3hG/6RaKxk+/gjY/VPZ3LyjF19cyctFC",
+	"B+TkfThPNlomi0ySOn45m0xKwaQPO9Pby00/4T0b22YnkKSjU/vOOJ4mBKf3i3mv96SvLtzlstZ9sNs/",
+	"7b9oRNk01hslq2Dz2a5ql6qUqs+/VytNzPGhlr41e53Ch5X1Ug9R4bqulVI1mlEnrcQI+r400phy/rQ6",
+	"Ojnzhy76oYv+DXTRbpKOl1ZEFfXP10Qr0b3wm66eeIIPXfTvLBxV4jtrKYloCW20OzFkulS0jFYQTfyh",
+	"jP6DpaVf0kZjFElVRSN56Z2i3IcW+t+hhc6iairD9TEidlbf3AsqfQlJQrYHUXuj1iDb1OMm70pzmFzA",
+	"H1cfZqe3sa9Cs8EnTCzqimOjPqAB71FMemlgRN2MCTjDkcQB6YGW8sGdAPNDxXl3Ks4EbX3oOtO6zix7",
+	"WjX10uQIKUmGJk/hd2YamphpgQb0OvrWJIL9saTwH/f8z+UYmsHXJW96XZnFspAnITYTRVF+X0QUqtU0",
+	"USwWgNWof1b6/TATvGusTkO4lbDagsRCzvwIDdFiIbOXrT7w+gOvf51bpyDcSnhtI8vBBKWz67JqsAiv",
+	"dbMPxP5A7FcIC0pBufmYzZYJPHec2OTAUiLbKtHX3yGET8zx8fD9LiLQYnxY+eF7DjbFhqv3abF6I0vV",
+	"hwno45X7b/PKnc4YJu+ehW+LUdx4/Pgje6a8/CRvifcoR31cT3/8ekp/eFn+vXEeyonHxneLbx/PjP8l",
+	"z4wLUF1w3acAW4OsKqg+93FRNowqr88KZ1fi+5H+/PtDhKPplgkS/i8skT95XMajF/tAeguOPWxkOvJ6",
+	"9O09yeMa4l8UjNMqIC8WjWfBEKcAOHYREBjUo/44BSrRsC0atsOG09WrfqJC84eonl7/FXIjTO9KWP8H",
+	"M6mYuZgZlM5OtiGzm6VaVacq6OhKXxCE3VV+QlWJgvVp4NigE+an0clqVLAR0WVFBUnwPnLFVz1Ii3yi",
+	"vqyjMYQcrelSp7qMTlqtm2ROuAr1/0jpm5Q537wSTtpeGAjBT2v6IUW+VaEcUI/SHqpEg/PpVeQa/AV6",
+	"Fd3fkl5FuslJep2Sb0wXoEqu0+Y08wZpjefC/+ZZjtP2dQ7tzzT9oP23p31xKGm0r886VZmoh2njgIhl",
+	"F7naYCIhOtDdQVzwGhNB5QvyxulJPwrKG/Ob/Yk6K+ERLEzNJaty6NYftWxlLRXHSWQui1B5HnlFlvEl",
+	"bZRT9DW3MJSC4FdqAqhJfktxqD+HysuaMz/uk59AeRM3SEX5RCbfVPuUMFnMZiJFz5hx89WxdIbdKJOo",
+	"4NMr5xttkZVzZ4L/+nyjf4dNm72P/5l5PmdWcfjsQWJfyj+ZWsxU2UGAZIp8e0LCi61zarnh5YHkcMgO",
+	"S/Fpusy1SOo6eT/sJmrkJUcWi2YD7Hnpa1Yd55fLS+Qat9EQW4j9iWp2r50RVTcFUvyKiyU2kyUllTIt",
+	"C7g6motGGIRlhUxoCwKXNRQE8srVPPPcx9PzT3tAFB1nYR7U8BB+stiwjUKLh7qotByI7PB0cyEEQFin",
+	"wyIYahJD5boyZoLUdJ/lk9Lr2T5y0v8DnCZCjAIQxHjwc6njMQMBQ+KBo0v9Ly1yhLgS4EKECUuuiIIs",
+	"6lqLEDhm/EkZbwGzMmg7S+LyH7vtDnWd2iz4v6rR/wllZJXJX/9YVvfR3+92XH9bLvF6WmJ0A8/euCy+",
+	"nD84zM+ohXMYy8ytuIGeOSK2gC6l1qz5blTdWKI2hR5WYJ8Qrh/COxJB38GI8XZYdLGt+j4IxGWIA8xb",
+	"RNaFpsQZ64GTGA1glyN/6gaW7/99yGTlNGSnlt73kA/0CQAoC/FfUFBSGBwLcJQApt6Lc+DSsZEPLv0x",
+	"uEB8RP2BIH7BRxlw4bhF/NAsVMjnweVpPIhks0m4NXfqUHucA8qaFFZmipYmFuDzsN6TH8mXckM6CNjI",
+	"85FEzXC4bsADH8nKOfo5R9fQ9ZEjdibwKJnZDtwj1EdM8HKBSpKvR1H5nE7sOnQT8Mkgh9DWvJ3fBkjg",
+	"poKFCkCZhQj0MWU5MDkldBiN+kY9GcDdiR2wKVJik7IytEgdcR+jIZpoVS2Drk9d+dtDjMGjPoUujitX",
+	"gQ3wwKnG/wfQKJ+mlhE+lCt+l7LYn+ayHxWJ/g4GP4Ww8+VGNaA/NGPxiWwGbDREDvVcRDhQjTPrmcB3",
+	"Ml8ywoSzMdzM/PgeDT4jyom3K96XgmaHBhzoySP013//+P7j/x8A",
 }
 
 // decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
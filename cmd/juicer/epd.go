@@ -0,0 +1,190 @@
+package juicer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/dankobg/juicer/engine"
+	"github.com/dankobg/juicer/engine/search"
+)
+
+type EPDCommand struct {
+	Run EPDRunCommand `cmd:"" help:"Run the perft and best move tests of the EPD suite"`
+}
+
+// EPDRunCommand runs the perft tests of the records with the D1..D6 operations and the search tests of the records with the bm and am operations
+type EPDRunCommand struct {
+	Suite    string        `arg:"" type:"existingfile" help:"EPD suite file"`
+	Mode     string        `enum:"all,perft,bestmove" default:"all" help:"Tests to run (all, perft or bestmove)"`
+	MaxDepth int           `default:"6" help:"Deepest perft depth to verify"`
+	Depth    int           `help:"Search depth of the best move tests, 0 is unlimited"`
+	MoveTime time.Duration `default:"1s" help:"Search time of the best move tests, 0 is unlimited"`
+	Hash     int           `default:"64" help:"Transposition table size in megabytes"`
+}
+
+type epdSummary struct {
+	passed int
+	failed int
+	nodes  int64
+	time   time.Duration
+}
+
+func (es *epdSummary) add(passed bool, nodes int64, elapsed time.Duration) string {
+	es.nodes += nodes
+	es.time += elapsed
+
+	if passed {
+		es.passed++
+		return "ok"
+	}
+
+	es.failed++
+
+	return "FAIL"
+}
+
+func (es *epdSummary) String() string {
+	return fmt.Sprintf("%d passed, %d failed, %d nodes in %s (%s)", es.passed, es.failed, es.nodes, es.time.Round(time.Millisecond), nps(es.nodes, es.time))
+}
+
+func (ec *EPDRunCommand) Run() error {
+	if ec.Mode != "perft" && ec.Depth == 0 && ec.MoveTime == 0 {
+		return fmt.Errorf("best move tests need the depth or the move time limit")
+	}
+
+	f, err := os.Open(ec.Suite)
+	if err != nil {
+		return fmt.Errorf("failed to open epd suite: %w", err)
+	}
+	defer f.Close()
+
+	records, err := engine.ReadEPD(f)
+	if err != nil {
+		return fmt.Errorf("failed to read epd suite: %w", err)
+	}
+
+	// the tables are initialised before the first test so its timing doesn't include them
+	engine.InitPrecalculatedTables()
+
+	var (
+		perft, bestMove epdSummary
+		searcher        = search.NewSearcher(ec.Hash)
+		out             = os.Stdout
+	)
+
+	for i, e := range records {
+		name := e.ID
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+
+		if ec.Mode != "bestmove" {
+			ec.runPerft(out, name, e, &perft)
+		}
+
+		if ec.Mode != "perft" && (len(e.BestMoves) > 0 || len(e.AvoidMoves) > 0) {
+			if err := ec.runBestMove(out, name, e, searcher, &bestMove); err != nil {
+				return err
+			}
+		}
+	}
+
+	if perft.passed+perft.failed > 0 {
+		fmt.Fprintf(out, "perft: %s\n", &perft)
+	}
+
+	if bestMove.passed+bestMove.failed > 0 {
+		fmt.Fprintf(out, "best move: %s\n", &bestMove)
+	}
+
+	if failed := perft.failed + bestMove.failed; failed > 0 {
+		return fmt.Errorf("%d of %d tests failed", failed, failed+perft.passed+bestMove.passed)
+	}
+
+	return nil
+}
+
+// runPerft verifies the leaf nodes of every depth up to the max depth
+func (ec *EPDRunCommand) runPerft(out io.Writer, name string, e *engine.EPD, summary *epdSummary) {
+	for _, depth := range slices.Sorted(maps.Keys(e.Perft)) {
+		if depth > ec.MaxDepth {
+			break
+		}
+
+		start := time.Now()
+		nodes := engine.Perft(e.FEN, depth)
+		elapsed := time.Since(start)
+
+		status := summary.add(nodes == e.Perft[depth], nodes, elapsed)
+		fmt.Fprintf(out, "%-4s %s D%d: want %d, got %d nodes in %s (%s)\n", status, name, depth, e.Perft[depth], nodes, elapsed.Round(time.Millisecond), nps(nodes, elapsed))
+	}
+}
+
+// runBestMove searches the position, it passes when the best move is one of the bm moves and none of the am moves
+func (ec *EPDRunCommand) runBestMove(out io.Writer, name string, e *engine.EPD, searcher *search.Searcher, summary *epdSummary) error {
+	c, err := engine.NewChess(e.FEN)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	bestMoves, err := parseEPDMoves(c, e.BestMoves)
+	if err != nil {
+		return fmt.Errorf("%s: invalid bm: %w", name, err)
+	}
+
+	avoidMoves, err := parseEPDMoves(c, e.AvoidMoves)
+	if err != nil {
+		return fmt.Errorf("%s: invalid am: %w", name, err)
+	}
+
+	searcher.Clear()
+
+	start := time.Now()
+	res := searcher.Search(context.Background(), c, search.Limits{Depth: ec.Depth, MoveTime: ec.MoveTime}, nil)
+	elapsed := time.Since(start)
+
+	passed := (len(bestMoves) == 0 || slices.Contains(bestMoves, res.BestMove)) && !slices.Contains(avoidMoves, res.BestMove)
+	status := summary.add(passed, res.Nodes, elapsed)
+
+	want := fmt.Sprintf("bm %v", e.BestMoves)
+	if len(e.BestMoves) == 0 {
+		want = fmt.Sprintf("am %v", e.AvoidMoves)
+	}
+
+	got := "none"
+	if res.BestMove != 0 {
+		got = res.BestMove.ToSAN(c.Position, false, false, c.LegalMoves)
+	}
+
+	fmt.Fprintf(out, "%-4s %s: want %s, got %s at depth %d, %d nodes in %s (%s)\n", status, name, want, got, res.Depth, res.Nodes, elapsed.Round(time.Millisecond), nps(res.Nodes, elapsed))
+
+	return nil
+}
+
+func parseEPDMoves(c *engine.Chess, sans []string) ([]engine.Move, error) {
+	moves := make([]engine.Move, 0, len(sans))
+
+	for _, san := range sans {
+		m, err := engine.ParseSAN(san, c.LegalMoves)
+		if err != nil {
+			return nil, err
+		}
+
+		moves = append(moves, m)
+	}
+
+	return moves, nil
+}
+
+func nps(nodes int64, elapsed time.Duration) string {
+	if elapsed <= 0 {
+		return "- nps"
+	}
+
+	return fmt.Sprintf("%.0f nps", float64(nodes)/elapsed.Seconds())
+}
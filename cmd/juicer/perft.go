@@ -0,0 +1,82 @@
+package juicer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dankobg/juicer/engine"
+	"github.com/dankobg/juicer/engine/uciclient"
+)
+
+// PerftCommand counts the leaf nodes of the position, the root moves are split across the threads
+type PerftCommand struct {
+	FEN       string `default:"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1" help:"Position to count the moves of"`
+	Depth     int    `default:"5" help:"Perft depth"`
+	Divide    bool   `help:"Print the nodes of every root move"`
+	Threads   int    `help:"Goroutines the root moves are split across, 0 uses all cpus"`
+	Hash      int    `default:"64" help:"Cache size of the subtree counts in megabytes, 0 disables it"`
+	Breakdown bool   `help:"Count the captures, en-passants, castles, promotions, checks and checkmates"`
+	Compare   string `type:"existingfile" help:"UCI engine (e.g. stockfish) to compare the divide with"`
+}
+
+func (pc *PerftCommand) Run() error {
+	if pc.Depth < 1 {
+		return fmt.Errorf("depth must be at least 1")
+	}
+
+	if pc.Compare != "" {
+		return pc.compare()
+	}
+
+	res, err := engine.Divide(pc.FEN, pc.Depth, engine.PerftOptions{Threads: pc.Threads, HashMB: pc.Hash, Breakdown: pc.Breakdown})
+	if err != nil {
+		return fmt.Errorf("failed to perft: %w", err)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+
+	if pc.Divide {
+		for _, m := range res.Moves {
+			if pc.Breakdown {
+				fmt.Fprintf(tw, "%s\t%s\n", m.Move, breakdown(m.PerftStats))
+			} else {
+				fmt.Fprintf(tw, "%s\t%d\n", m.Move, m.Nodes)
+			}
+		}
+
+		fmt.Fprintln(tw)
+	}
+
+	fmt.Fprintf(tw, "Depth\t%d\n", res.Depth)
+
+	if pc.Breakdown {
+		fmt.Fprintf(tw, "Total\t%s\n", breakdown(res.PerftStats))
+	} else {
+		fmt.Fprintf(tw, "Nodes\t%d\n", res.Nodes)
+	}
+
+	fmt.Fprintf(tw, "Time\t%s\n", res.Time.Round(time.Millisecond))
+	fmt.Fprintf(tw, "NPS\t%d\n", res.NPS())
+
+	return tw.Flush()
+}
+
+// compare prints the divide differences with the other engine
+func (pc *PerftCommand) compare() error {
+	ctx := context.Background()
+
+	client, err := uciclient.New(ctx, uciclient.Config{Path: pc.Compare})
+	if err != nil {
+		return fmt.Errorf("failed to start %s: %w", pc.Compare, err)
+	}
+	defer client.Close()
+
+	return engine.ComparePerft(ctx, client, pc.FEN, pc.Depth, os.Stdout)
+}
+
+func breakdown(s engine.PerftStats) string {
+	return fmt.Sprintf("%d\tcaptures %d\te.p. %d\tcastles %d\tpromotions %d\tchecks %d\tcheckmates %d", s.Nodes, s.Captures, s.EnPassants, s.Castles, s.Promotions, s.Checks, s.Checkmates)
+}
@@ -15,7 +15,6 @@ import (
 	"github.com/dankobg/juicer/auth/kratos"
 	"github.com/dankobg/juicer/bus"
 	"github.com/dankobg/juicer/config"
-	"github.com/dankobg/juicer/engine"
 	"github.com/dankobg/juicer/features/chat"
 	chatpg "github.com/dankobg/juicer/features/chat/persistence/postgres"
 	chatrdb "github.com/dankobg/juicer/features/chat/persistence/redis"
@@ -40,8 +39,6 @@ import (
 type ServeCommand struct{}
 
 func (sc *ServeCommand) Run() error {
-	engine.InitPrecalculatedTables()
-
 	cfg, _, err := config.New()
 	if err != nil {
 		slog.Error("failed to initialize config", slog.Any("error", err))
@@ -0,0 +1,14 @@
+package juicer
+
+import (
+	"context"
+	"os"
+
+	"github.com/dankobg/juicer/engine/uci"
+)
+
+type UCICommand struct{}
+
+func (uc *UCICommand) Run() error {
+	return uci.New(os.Stdin, os.Stdout).Run(context.Background())
+}
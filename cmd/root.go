@@ -9,6 +9,9 @@ import (
 var CLI struct {
 	Serve      juicer.ServeCommand `cmd:"" help:"Run Juicer server"`
 	Identities identities.RootCmd  `cmd:"" help:"Manage identities"`
+	UCI        juicer.UCICommand   `cmd:"" name:"uci" help:"Run Juicer engine over the UCI protocol"`
+	EPD        juicer.EPDCommand   `cmd:"" name:"epd" help:"Run EPD test suites against Juicer engine"`
+	Perft      juicer.PerftCommand `cmd:"" name:"perft" help:"Run perft on the position with Juicer engine"`
 }
 
 func Run() {
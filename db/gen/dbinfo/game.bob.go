@@ -276,6 +276,51 @@ var Games = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		Eco: column{
+			Name:      "eco",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		OpeningName: column{
+			Name:      "opening_name",
+			DBType:    "text",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		OpeningVariation: column{
+			Name:      "opening_variation",
+			DBType:    "text",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		WhiteBotLevel: column{
+			Name:      "white_bot_level",
+			DBType:    "integer",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		BlackBotLevel: column{
+			Name:      "black_bot_level",
+			DBType:    "integer",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: gameIndexes{
 		PKGameID: index{
@@ -295,6 +340,23 @@ var Games = Table[
 			Where:         "",
 			Include:       []string{},
 		},
+		IxGameEco: index{
+			Type: "btree",
+			Name: "ix_game_eco",
+			Columns: []indexColumn{
+				{
+					Name:         "eco",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
 	},
 	PrimaryKey: &constraint{
 		Name:    "pk_game_id",
@@ -377,6 +439,14 @@ var Games = Table[
 	},
 
 	Checks: gameChecks{
+		CKGameBotLevel: check{
+			constraint: constraint{
+				Name:    "ck_game_bot_level",
+				Columns: []string{"white_bot_level", "black_bot_level", "rated"},
+				Comment: "",
+			},
+			Expression: "(((white_bot_level IS NULL) OR (black_bot_level IS NULL)) AND ((white_bot_level IS NULL) OR ((white_bot_level >= 1) AND (white_bot_level <= 8) AND (NOT rated))) AND ((black_bot_level IS NULL) OR ((black_bot_level >= 1) AND (black_bot_level <= 8) AND (NOT rated))))",
+		},
 		CKGameWhiteBlack: check{
 			constraint: constraint{
 				Name:    "ck_game_white_black",
@@ -419,21 +489,27 @@ type gameColumns struct {
 	Version                column
 	CreatedAt              column
 	UpdatedAt              column
+	Eco                    column
+	OpeningName            column
+	OpeningVariation       column
+	WhiteBotLevel          column
+	BlackBotLevel          column
 }
 
 func (c gameColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.WhiteID, c.BlackID, c.GuestWhiteID, c.GuestBlackID, c.GameVariantID, c.GameTimeKindID, c.GameTimeCategoryID, c.GameStateID, c.GameResultID, c.GameResultStatusID, c.TimeControlClockMS, c.TimeControlIncrementMS, c.ReconnectTimeoutMS, c.FirstMoveTimeoutMS, c.WhiteGameRemainingSecs, c.WhiteGameRemainingNS, c.BlackGameRemainingSecs, c.BlackGameRemainingNS, c.Rated, c.StartTime, c.EndTime, c.LastMove, c.Fen, c.PGN, c.Repetitions, c.Version, c.CreatedAt, c.UpdatedAt,
+		c.ID, c.WhiteID, c.BlackID, c.GuestWhiteID, c.GuestBlackID, c.GameVariantID, c.GameTimeKindID, c.GameTimeCategoryID, c.GameStateID, c.GameResultID, c.GameResultStatusID, c.TimeControlClockMS, c.TimeControlIncrementMS, c.ReconnectTimeoutMS, c.FirstMoveTimeoutMS, c.WhiteGameRemainingSecs, c.WhiteGameRemainingNS, c.BlackGameRemainingSecs, c.BlackGameRemainingNS, c.Rated, c.StartTime, c.EndTime, c.LastMove, c.Fen, c.PGN, c.Repetitions, c.Version, c.CreatedAt, c.UpdatedAt, c.Eco, c.OpeningName, c.OpeningVariation, c.WhiteBotLevel, c.BlackBotLevel,
 	}
 }
 
 type gameIndexes struct {
-	PKGameID index
+	PKGameID  index
+	IxGameEco index
 }
 
 func (i gameIndexes) AsSlice() []index {
 	return []index{
-		i.PKGameID,
+		i.PKGameID, i.IxGameEco,
 	}
 }
 
@@ -461,11 +537,12 @@ func (u gameUniques) AsSlice() []constraint {
 }
 
 type gameChecks struct {
+	CKGameBotLevel   check
 	CKGameWhiteBlack check
 }
 
 func (c gameChecks) AsSlice() []check {
 	return []check{
-		c.CKGameWhiteBlack,
+		c.CKGameBotLevel, c.CKGameWhiteBlack,
 	}
 }
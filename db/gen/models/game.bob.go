@@ -55,6 +55,11 @@ type Game struct {
 	Version                int32               `db:"version" `
 	CreatedAt              time.Time           `db:"created_at" `
 	UpdatedAt              time.Time           `db:"updated_at" `
+	Eco                    null.Val[string]    `db:"eco" `
+	OpeningName            null.Val[string]    `db:"opening_name" `
+	OpeningVariation       null.Val[string]    `db:"opening_variation" `
+	WhiteBotLevel          null.Val[int32]     `db:"white_bot_level" `
+	BlackBotLevel          null.Val[int32]     `db:"black_bot_level" `
 
 	R gameR `db:"-" `
 }
@@ -103,7 +108,7 @@ type gameRLoaded struct {
 
 func buildGameColumns(tableName string) gameColumns {
 	columnsExpr := expr.NewColumnsExpr(
-		"id", "white_id", "black_id", "guest_white_id", "guest_black_id", "game_variant_id", "game_time_kind_id", "game_time_category_id", "game_state_id", "game_result_id", "game_result_status_id", "time_control_clock_ms", "time_control_increment_ms", "reconnect_timeout_ms", "first_move_timeout_ms", "white_game_remaining_secs", "white_game_remaining_ns", "black_game_remaining_secs", "black_game_remaining_ns", "rated", "start_time", "end_time", "last_move", "fen", "pgn", "repetitions", "version", "created_at", "updated_at",
+		"id", "white_id", "black_id", "guest_white_id", "guest_black_id", "game_variant_id", "game_time_kind_id", "game_time_category_id", "game_state_id", "game_result_id", "game_result_status_id", "time_control_clock_ms", "time_control_increment_ms", "reconnect_timeout_ms", "first_move_timeout_ms", "white_game_remaining_secs", "white_game_remaining_ns", "black_game_remaining_secs", "black_game_remaining_ns", "rated", "start_time", "end_time", "last_move", "fen", "pgn", "repetitions", "version", "created_at", "updated_at", "eco", "opening_name", "opening_variation", "white_bot_level", "black_bot_level",
 	)
 
 	if tableName != "" {
@@ -142,6 +147,11 @@ func buildGameColumns(tableName string) gameColumns {
 		Version:                buildGameColumn(tableName, "version"),
 		CreatedAt:              buildGameColumn(tableName, "created_at"),
 		UpdatedAt:              buildGameColumn(tableName, "updated_at"),
+		Eco:                    buildGameColumn(tableName, "eco"),
+		OpeningName:            buildGameColumn(tableName, "opening_name"),
+		OpeningVariation:       buildGameColumn(tableName, "opening_variation"),
+		WhiteBotLevel:          buildGameColumn(tableName, "white_bot_level"),
+		BlackBotLevel:          buildGameColumn(tableName, "black_bot_level"),
 	}
 }
 
@@ -177,6 +187,11 @@ type gameColumns struct {
 	Version                gameColumn
 	CreatedAt              gameColumn
 	UpdatedAt              gameColumn
+	Eco                    gameColumn
+	OpeningName            gameColumn
+	OpeningVariation       gameColumn
+	WhiteBotLevel          gameColumn
+	BlackBotLevel          gameColumn
 }
 
 // Alias returns the current table alias for the columns set.
@@ -250,10 +265,15 @@ type GameSetter struct {
 	Version                omit.Val[int32]         `db:"version" `
 	CreatedAt              omit.Val[time.Time]     `db:"created_at" `
 	UpdatedAt              omit.Val[time.Time]     `db:"updated_at" `
+	Eco                    omitnull.Val[string]    `db:"eco" `
+	OpeningName            omitnull.Val[string]    `db:"opening_name" `
+	OpeningVariation       omitnull.Val[string]    `db:"opening_variation" `
+	WhiteBotLevel          omitnull.Val[int32]     `db:"white_bot_level" `
+	BlackBotLevel          omitnull.Val[int32]     `db:"black_bot_level" `
 }
 
 func (s GameSetter) SetColumns() []string {
-	vals := make([]string, 0, 28)
+	vals := make([]string, 0, 33)
 	if s.WhiteID.IsValue() || s.WhiteID.IsNull() {
 		vals = append(vals, "white_id")
 	}
@@ -338,6 +358,21 @@ func (s GameSetter) SetColumns() []string {
 	if s.UpdatedAt.IsValue() {
 		vals = append(vals, "updated_at")
 	}
+	if s.Eco.IsValue() || s.Eco.IsNull() {
+		vals = append(vals, "eco")
+	}
+	if s.OpeningName.IsValue() || s.OpeningName.IsNull() {
+		vals = append(vals, "opening_name")
+	}
+	if s.OpeningVariation.IsValue() || s.OpeningVariation.IsNull() {
+		vals = append(vals, "opening_variation")
+	}
+	if s.WhiteBotLevel.IsValue() || s.WhiteBotLevel.IsNull() {
+		vals = append(vals, "white_bot_level")
+	}
+	if s.BlackBotLevel.IsValue() || s.BlackBotLevel.IsNull() {
+		vals = append(vals, "black_bot_level")
+	}
 	return vals
 }
 
@@ -426,6 +461,21 @@ func (s GameSetter) Overwrite(t *Game) {
 	if s.UpdatedAt.IsValue() {
 		t.UpdatedAt = s.UpdatedAt.MustGet()
 	}
+	if s.Eco.IsValue() || s.Eco.IsNull() {
+		t.Eco = s.Eco.MustGetNull()
+	}
+	if s.OpeningName.IsValue() || s.OpeningName.IsNull() {
+		t.OpeningName = s.OpeningName.MustGetNull()
+	}
+	if s.OpeningVariation.IsValue() || s.OpeningVariation.IsNull() {
+		t.OpeningVariation = s.OpeningVariation.MustGetNull()
+	}
+	if s.WhiteBotLevel.IsValue() || s.WhiteBotLevel.IsNull() {
+		t.WhiteBotLevel = s.WhiteBotLevel.MustGetNull()
+	}
+	if s.BlackBotLevel.IsValue() || s.BlackBotLevel.IsNull() {
+		t.BlackBotLevel = s.BlackBotLevel.MustGetNull()
+	}
 }
 
 func (s *GameSetter) Apply(q *dialect.InsertQuery) {
@@ -574,6 +624,31 @@ func (s *GameSetter) Apply(q *dialect.InsertQuery) {
 				return psql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
 			}
 			return psql.Arg(s.UpdatedAt.MustGet()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+			if s.Eco.IsUnset() {
+				return psql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return psql.Arg(s.Eco.MustGetNull()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+			if s.OpeningName.IsUnset() {
+				return psql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return psql.Arg(s.OpeningName.MustGetNull()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+			if s.OpeningVariation.IsUnset() {
+				return psql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return psql.Arg(s.OpeningVariation.MustGetNull()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+			if s.WhiteBotLevel.IsUnset() {
+				return psql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return psql.Arg(s.WhiteBotLevel.MustGetNull()).WriteSQL(ctx, w, d, start)
+		}), bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+			if s.BlackBotLevel.IsUnset() {
+				return psql.Raw("DEFAULT").WriteSQL(ctx, w, d, start)
+			}
+			return psql.Arg(s.BlackBotLevel.MustGetNull()).WriteSQL(ctx, w, d, start)
 		}))
 }
 
@@ -582,7 +657,7 @@ func (s GameSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s GameSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 28)
+	exprs := make([]bob.Expression, 0, 33)
 
 	if s.WhiteID.IsValue() || s.WhiteID.IsNull() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -780,6 +855,41 @@ func (s GameSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if s.Eco.IsValue() || s.Eco.IsNull() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "eco")...),
+			psql.Arg(s.Eco),
+		}})
+	}
+
+	if s.OpeningName.IsValue() || s.OpeningName.IsNull() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "opening_name")...),
+			psql.Arg(s.OpeningName),
+		}})
+	}
+
+	if s.OpeningVariation.IsValue() || s.OpeningVariation.IsNull() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "opening_variation")...),
+			psql.Arg(s.OpeningVariation),
+		}})
+	}
+
+	if s.WhiteBotLevel.IsValue() || s.WhiteBotLevel.IsNull() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "white_bot_level")...),
+			psql.Arg(s.WhiteBotLevel),
+		}})
+	}
+
+	if s.BlackBotLevel.IsValue() || s.BlackBotLevel.IsNull() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "black_bot_level")...),
+			psql.Arg(s.BlackBotLevel),
+		}})
+	}
+
 	return exprs
 }
 
@@ -790,7 +900,7 @@ func gameScanMapper(ctx context.Context, cols []string) (scan.BeforeFunc, func(a
 		idx int
 		dst func(o *Game) any
 	}
-	targets := make([]target, 0, 29)
+	targets := make([]target, 0, 34)
 	for i, col := range cols {
 		switch col {
 		case "id":
@@ -851,6 +961,16 @@ func gameScanMapper(ctx context.Context, cols []string) (scan.BeforeFunc, func(a
 			targets = append(targets, target{i, func(o *Game) any { return &o.CreatedAt }})
 		case "updated_at":
 			targets = append(targets, target{i, func(o *Game) any { return &o.UpdatedAt }})
+		case "eco":
+			targets = append(targets, target{i, func(o *Game) any { return &o.Eco }})
+		case "opening_name":
+			targets = append(targets, target{i, func(o *Game) any { return &o.OpeningName }})
+		case "opening_variation":
+			targets = append(targets, target{i, func(o *Game) any { return &o.OpeningVariation }})
+		case "white_bot_level":
+			targets = append(targets, target{i, func(o *Game) any { return &o.WhiteBotLevel }})
+		case "black_bot_level":
+			targets = append(targets, target{i, func(o *Game) any { return &o.BlackBotLevel }})
 		}
 	}
 
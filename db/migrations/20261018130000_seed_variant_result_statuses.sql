@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+insert into "game_result_status" ("name") values
+  ('king-of-the-hill'),
+  ('three-check');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from "game_result_status" where "name" in ('king-of-the-hill', 'three-check');
+-- +goose StatementEnd
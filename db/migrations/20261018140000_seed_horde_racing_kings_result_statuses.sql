@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+insert into "game_result_status" ("name") values
+  ('all-pieces-captured'),
+  ('race-finished');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from "game_result_status" where "name" in ('all-pieces-captured', 'race-finished');
+-- +goose StatementEnd
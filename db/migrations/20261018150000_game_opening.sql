@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table "game"
+  add column "eco" character varying(3),
+  add column "opening_name" text,
+  add column "opening_variation" text;
+
+create index "ix_game_eco" on "game" ("eco");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists "ix_game_eco";
+
+alter table "game"
+  drop column if exists "eco",
+  drop column if exists "opening_name",
+  drop column if exists "opening_variation";
+-- +goose StatementEnd
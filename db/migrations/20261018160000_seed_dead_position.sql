@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+insert into "game_result_status" ("name") values
+  ('dead-position');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from "game_result_status" where "name" = 'dead-position';
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table "game"
+  add column "white_bot_level" integer,
+  add column "black_bot_level" integer,
+  add constraint "ck_game_bot_level" check (
+    ("white_bot_level" is null or "black_bot_level" is null) and
+    ("white_bot_level" is null or ("white_bot_level" between 1 and 8 and not "rated")) and
+    ("black_bot_level" is null or ("black_bot_level" between 1 and 8 and not "rated"))
+  );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table "game"
+  drop constraint if exists "ck_game_bot_level",
+  drop column if exists "white_bot_level",
+  drop column if exists "black_bot_level";
+-- +goose StatementEnd
@@ -0,0 +1,73 @@
+package engine
+
+// explode removes the capturing piece and all the pieces except pawns around the capture square in atomic chess
+func (p *Position) explode(sq Square) {
+	pawns := p.Board.pieceOccupancies[White][Pawn] | p.Board.pieceOccupancies[Black][Pawn]
+	blast := sq.occupancyMask() | kingAttacksMask[sq]&^pawns
+
+	for _, color := range colors {
+		for _, pk := range pieceKinds {
+			exploded := p.Board.pieceOccupancies[color][pk] & blast
+
+			for exploded > 0 {
+				esq := Square(exploded.PopLS1B())
+
+				p.Board.pieceOccupancies[color][pk].clearBit(esq)
+				p.Hash ^= defaultZobrist.occupanciesKeys[color][pk][esq]
+
+				if color != p.Turn {
+					p.CapturedPieces = append(p.CapturedPieces, NewPiece(pk, color))
+				}
+			}
+		}
+	}
+
+	// the castle rights are lost with the exploded king or rook
+	for _, color := range colors {
+		for _, side := range [2]CastleSide{KingSide, QueenSide} {
+			right := castleRight(color, side)
+			if p.CastleRights&right == 0 {
+				continue
+			}
+
+			if p.Board.pieceOccupancies[color][King] == 0 || !p.Board.pieceOccupancies[color][Rook].bitIsSet(p.CastleRooks[color][side]) {
+				p.CastleRights &^= right
+				p.ZobristCastleRights(right)
+			}
+		}
+	}
+}
+
+// isKingExploded checks if the side lost the king in atomic chess
+func (p *Position) isKingExploded(side Color) bool {
+	return p.Variant == VariantAtomic && p.Board.pieceOccupancies[side][King] == 0
+}
+
+// kingsTouch checks if the kings are on the adjacent squares, they can't be in check then because capturing the king would explode both kings
+func (p *Position) kingsTouch() bool {
+	whiteKing := p.Board.pieceOccupancies[White][King]
+	if whiteKing == 0 {
+		return false
+	}
+
+	return kingAttacksMask[whiteKing.LS1B()]&p.Board.pieceOccupancies[Black][King] != 0
+}
+
+// isAtomicCheck checks if the side is in check by the atomic rules, there is no check without the kings or when the kings touch
+func (p *Position) isAtomicCheck(side Color) bool {
+	if p.isKingExploded(side) || p.isKingExploded(side.Opposite()) || p.kingsTouch() {
+		return false
+	}
+
+	return p.Board.IsInCheck(side)
+}
+
+// atomicCastlePathAttacks removes the squares next to the enemy king from the castle path because the king can't be captured there
+func (p *Position) atomicCastlePathAttacks(path bitboard) bitboard {
+	enemyKing := p.Board.pieceOccupancies[p.Turn.Opposite()][King]
+	if enemyKing == 0 {
+		return path
+	}
+
+	return path &^ kingAttacksMask[enemyKing.LS1B()]
+}
@@ -0,0 +1,102 @@
+package engine
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAtomicPerft(t *testing.T) {
+	testCases := map[string]struct {
+		fen   string
+		nodes []int64
+	}{
+		"starting position":          {fen: FENStartingPosition, nodes: []int64{20, 400, 8902, 197326}},
+		"kiwipete":                   {fen: "r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1", nodes: []int64{48, 1939, 88298}},
+		"middlegame":                 {fen: "rn2kb1r/1pp1p2p/p2q1pp1/3P4/2P3b1/4PN2/PP3PPP/R2QKB1R b KQkq - 0 1", nodes: []int64{40, 1238, 45237}},
+		"open king":                  {fen: "rn1qkb1r/p5pp/2p5/3p4/N3P3/5P2/PPP4P/R1BQK3 w Qkq - 0 1", nodes: []int64{28, 833, 23353}},
+		"en passant and promotions":  {fen: "1r2k3/2P5/8/3pP3/8/8/8/4K3 w - d6 0 1", nodes: []int64{15, 161, 1736, 21745}},
+		"castle next to enemy king":  {fen: "8/8/8/8/8/8/1k6/R3K2R w KQ - 0 1", nodes: []int64{26, 95, 2649, 13593}},
+		"castle through the attacks": {fen: "r3k2r/1b4bq/8/8/8/8/7B/R3K2R w KQkq - 0 1", nodes: []int64{26, 1164, 28320}},
+		"touching kings":             {fen: "8/8/8/8/2k5/8/3K4/8 w - - 0 1", nodes: []int64{8, 62, 412, 3212}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for i, want := range tc.nodes {
+				if got := PerftVariant(tc.fen, VariantAtomic, i+1); got != want {
+					t.Fatalf("invalid perft nodes at depth %d: want %d, got %d", i+1, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestAtomicMoves(t *testing.T) {
+	testCases := map[string]struct {
+		fen        string
+		moves      []string
+		wantFen    string
+		wantStatus Status
+	}{
+		"capture explodes the king":             {fen: FENStartingPosition, moves: []string{"g1f3", "a7a6", "f3g5", "a6a5", "g5f7"}, wantFen: "rnbq3r/1pppp1pp/8/p7/8/8/PPPPPPPP/RNBQKB1R b KQ - 0 3", wantStatus: StatusKingExploded},
+		"en passant explodes around the pawn":   {fen: "4k3/8/8/2npP3/8/8/8/4K3 w - d6 0 1", moves: []string{"e5d6"}, wantFen: "4k3/8/8/8/8/8/8/4K3 b - - 0 1", wantStatus: StatusInsufficientMaterial},
+		"exploded rook loses the castle rights": {fen: "r3k2r/p7/8/8/8/8/8/R3K2R w KQkq - 0 1", moves: []string{"a1a7"}, wantFen: "4k2r/8/8/8/8/8/8/4K2R b Kk - 0 1", wantStatus: StatusUnknown},
+		"exploding the king wins in check":      {fen: "q3k3/3p4/8/8/8/8/8/3R3K w - - 0 1", moves: []string{"d1d7"}, wantFen: "q7/8/8/8/8/8/8/7K b - - 0 1", wantStatus: StatusKingExploded},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewChessVariant(tc.fen, VariantAtomic)
+			if err != nil {
+				t.Fatalf("invalid fen: %v", err)
+			}
+
+			for _, uci := range tc.moves {
+				if _, err := c.MakeMoveUCI(uci); err != nil {
+					t.Fatalf("failed to make move %s: %v", uci, err)
+				}
+			}
+
+			if got := c.Position.Fen(); got != tc.wantFen {
+				t.Fatalf("invalid fen: want %s, got %s", tc.wantFen, got)
+			}
+
+			if got := c.Status(); got != tc.wantStatus {
+				t.Fatalf("invalid status: want %d, got %d", tc.wantStatus, got)
+			}
+
+			p := c.Position.Copy()
+			p.InitHash()
+
+			if p.Hash != c.Position.Hash {
+				t.Fatalf("invalid hash after the moves: want %d, got %d", p.Hash, c.Position.Hash)
+			}
+		})
+	}
+}
+
+func TestAtomicLegalMoves(t *testing.T) {
+	testCases := map[string]struct {
+		fen       string
+		uci       string
+		wantLegal bool
+	}{
+		"king can't capture":               {fen: "4k3/8/8/8/8/8/4p3/4K3 w - - 0 1", uci: "e1e2", wantLegal: false},
+		"capture can't explode own king":   {fen: "4k3/8/8/8/8/8/3p4/3RK3 w - - 0 1", uci: "d1d2", wantLegal: false},
+		"touching kings cancel the check":  {fen: "8/8/8/8/8/3k4/r2K4/8 w - - 0 1", uci: "d2e2", wantLegal: true},
+		"parting kings bring the check":    {fen: "8/8/8/8/8/3k4/3K4/r7 w - - 0 1", uci: "d2e1", wantLegal: false},
+		"exploding the king ignores check": {fen: "q3k3/3p4/8/8/8/8/8/3R3K w - - 0 1", uci: "d1d7", wantLegal: true},
+		"king in check can't ignore it":    {fen: "q3k3/3p4/8/8/8/8/8/3R3K w - - 0 1", uci: "d1d2", wantLegal: false},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewChessVariant(tc.fen, VariantAtomic)
+			if err != nil {
+				t.Fatalf("invalid fen: %v", err)
+			}
+
+			legal := slices.ContainsFunc(c.LegalMoves, func(m Move) bool { return m.ToUCI() == tc.uci })
+			if legal != tc.wantLegal {
+				t.Fatalf("invalid legality of %s: want %v, got %v", tc.uci, tc.wantLegal, legal)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package engine
+
+// seePieceValues are the piece values of the static exchange evaluation, the king outweighs any exchange so it never captures into the attacked square
+var seePieceValues = [6]int{
+	King:   20000,
+	Queen:  900,
+	Rook:   500,
+	Bishop: 330,
+	Knight: 320,
+	Pawn:   100,
+}
+
+// Pin is the piece pinned to its king and the enemy slider pinning it
+type Pin struct {
+	Pinned Square
+	Pinner Square
+}
+
+// IsSquareAttacked checks if the square is attacked by the side
+func (p *Position) IsSquareAttacked(sq Square, side Color) bool {
+	return p.Board.isSquareAttacked(sq, side, p.Board.sideOccupancies[Both])
+}
+
+// Attackers gets the pieces of the side attacking the square, the pinned pieces are included
+func (p *Position) Attackers(sq Square, side Color) []Square {
+	return p.Board.attackersTo(sq, side, p.Board.sideOccupancies[Both]).squares()
+}
+
+// XRayAttackers gets the sliders of the side which attack the square through exactly one piece of any color,
+// e.g. the rook behind the queen or the bishop behind the pawn
+func (p *Position) XRayAttackers(sq Square, side Color) []Square {
+	return p.xrayAttackers(sq, side).squares()
+}
+
+func (p *Position) xrayAttackers(sq Square, side Color) bitboard {
+	occupancy := p.Board.sideOccupancies[Both]
+	queens := p.Board.pieceOccupancies[side][Queen]
+	sliders := getRookAttacks(sq, 0)&(p.Board.pieceOccupancies[side][Rook]|queens) |
+		getBishopAttacks(sq, 0)&(p.Board.pieceOccupancies[side][Bishop]|queens)
+
+	var xrays bitboard
+
+	for sliders > 0 {
+		slider := Square(sliders.PopLS1B())
+		if (betweenMask[sq][slider] & occupancy).populationCount() == 1 {
+			xrays |= slider.occupancyMask()
+		}
+	}
+
+	return xrays
+}
+
+// Pins gets the pieces of the side pinned to its king and their pinners, the side without the king has no pins
+func (p *Position) Pins(side Color) []Pin {
+	kings := p.Board.pieceOccupancies[side][King]
+	if kings == 0 {
+		return nil
+	}
+
+	king := Square(kings.LS1B())
+
+	var pins []Pin
+
+	pinners := p.xrayAttackers(king, side.Opposite())
+	for pinners > 0 {
+		pinner := Square(pinners.PopLS1B())
+
+		// the single blocker of the enemy color only blocks the line, it is not pinned
+		blocker := betweenMask[king][pinner] & p.Board.sideOccupancies[side]
+		if blocker != 0 {
+			pins = append(pins, Pin{Pinned: Square(blocker.LS1B()), Pinner: pinner})
+		}
+	}
+
+	return pins
+}
+
+// PinnedPieces gets the pieces of the side pinned to its king
+func (p *Position) PinnedPieces(side Color) []Square {
+	pins := p.Pins(side)
+	pinned := make([]Square, len(pins))
+
+	for i, pin := range pins {
+		pinned[i] = pin.Pinned
+	}
+
+	return pinned
+}
+
+// pinnedMask gets the pieces of the side pinned to its king
+func (p *Position) pinnedMask(side Color) bitboard {
+	var pinned bitboard
+
+	for _, pin := range p.Pins(side) {
+		pinned |= pin.Pinned.occupancyMask()
+	}
+
+	return pinned
+}
+
+// SEE is the static exchange evaluation of the move, the material the side to move wins (or loses when negative) in centipawns
+// after both sides keep recapturing on the destination square with their least valuable piece while it pays off.
+// the pinned pieces recapture only along the pin line, the variant rules (e.g. the atomic explosions) are not considered
+func (p *Position) SEE(m Move) int {
+	if m.IsCastle() {
+		return 0
+	}
+
+	src, dest := m.SrcDest()
+	side := m.Piece().Color()
+	occupancy := p.Board.sideOccupancies[Both]
+
+	var gain [32]int
+
+	switch {
+	case m.IsEnPassant():
+		gain[0] = seePieceValues[Pawn]
+		occupancy &^= Square(int(dest) - 8 + 16*int(side)).occupancyMask()
+	case m.IsCapture():
+		gain[0] = seePieceValues[p.Board.pieceAt(dest).Kind()]
+	}
+
+	onSquare := seePieceValues[m.Piece().Kind()]
+	if m.Promotion().IsPromotion() {
+		promoted := seePieceValues[m.Promotion().PieceKind()]
+		gain[0] += promoted - seePieceValues[Pawn]
+		onSquare = promoted
+	}
+
+	if !m.IsDrop() {
+		occupancy &^= src.occupancyMask()
+	}
+
+	pinned := [2]bitboard{p.pinnedMask(White), p.pinnedMask(Black)}
+	kings := [2]Square{SquareNone, SquareNone}
+	for _, c := range colors {
+		if k := p.Board.pieceOccupancies[c][King]; k != 0 {
+			kings[c] = Square(k.LS1B())
+		}
+	}
+
+	d := 0
+
+	for d < len(gain)-1 {
+		d++
+		side = side.Opposite()
+
+		// speculative gain of the side capturing the piece on the square, it is dropped when the side has no attacker left
+		gain[d] = onSquare - gain[d-1]
+		if max(-gain[d-1], gain[d]) < 0 {
+			break
+		}
+
+		// the sliders are looked up with the shrinking occupancy so the x-ray attackers join once the pieces in front of them are gone
+		attackers := p.Board.attackersTo(dest, side, occupancy) & occupancy
+
+		// the pinned piece can capture only along its pin line
+		if kings[side] != SquareNone {
+			attackers &^= pinned[side] &^ lineMask[kings[side]][dest]
+		}
+
+		from, kind, ok := p.leastValuableAttacker(attackers, side)
+		if !ok {
+			break
+		}
+
+		occupancy &^= from.occupancyMask()
+		onSquare = seePieceValues[kind]
+	}
+
+	for d--; d > 0; d-- {
+		gain[d-1] = -max(-gain[d-1], gain[d])
+	}
+
+	return gain[0]
+}
+
+// leastValuableAttacker picks the cheapest piece of the side from the attackers
+func (p *Position) leastValuableAttacker(attackers bitboard, side Color) (Square, PieceKind, bool) {
+	for _, kind := range [6]PieceKind{Pawn, Knight, Bishop, Rook, Queen, King} {
+		if bb := attackers & p.Board.pieceOccupancies[side][kind]; bb != 0 {
+			return Square(bb.LS1B()), kind, true
+		}
+	}
+
+	return SquareNone, 0, false
+}
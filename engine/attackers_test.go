@@ -0,0 +1,123 @@
+package engine
+
+import (
+	"slices"
+	"testing"
+)
+
+func findLegalMove(t *testing.T, c *Chess, uci string) Move {
+	t.Helper()
+
+	for _, m := range c.LegalMoves {
+		if m.String() == uci {
+			return m
+		}
+	}
+
+	t.Fatalf("invalid move: %s is not legal in %s", uci, c.Position.Fen())
+
+	return 0
+}
+
+func TestSEE(t *testing.T) {
+	testCases := map[string]struct {
+		fen  string
+		move string
+		want int
+	}{
+		"undefended pawn":       {fen: "1k1r4/1pp4p/p7/4p3/8/P5P1/1PP4P/2K1R3 w - - 0 1", move: "e1e5", want: 100},
+		"exchange sequence":     {fen: "1k1r3q/1ppn3p/p4b2/4p3/8/P2N2P1/1PP1R1BP/2K1Q3 w - - 0 1", move: "d3e5", want: -220},
+		"rook for pawn":         {fen: "4r1k1/8/8/4p3/8/8/4R3/6K1 w - - 0 1", move: "e2e5", want: -400},
+		"x-ray recapture":       {fen: "4r1k1/8/8/4p3/8/8/4R3/4R1K1 w - - 0 1", move: "e2e5", want: 100},
+		"pinned defender":       {fen: "6k1/5n2/8/4p3/2B5/8/8/4R1K1 w - - 0 1", move: "e1e5", want: 100},
+		"unpinned defender":     {fen: "6k1/5n2/8/4p3/8/8/8/4R1K1 w - - 0 1", move: "e1e5", want: -400},
+		"en passant":            {fen: "4k3/8/8/3pP3/8/8/8/4K3 w - d6 0 1", move: "e5d6", want: 100},
+		"defended en passant":   {fen: "4k3/2p5/8/3pP3/8/8/8/4K3 w - d6 0 1", move: "e5d6", want: 0},
+		"promotion":             {fen: "4k3/1P6/8/8/8/8/8/4K3 w - - 0 1", move: "b7b8q", want: 800},
+		"defended promotion":    {fen: "7r/P7/8/8/8/8/8/K5k1 w - - 0 1", move: "a7a8q", want: -100},
+		"quiet move":            {fen: "4k3/8/8/8/8/8/8/3QK3 w - - 0 1", move: "d1d5", want: 0},
+		"quiet move en prise":   {fen: "4k3/8/4p3/8/8/8/8/3QK3 w - - 0 1", move: "d1d5", want: -900},
+		"king cannot recapture": {fen: "8/8/2k5/3p4/8/8/3Q4/3RK3 w - - 0 1", move: "d2d5", want: 100},
+		"queen takes defended":  {fen: "3rk3/8/8/3p4/8/8/8/3QK3 w - - 0 1", move: "d1d5", want: -800},
+		"black captures":        {fen: "4k3/8/8/4r3/4P3/8/8/4K3 b - - 0 1", move: "e5e4", want: 100},
+		"black loses the queen": {fen: "4k3/8/8/4q3/4P3/3P4/8/4K3 b - - 0 1", move: "e5e4", want: -800},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewChess(tc.fen)
+			if err != nil {
+				t.Fatalf("failed to start game: %v", err)
+			}
+
+			if got := c.Position.SEE(findLegalMove(t, c, tc.move)); got != tc.want {
+				t.Fatalf("invalid see: want %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAttackers(t *testing.T) {
+	// the e5 pawn is attacked by the knight, the rook and the bishop, the queen is behind the rook
+	fen := "4k3/8/5n2/4p3/8/2B5/4R3/4Q1K1 w - - 0 1"
+
+	testCases := map[string]struct {
+		sq        Square
+		side      Color
+		want      []Square
+		wantXRays []Square
+	}{
+		"white on e5": {sq: E5, side: White, want: []Square{E2, C3}, wantXRays: []Square{E1}},
+		"black on e5": {sq: E5, side: Black, want: []Square{}, wantXRays: []Square{}},
+		"black on e4": {sq: E4, side: Black, want: []Square{F6}, wantXRays: []Square{}},
+		"white on f6": {sq: F6, side: White, want: []Square{}, wantXRays: []Square{C3}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := &Position{}
+			if err := p.LoadFromFEN(fen); err != nil {
+				t.Fatalf("failed to load fen: %v", err)
+			}
+
+			if got := p.Attackers(tc.sq, tc.side); !slices.Equal(got, tc.want) {
+				t.Fatalf("invalid attackers: want %v, got %v", tc.want, got)
+			}
+
+			if got := p.XRayAttackers(tc.sq, tc.side); !slices.Equal(got, tc.wantXRays) {
+				t.Fatalf("invalid x-ray attackers: want %v, got %v", tc.wantXRays, got)
+			}
+
+			if got := p.IsSquareAttacked(tc.sq, tc.side); got != (len(tc.want) > 0) {
+				t.Fatalf("invalid attacked: want %v, got %v", len(tc.want) > 0, got)
+			}
+		})
+	}
+}
+
+func TestPins(t *testing.T) {
+	testCases := map[string]struct {
+		fen     string
+		variant Variant
+		side    Color
+		want    []Pin
+	}{
+		"no pins":             {fen: FENStartingPosition, side: White},
+		"bishop pin":          {fen: "6k1/5n2/8/8/2B5/8/8/6K1 w - - 0 1", side: Black, want: []Pin{{Pinned: F7, Pinner: C4}}},
+		"enemy blocker":       {fen: "6k1/5B2/8/8/2B5/8/8/6K1 w - - 0 1", side: Black},
+		"two blockers":        {fen: "6k1/5n2/4n3/8/2B5/8/8/6K1 w - - 0 1", side: Black},
+		"rook and queen pins": {fen: "4r1k1/8/8/q7/8/2N5/4R3/4K3 w - - 0 1", side: White, want: []Pin{{Pinned: C3, Pinner: A5}, {Pinned: E2, Pinner: E8}}},
+		"checker is no pin":   {fen: "4r1k1/8/8/8/8/8/8/4K3 w - - 0 1", side: White},
+		"horde":               {fen: FENStartingPositionHorde, variant: VariantHorde, side: White},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := &Position{}
+			if err := p.LoadFromFENVariant(tc.fen, tc.variant); err != nil {
+				t.Fatalf("failed to load fen: %v", err)
+			}
+
+			if got := p.Pins(tc.side); !slices.Equal(got, tc.want) {
+				t.Fatalf("invalid pins: want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -411,3 +411,25 @@ func TestGenerateRookRelevantOccupancyBitsMask(t *testing.T) {
 		})
 	}
 }
+
+func TestMagicAttacks(t *testing.T) {
+	InitPrecalculatedTables()
+
+	for sq := A1; sq <= H8; sq++ {
+		bishopMask, rookMask := bishopRelevantOccupancyBitsMask[sq], rookRelevantOccupancyBitsMask[sq]
+
+		for i := range 1 << BishopRelevantOccupancyBitsPopulationCount[sq] {
+			occ := SetOccupancy(i, int(BishopRelevantOccupancyBitsPopulationCount[sq]), bishopMask)
+			if want, got := generateBishopAttacksWithBlockers(sq, occ), getBishopAttacks(sq, occ); got != want {
+				t.Fatalf("invalid bishop attacks on %s: want %s, got %s", sq, want, got)
+			}
+		}
+
+		for i := range 1 << RookRelevantOccupancyBitsPopulationCount[sq] {
+			occ := SetOccupancy(i, int(RookRelevantOccupancyBitsPopulationCount[sq]), rookMask)
+			if want, got := generateRookAttacksWithBlockers(sq, occ), getRookAttacks(sq, occ); got != want {
+				t.Fatalf("invalid rook attacks on %s: want %s, got %s", sq, want, got)
+			}
+		}
+	}
+}
@@ -163,6 +163,17 @@ func (bb *bitboard) PopMS1B() int {
 	return ms1b
 }
 
+// squares lists the set squares from a1 to h8
+func (bb bitboard) squares() []Square {
+	sqs := make([]Square, 0, bb.populationCount())
+
+	for bb > 0 {
+		sqs = append(sqs, Square(bb.PopLS1B()))
+	}
+
+	return sqs
+}
+
 // isEmpty checks whether bitboard is empty (all 0s)
 func (bb bitboard) isEmpty() bool {
 	return bb == bitboardEmpty
@@ -74,6 +74,11 @@ func (b Board) Draw(options *DrawOptions) string {
 	})
 }
 
+// PieceAt returns the piece on the square or PieceNone when the square is empty
+func (b Board) PieceAt(sq Square) Piece {
+	return b.pieceAt(sq)
+}
+
 func (b Board) pieceAt(sq Square) Piece {
 	for _, color := range colors {
 		for _, pk := range pieceKinds {
@@ -89,6 +94,11 @@ func (b Board) pieceAt(sq Square) Piece {
 // FenPositionPart returns the fen position part without the metadata (turn, enpSq, castle, half/full move clock)
 // e.g. fen: "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1" -> fenPositionPart: "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR"
 func (b Board) FenPositionPart() string {
+	return b.fenPositionPart(0)
+}
+
+// fenPositionPart writes the crazyhouse promoted pieces with the `~` mark after the piece
+func (b Board) fenPositionPart(promoted bitboard) string {
 	var sb strings.Builder
 
 	for r := boardSize - 1; r >= 0; r-- {
@@ -106,6 +116,10 @@ func (b Board) FenPositionPart() string {
 				}
 
 				sb.WriteString(piece.FENSymbol())
+
+				if promoted.bitIsSet(sq) {
+					sb.WriteString(fenPromotedMark)
+				}
 			} else {
 				emptySquares++
 			}
@@ -132,6 +146,15 @@ func (b Board) isSquareAttacked(sq Square, side Color, occupancy bitboard) bool
 		getRookAttacks(sq, occupancy)&(b.pieceOccupancies[side][Rook]|b.pieceOccupancies[side][Queen]) != 0
 }
 
+// attackersTo gets the pieces of the provided side attacking the square
+func (b Board) attackersTo(sq Square, side Color, occupancy bitboard) bitboard {
+	return pawnAttacksMask[side.Opposite()][sq]&b.pieceOccupancies[side][Pawn] |
+		kingAttacksMask[sq]&b.pieceOccupancies[side][King] |
+		knightsAttacksMask[sq]&b.pieceOccupancies[side][Knight] |
+		getBishopAttacks(sq, occupancy)&(b.pieceOccupancies[side][Bishop]|b.pieceOccupancies[side][Queen]) |
+		getRookAttacks(sq, occupancy)&(b.pieceOccupancies[side][Rook]|b.pieceOccupancies[side][Queen])
+}
+
 // GetAttackedSquares gets the attacked squares by the provided side
 func (b Board) GetAttackedSquares(side Color, mask, occupancy bitboard) bitboard {
 	var attacked bitboard
@@ -147,8 +170,12 @@ func (b Board) GetAttackedSquares(side Color, mask, occupancy bitboard) bitboard
 	return attacked
 }
 
-// IsChecked checks if the provided side is in check
+// IsChecked checks if the provided side is in check, the side without the king (e.g. the horde) is never in check
 func (b Board) IsInCheck(side Color) bool {
+	if b.pieceOccupancies[side][King] == 0 {
+		return false
+	}
+
 	return b.isSquareAttacked(Square(b.pieceOccupancies[side][King].LS1B()), side.Opposite(), b.sideOccupancies[Both])
 }
 
@@ -162,6 +189,11 @@ func (b Board) IsOnlyKingLeft() bool {
 	return b.pieceOccupancies[White][King]|b.pieceOccupancies[Black][King] == b.sideOccupancies[Both]
 }
 
+// HasNonPawnMaterial checks if the side has any pieces other than the king and pawns
+func (b Board) HasNonPawnMaterial(side Color) bool {
+	return b.sideOccupancies[side]&^(b.pieceOccupancies[side][King]|b.pieceOccupancies[side][Pawn]) != 0
+}
+
 // AlivePieces gets the total alive pieces count
 func (b Board) AlivePieces() uint8 {
 	return b.sideOccupancies[Both].populationCount()
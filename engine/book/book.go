@@ -0,0 +1,233 @@
+// Package book reads and writes the opening books in the polyglot format
+package book
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"slices"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+var ErrInvalidBook = errors.New("invalid polyglot book")
+
+// entrySize is the size of the big endian polyglot entry: key, move, weight and learn
+const entrySize = 16
+
+// Entry is the polyglot book entry, the move is in the polyglot encoding
+type Entry struct {
+	Key    uint64
+	Move   uint16
+	Weight uint16
+	Learn  uint32
+}
+
+// Move is the book move with its weight
+type Move struct {
+	Move   engine.Move
+	Weight uint16
+}
+
+// Book is the polyglot opening book with the entries sorted by the key
+type Book struct {
+	entries []Entry
+}
+
+// New creates the book from the entries
+func New(entries []Entry) *Book {
+	b := &Book{entries: slices.Clone(entries)}
+	b.sort()
+
+	return b
+}
+
+// Open reads the polyglot book file
+func Open(path string) (*Book, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open book: %w", err)
+	}
+	defer f.Close()
+
+	return Read(f)
+}
+
+// Read reads the polyglot book, the entries are sorted in case the book is not
+func Read(r io.Reader) (*Book, error) {
+	var (
+		entries []Entry
+		buf     [entrySize]byte
+	)
+
+	br := bufio.NewReader(r)
+
+	for {
+		if _, err := io.ReadFull(br, buf[:]); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil, fmt.Errorf("%w: truncated entry", ErrInvalidBook)
+			}
+
+			return nil, fmt.Errorf("failed to read book: %w", err)
+		}
+
+		entries = append(entries, Entry{
+			Key:    binary.BigEndian.Uint64(buf[0:8]),
+			Move:   binary.BigEndian.Uint16(buf[8:10]),
+			Weight: binary.BigEndian.Uint16(buf[10:12]),
+			Learn:  binary.BigEndian.Uint32(buf[12:16]),
+		})
+	}
+
+	b := &Book{entries: entries}
+	b.sort()
+
+	return b, nil
+}
+
+// WriteTo writes the book in the polyglot format
+func (b *Book) WriteTo(w io.Writer) (int64, error) {
+	var (
+		n   int64
+		buf [entrySize]byte
+	)
+
+	bw := bufio.NewWriter(w)
+
+	for _, e := range b.entries {
+		binary.BigEndian.PutUint64(buf[0:8], e.Key)
+		binary.BigEndian.PutUint16(buf[8:10], e.Move)
+		binary.BigEndian.PutUint16(buf[10:12], e.Weight)
+		binary.BigEndian.PutUint32(buf[12:16], e.Learn)
+
+		written, err := bw.Write(buf[:])
+		n += int64(written)
+
+		if err != nil {
+			return n, fmt.Errorf("failed to write book: %w", err)
+		}
+	}
+
+	if err := bw.Flush(); err != nil {
+		return n, fmt.Errorf("failed to write book: %w", err)
+	}
+
+	return n, nil
+}
+
+// Len returns the number of the entries
+func (b *Book) Len() int {
+	return len(b.entries)
+}
+
+// Entries returns the entries of the position key
+func (b *Book) Entries(key uint64) []Entry {
+	i, _ := slices.BinarySearchFunc(b.entries, key, func(e Entry, key uint64) int { return cmp.Compare(e.Key, key) })
+
+	j := i
+	for j < len(b.entries) && b.entries[j].Key == key {
+		j++
+	}
+
+	return b.entries[i:j]
+}
+
+// Moves returns the legal book moves of the position sorted by the weight, the entries of the moves that are not legal are skipped
+func (b *Book) Moves(p *engine.Position) []Move {
+	entries := b.Entries(p.PolyglotKey())
+	if len(entries) == 0 {
+		return nil
+	}
+
+	legalMoves := p.LegalMoves()
+
+	var moves []Move
+
+	for _, e := range entries {
+		for _, m := range legalMoves {
+			if !m.IsDrop() && EncodeMove(p, m) == e.Move {
+				moves = append(moves, Move{Move: m, Weight: e.Weight})
+				break
+			}
+		}
+	}
+
+	slices.SortStableFunc(moves, func(a, b Move) int { return cmp.Compare(b.Weight, a.Weight) })
+
+	return moves
+}
+
+// Pick picks the book move with the probability proportional to its weight, it is false when the position is out of book
+func (b *Book) Pick(p *engine.Position, rnd *rand.Rand) (engine.Move, bool) {
+	moves := b.Moves(p)
+
+	var total int
+	for _, m := range moves {
+		total += int(m.Weight)
+	}
+
+	if total == 0 {
+		return 0, false
+	}
+
+	n := rnd.IntN(total)
+
+	for _, m := range moves {
+		n -= int(m.Weight)
+		if n < 0 {
+			return m.Move, true
+		}
+	}
+
+	return 0, false
+}
+
+// EncodeMove encodes the move in the polyglot format, the castle moves are written as the king takes its own rook
+func EncodeMove(p *engine.Position, m engine.Move) uint16 {
+	src, dest := m.SrcDest()
+
+	if m.IsKingSideCastle() {
+		dest = p.CastleRooks[p.Turn][engine.KingSide]
+	} else if m.IsQueenSideCastle() {
+		dest = p.CastleRooks[p.Turn][engine.QueenSide]
+	}
+
+	var promo uint16
+
+	switch m.Promotion() {
+	case engine.PromotionKnight:
+		promo = 1
+	case engine.PromotionBishop:
+		promo = 2
+	case engine.PromotionRook:
+		promo = 3
+	case engine.PromotionQueen:
+		promo = 4
+	}
+
+	return uint16(dest.File()) | uint16(dest.Rank())<<3 | uint16(src.File())<<6 | uint16(src.Rank())<<9 | promo<<12
+}
+
+// sort orders the entries by the key and the higher weight first
+func (b *Book) sort() {
+	slices.SortFunc(b.entries, func(x, y Entry) int {
+		if c := cmp.Compare(x.Key, y.Key); c != 0 {
+			return c
+		}
+
+		if c := cmp.Compare(y.Weight, x.Weight); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(x.Move, y.Move)
+	})
+}
@@ -0,0 +1,173 @@
+package book
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/dankobg/juicer/engine"
+	"github.com/dankobg/juicer/engine/pgn"
+)
+
+const gamesPGN = `[Result "1-0"]
+
+1. e4 e5 2. Nf3 Nc6 3. Bb5 a6 4. O-O 1-0
+
+[Result "1/2-1/2"]
+
+1. e4 c5 2. Nf3 d6 1/2-1/2
+
+[Result "0-1"]
+
+1. d4 d5 2. c4 e6 0-1
+
+[Result "*"]
+
+1. c4 e5 *
+`
+
+func buildBook(t *testing.T, maxPly int) *Book {
+	t.Helper()
+
+	builder := NewBuilder(maxPly)
+
+	games, err := pgn.NewReader(strings.NewReader(gamesPGN)).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read games: %v", err)
+	}
+
+	for _, g := range games {
+		if err := builder.AddGame(g); err != nil {
+			t.Fatalf("failed to add game: %v", err)
+		}
+	}
+
+	return builder.Book()
+}
+
+func loadPosition(t *testing.T, fen string) *engine.Position {
+	t.Helper()
+
+	p := &engine.Position{}
+	if err := p.LoadFromFEN(fen); err != nil {
+		t.Fatalf("failed to load fen: %v", err)
+	}
+
+	return p
+}
+
+func TestBookMoves(t *testing.T) {
+	b := buildBook(t, 8)
+
+	testCases := map[string]struct {
+		fen  string
+		want []string
+	}{
+		"starting position": {fen: engine.FENStartingPosition, want: []string{"e2e4:3"}},
+		"after e4":          {fen: "rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 1", want: []string{"c7c5:1"}},
+		"after d4":          {fen: "rnbqkbnr/pppppppp/8/8/3P4/8/PPP1PPPP/RNBQKBNR b KQkq d3 0 1", want: []string{"d7d5:2"}},
+		"castle":            {fen: "r1bqkbnr/1ppp1ppp/p1n5/1B2p3/4P3/5N2/PPPP1PPP/RNBQK2R w KQkq - 0 4", want: []string{"e1g1:2"}},
+		"out of book":       {fen: "rnbqkbnr/pppppppp/8/8/2P5/8/PP1PPPPP/RNBQKBNR b KQkq c3 0 1", want: nil},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			for _, m := range b.Moves(loadPosition(t, tc.fen)) {
+				got = append(got, fmt.Sprintf("%s:%d", m.Move, m.Weight))
+			}
+
+			if strings.Join(got, " ") != strings.Join(tc.want, " ") {
+				t.Fatalf("invalid book moves: want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestBookReadWrite(t *testing.T) {
+	b := buildBook(t, 8)
+
+	var buf bytes.Buffer
+
+	n, err := b.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("failed to write book: %v", err)
+	}
+
+	if n != int64(b.Len()*entrySize) {
+		t.Fatalf("invalid written bytes: want %d, got %d", b.Len()*entrySize, n)
+	}
+
+	got, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("failed to read book: %v", err)
+	}
+
+	if got.Len() != b.Len() {
+		t.Fatalf("invalid entries: want %d, got %d", b.Len(), got.Len())
+	}
+
+	if _, err := Read(bytes.NewReader(make([]byte, entrySize+3))); !errors.Is(err, ErrInvalidBook) {
+		t.Fatalf("invalid error: want %v, got %v", ErrInvalidBook, err)
+	}
+}
+
+func TestEncodeMove(t *testing.T) {
+	testCases := map[string]struct {
+		fen  string
+		uci  string
+		want uint16
+	}{
+		"e2e4":             {fen: engine.FENStartingPosition, uci: "e2e4", want: 4<<6 | 1<<9 | 4 | 3<<3},
+		"king side castle": {fen: "4k3/8/8/8/8/8/8/4K2R w K - 0 1", uci: "e1g1", want: 4<<6 | 7},
+		"queen promotion":  {fen: "4k3/P7/8/8/8/8/8/4K3 w - - 0 1", uci: "a7a8q", want: 6<<9 | 7<<3 | 4<<12},
+		"knight promotion": {fen: "4k3/P7/8/8/8/8/8/4K3 w - - 0 1", uci: "a7a8n", want: 6<<9 | 7<<3 | 1<<12},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := loadPosition(t, tc.fen)
+
+			for _, m := range p.LegalMoves() {
+				if m.ToUCI() == tc.uci {
+					if got := EncodeMove(p, m); got != tc.want {
+						t.Fatalf("invalid encoded move: want %#x, got %#x", tc.want, got)
+					}
+
+					return
+				}
+			}
+
+			t.Fatalf("move %s not found", tc.uci)
+		})
+	}
+}
+
+func TestBookPick(t *testing.T) {
+	b := New([]Entry{
+		{Key: loadPosition(t, engine.FENStartingPosition).PolyglotKey(), Move: 4<<6 | 1<<9 | 4 | 3<<3, Weight: 3},
+		{Key: loadPosition(t, engine.FENStartingPosition).PolyglotKey(), Move: 3<<6 | 1<<9 | 3 | 3<<3, Weight: 1},
+	})
+
+	rnd := rand.New(rand.NewPCG(1, 2))
+	p := loadPosition(t, engine.FENStartingPosition)
+
+	counts := make(map[string]int)
+	for range 400 {
+		m, ok := b.Pick(p, rnd)
+		if !ok {
+			t.Fatalf("invalid pick: want book move, got none")
+		}
+
+		counts[m.String()]++
+	}
+
+	if counts["e2e4"] <= counts["d2d4"] || counts["e2e4"]+counts["d2d4"] != 400 {
+		t.Fatalf("invalid picks by weight: got %v", counts)
+	}
+
+	if _, ok := b.Pick(loadPosition(t, "4k3/8/8/8/8/8/8/4K3 w - - 0 1"), rnd); ok {
+		t.Fatalf("invalid pick: want none out of book")
+	}
+}
@@ -0,0 +1,93 @@
+package book
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/dankobg/juicer/engine"
+	"github.com/dankobg/juicer/engine/pgn"
+)
+
+var ErrUnsupportedVariant = errors.New("polyglot book supports only standard chess")
+
+// the points of the move by the game result for the side that played it
+const (
+	winPoints  = 2
+	drawPoints = 1
+)
+
+type entryKey struct {
+	key  uint64
+	move uint16
+}
+
+// Builder builds the polyglot book from the finished games, the moves are weighted by the results of the games they were played in
+type Builder struct {
+	maxPly int
+	points map[entryKey]uint64
+}
+
+// NewBuilder creates the book builder which takes the moves up to the max ply of every game
+func NewBuilder(maxPly int) *Builder {
+	return &Builder{maxPly: maxPly, points: make(map[entryKey]uint64)}
+}
+
+// AddGame adds the main line moves of the finished game, the winner gets 2 points and both sides 1 point for the draw
+// the games without the result are skipped and the moves of the loser are not added
+func (b *Builder) AddGame(g *pgn.Game) error {
+	if g.Root.Position.Variant != engine.VariantStandard {
+		return fmt.Errorf("%w: %s", ErrUnsupportedVariant, g.Root.Position.Variant)
+	}
+
+	result := g.Result()
+	if result == pgn.ResultUnknown {
+		return nil
+	}
+
+	for ply, n := range g.MainLine() {
+		if ply >= b.maxPly {
+			break
+		}
+
+		p := n.Parent.Position
+
+		var points uint64
+
+		switch {
+		case result == pgn.ResultDraw:
+			points = drawPoints
+		case result == pgn.ResultWhiteWon && p.Turn.IsWhite(), result == pgn.ResultBlackWon && p.Turn.IsBlack():
+			points = winPoints
+		}
+
+		if points == 0 {
+			continue
+		}
+
+		b.points[entryKey{key: p.PolyglotKey(), move: EncodeMove(p, n.Move)}] += points
+	}
+
+	return nil
+}
+
+// Book creates the book from the added games, the weights are scaled down proportionally when the points don't fit the polyglot weight
+func (b *Builder) Book() *Book {
+	var maxPoints uint64
+	for _, points := range b.points {
+		maxPoints = max(maxPoints, points)
+	}
+
+	entries := make([]Entry, 0, len(b.points))
+
+	for k, points := range b.points {
+		weight := points
+		if maxPoints > math.MaxUint16 {
+			weight = max(1, points*math.MaxUint16/maxPoints)
+		}
+
+		entries = append(entries, Entry{Key: k.key, Move: k.move, Weight: uint16(weight)})
+	}
+
+	return New(entries)
+}
@@ -3,6 +3,7 @@ package engine
 import (
 	"fmt"
 	"strings"
+	"unicode"
 )
 
 type CastleRights uint8
@@ -104,8 +105,10 @@ func (cr *CastleRights) clear() {
 	*cr = CastleRightsNone
 }
 
+// NewCastleRightsFromFen creates the castle rights from the standard fen castle string (KQkq)
+// the chess960 castle strings need the board to resolve the rook files, they are parsed when loading the fen
 func NewCastleRightsFromFen(fenCastle string) (CastleRights, error) {
-	if reCastleRights.MatchString(fenCastle) {
+	if fenCastle != "" && reStandardCastle.MatchString(fenCastle) {
 		var cr CastleRights
 
 		if strings.Contains(fenCastle, wkCastleFen) {
@@ -129,3 +132,234 @@ func NewCastleRightsFromFen(fenCastle string) (CastleRights, error) {
 
 	return CastleRightsNone, fmt.Errorf("invalid castle rights string, doesn't match the pattern")
 }
+
+// CastleSide is the side of the board where the king castles
+type CastleSide uint8
+
+const (
+	KingSide CastleSide = iota
+	QueenSide
+)
+
+// castleRight returns the castle right of the color for the side
+func castleRight(c Color, side CastleSide) CastleRights {
+	switch {
+	case c.IsWhite() && side == KingSide:
+		return WhiteKingSideCastle
+	case c.IsWhite() && side == QueenSide:
+		return WhiteQueenSideCastle
+	case c.IsBlack() && side == KingSide:
+		return BlackKingSideCastle
+	default:
+		return BlackQueenSideCastle
+	}
+}
+
+// castleDestinations returns the king and the rook squares after castling, they are the same in chess960 and in standard chess
+func castleDestinations(c Color, side CastleSide) (Square, Square) {
+	rank := Rank1
+	if c.IsBlack() {
+		rank = Rank8
+	}
+
+	if side == KingSide {
+		return NewSquare(FileG, rank), NewSquare(FileF, rank)
+	}
+
+	return NewSquare(FileC, rank), NewSquare(FileD, rank)
+}
+
+// rankSpan returns the squares between and including the two squares on the same rank
+func rankSpan(a, b Square) bitboard {
+	if a > b {
+		a, b = b, a
+	}
+
+	var span bitboard
+
+	for sq := a; sq <= b; sq++ {
+		span.setBit(sq)
+	}
+
+	return span
+}
+
+// resolveCastleRights resolves the fen castle token (standard, X-FEN or Shredder-FEN) into the castle rights and the castling rook squares
+// the rights without the king on the back rank or without the rook are dropped
+// chess960 is reported when the castling king or rooks are not on the standard squares or the file letters are used
+func resolveCastleRights(token string, squares map[Square]Piece) (CastleRights, [2][2]Square, bool, error) {
+	rooks := defaultCastleRooks
+	cr := CastleRightsNone
+	chess960 := false
+
+	if token == fenNoneSymbol {
+		return cr, rooks, false, nil
+	}
+
+	// the zero piece is the white king so the empty squares must be checked explicitly
+	isPiece := func(sq Square, piece Piece) bool {
+		pc, ok := squares[sq]
+		return ok && pc == piece
+	}
+
+	for _, char := range token {
+		color := White
+		if unicode.IsLower(char) {
+			color = Black
+		}
+
+		backRank := Rank1
+		if color.IsBlack() {
+			backRank = Rank8
+		}
+
+		kingSq := SquareNone
+
+		for f := FileA; f <= FileH; f++ {
+			if sq := NewSquare(f, backRank); isPiece(sq, NewPiece(King, color)) {
+				kingSq = sq
+			}
+		}
+
+		if kingSq == SquareNone {
+			continue
+		}
+
+		rook := NewPiece(Rook, color)
+		rookSq := SquareNone
+
+		var side CastleSide
+
+		switch unicode.ToUpper(char) {
+		case 'K':
+			side = KingSide
+
+			for f := FileH; f > kingSq.File(); f-- {
+				if sq := NewSquare(f, backRank); isPiece(sq, rook) {
+					rookSq = sq
+					break
+				}
+			}
+		case 'Q':
+			side = QueenSide
+
+			for f := FileA; f < kingSq.File(); f++ {
+				if sq := NewSquare(f, backRank); isPiece(sq, rook) {
+					rookSq = sq
+					break
+				}
+			}
+		default:
+			file := File(unicode.ToLower(char) - 'a')
+			if file == kingSq.File() {
+				return cr, rooks, false, fmt.Errorf("invalid castle rights: castling rook file %c is the king file", char)
+			}
+
+			side = KingSide
+			if file < kingSq.File() {
+				side = QueenSide
+			}
+
+			if sq := NewSquare(file, backRank); isPiece(sq, rook) {
+				rookSq = sq
+			}
+
+			chess960 = true
+		}
+
+		if rookSq == SquareNone {
+			continue
+		}
+
+		right := castleRight(color, side)
+		if cr&right != 0 {
+			return cr, rooks, false, fmt.Errorf("invalid castle rights: duplicate castle right %c", char)
+		}
+
+		cr |= right
+		rooks[color][side] = rookSq
+
+		if kingSq.File() != FileE || (rookSq.File() != FileA && rookSq.File() != FileH) {
+			chess960 = true
+		}
+	}
+
+	return cr, rooks, chess960, nil
+}
+
+// defaultCastleRooks are the castling rook squares in standard chess
+var defaultCastleRooks = [2][2]Square{
+	White: {KingSide: H1, QueenSide: A1},
+	Black: {KingSide: H8, QueenSide: A8},
+}
+
+// castleRightsXFEN writes the castle rights with K/Q (k/q) for the outermost castling rooks and with the rook files otherwise
+func (p *Position) castleRightsXFEN() string {
+	if !p.Chess960 || p.CastleRights == CastleRightsNone {
+		return p.CastleRights.ToFEN()
+	}
+
+	var sb strings.Builder
+
+	for _, color := range colors {
+		for _, side := range [2]CastleSide{KingSide, QueenSide} {
+			if p.CastleRights&castleRight(color, side) == 0 {
+				continue
+			}
+
+			rookSq := p.CastleRooks[color][side]
+
+			// the other rooks further from the king on the same side make the K/Q ambiguous
+			beyond := rankSpan(rookSq, NewSquare(FileH, rookSq.Rank()))
+			if side == QueenSide {
+				beyond = rankSpan(rookSq, NewSquare(FileA, rookSq.Rank()))
+			}
+
+			var char string
+
+			if p.Board.pieceOccupancies[color][Rook]&beyond&^rookSq.occupancyMask() != 0 {
+				char = rookSq.File().String()
+			} else if side == KingSide {
+				char = wkCastleFen
+			} else {
+				char = wqCastleFen
+			}
+
+			if color.IsWhite() {
+				char = strings.ToUpper(char)
+			} else {
+				char = strings.ToLower(char)
+			}
+
+			sb.WriteString(char)
+		}
+	}
+
+	return sb.String()
+}
+
+// castleRightsShredderFEN writes the castle rights with the rook files (e.g. HAha)
+func (p *Position) castleRightsShredderFEN() string {
+	if p.CastleRights == CastleRightsNone {
+		return fenNoneSymbol
+	}
+
+	var sb strings.Builder
+
+	for _, color := range colors {
+		for _, side := range [2]CastleSide{KingSide, QueenSide} {
+			if p.CastleRights&castleRight(color, side) == 0 {
+				continue
+			}
+
+			char := p.CastleRooks[color][side].File().String()
+			if color.IsWhite() {
+				char = strings.ToUpper(char)
+			}
+
+			sb.WriteString(char)
+		}
+	}
+
+	return sb.String()
+}
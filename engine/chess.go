@@ -2,11 +2,16 @@ package engine
 
 import (
 	"fmt"
+	"sync"
 )
 
 const (
 	FENEmptyPosition    = "8/8/8/8/8/8/8/8 w KQkq - 0 1"
 	FENStartingPosition = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"
+	// FENStartingPositionHorde has the white horde of pawns without the king
+	FENStartingPositionHorde = "rnbqkbnr/pppppppp/8/1PP2PP1/PPPPPPPP/PPPPPPPP/PPPPPPPP/PPPPPPPP w kq - 0 1"
+	// FENStartingPositionRacingKings has the pieces of both sides on the first two ranks
+	FENStartingPositionRacingKings = "8/8/8/8/8/8/krbnNBRK/qrbnNBRQ w - - 0 1"
 )
 
 type Outcome uint8
@@ -31,6 +36,12 @@ const (
 	StatusFiftyMoveRule
 	StatusSeventyFiveMoveRule
 	StatusInsufficientMaterial
+	StatusKingExploded
+	StatusKingOfTheHill
+	StatusThreeCheck
+	StatusAllPiecesCaptured
+	StatusRaceFinished
+	StatusDeadPosition
 )
 
 type History struct {
@@ -38,41 +49,78 @@ type History struct {
 	pos  Position
 }
 
+// Move returns the move that was played
+func (h History) Move() Move {
+	return h.move
+}
+
+// Position returns the position after the move was played
+func (h History) Position() Position {
+	return h.pos
+}
+
 type Chess struct {
 	Position             *Position
+	StartPosition        *Position
 	Repetitions          uint16
 	History              []History
 	HistoryHashes        []uint64
 	LegalMoves           []Move
 	DisableAutoThreefold bool
+	// deadPosition is the dead position result of the current position, deadPositions keeps the results of the
+	// locked structures seen in the game because the quiet king and bishop moves don't change them
+	deadPosition  deadPositionState
+	deadPositions map[deadPositionKey]bool
 }
 
+var initTablesOnce sync.Once
+
+// InitPrecalculatedTables initialises the attack, zobrist and evaluation tables, calling it is optional
+// because loading the position initialises them lazily and only once
 func InitPrecalculatedTables() {
-	initAllAttackMasksTables()
-	initZobrist()
+	initTablesOnce.Do(func() {
+		initAllAttackMasksTables()
+		initZobrist()
+		initEvalMasks()
+	})
 }
 
 func NewChess(fen string) (*Chess, error) {
+	return newChess(fen, nil)
+}
+
+// newChess starts the game from the fen, the setup adjusts the loaded position before the legal moves are calculated
+func newChess(fen string, setup func(p *Position)) (*Chess, error) {
 	p := &Position{}
 
 	if err := p.LoadFromFEN(fen); err != nil {
 		return nil, fmt.Errorf("failed to start new game: %w", err)
 	}
 
+	if setup != nil {
+		setup(p)
+	}
+
+	return newChessFromPosition(p), nil
+}
+
+func newChessFromPosition(p *Position) *Chess {
 	c := &Chess{
 		Position:      p,
+		StartPosition: p.Copy(),
 		HistoryHashes: []uint64{p.Hash},
 	}
 
 	c.calcLegalMoves()
 
-	return c, nil
+	return c
 }
 
+// calcLegalMoves generates the legal moves into the reused LegalMoves slice
 func (c *Chess) calcLegalMoves() {
-	pseudo := c.Position.generateAllPseudoLegalMoves()
-	legal := c.Position.generateAllLegalMoves(pseudo)
-	c.LegalMoves = legal
+	var ml MoveList
+	c.Position.GenerateLegalMoves(&ml)
+	c.LegalMoves = append(c.LegalMoves[:0], ml.Moves()...)
 }
 
 func (c *Chess) AppendHistoryEntry(m Move, pos Position) {
@@ -87,6 +135,11 @@ func (c *Chess) AppendHistoryHash(hash uint64) {
 func (c *Chess) MakeMove(m Move) {
 	c.Position.MakeMove(m)
 
+	// the position stays alive after the quiet piece move, only the pawn moves and the captures can lock it
+	if c.deadPosition != deadPositionAlive || m.IsCapture() || m.Piece().Kind() == Pawn {
+		c.deadPosition = deadPositionUnknown
+	}
+
 	pos := c.Position.Copy()
 
 	c.AppendHistoryEntry(m, *pos)
@@ -95,6 +148,7 @@ func (c *Chess) MakeMove(m Move) {
 	c.calcLegalMoves()
 }
 
+// MakeMoveUCI makes the uci move, the castle move is accepted both as the king destination and as the king takes rook
 func (c *Chess) MakeMoveUCI(uciMove string) (Move, error) {
 	for _, m := range c.LegalMoves {
 		if m.String() == uciMove {
@@ -103,9 +157,42 @@ func (c *Chess) MakeMoveUCI(uciMove string) (Move, error) {
 		}
 	}
 
+	// the other castle notation is only tried when no move matches exactly, in chess960 the king destination can also be a king step
+	for _, m := range c.LegalMoves {
+		if m.IsCastle() && c.alternativeCastleUCI(m) == uciMove {
+			c.MakeMove(m)
+			return m, nil
+		}
+	}
+
 	return 0, fmt.Errorf("invalid move: %v", uciMove)
 }
 
+func (c *Chess) alternativeCastleUCI(m Move) string {
+	if m.IsChess960Castle() {
+		return m.Src().String() + m.Dest().String()
+	}
+
+	side := KingSide
+	if m.IsQueenSideCastle() {
+		side = QueenSide
+	}
+
+	return m.Src().String() + c.Position.CastleRooks[c.Position.Turn][side].String()
+}
+
+// MakeMoveSAN parses the san move against the current legal moves and makes it
+func (c *Chess) MakeMoveSAN(sanMove string) (Move, error) {
+	m, err := ParseSAN(sanMove, c.LegalMoves)
+	if err != nil {
+		return 0, err
+	}
+
+	c.MakeMove(m)
+
+	return m, nil
+}
+
 func (c *Chess) calcRepetitions() {
 	var reps uint16
 
@@ -124,6 +211,40 @@ func (c *Chess) IsInsufficientMaterial() bool {
 	return c.Position.IsInsufficientMaterial()
 }
 
+// IsDeadPosition checks if neither side can checkmate by any series of legal moves, the result is kept until the
+// next move and the locked structures are searched only once per game
+func (c *Chess) IsDeadPosition() bool {
+	if c.deadPosition == deadPositionUnknown {
+		c.deadPosition = deadPositionAlive
+		if c.isDeadPosition() {
+			c.deadPosition = deadPositionDead
+		}
+	}
+
+	return c.deadPosition == deadPositionDead
+}
+
+func (c *Chess) isDeadPosition() bool {
+	p := c.Position
+	if p.Variant != VariantStandard || !p.isLocked() {
+		return p.IsDeadPosition()
+	}
+
+	key := p.deadPositionKey()
+	if dead, ok := c.deadPositions[key]; ok {
+		return dead
+	}
+
+	if c.deadPositions == nil {
+		c.deadPositions = make(map[deadPositionKey]bool)
+	}
+
+	dead := p.IsDeadPosition()
+	c.deadPositions[key] = dead
+
+	return dead
+}
+
 func (c *Chess) IsThreefoldRepetition() bool {
 	return !c.DisableAutoThreefold && c.Repetitions >= 3
 }
@@ -141,22 +262,78 @@ func (c *Chess) IsDrawBy75MoveRule() bool {
 }
 
 func (c *Chess) IsDraw() bool {
-	return c.IsDrawBy50MoveRule() || c.IsThreefoldRepetition() || c.IsStalemate() || c.IsInsufficientMaterial()
+	return c.IsDrawBy50MoveRule() || c.IsThreefoldRepetition() || c.IsStalemate() || c.IsInsufficientMaterial() || c.IsDeadPosition()
 }
 
 func (c *Chess) IsCheckmate() bool {
-	return len(c.LegalMoves) == 0 && c.Position.Board.IsInCheck(c.Position.Turn)
+	return len(c.LegalMoves) == 0 && c.Position.isInCheck(c.Position.Turn) && !c.IsVariantEnd()
 }
 
 func (c *Chess) IsStalemate() bool {
-	return len(c.LegalMoves) == 0 && !c.Position.Board.IsInCheck(c.Position.Turn)
+	return len(c.LegalMoves) == 0 && !c.Position.isInCheck(c.Position.Turn) && !c.IsVariantEnd()
 }
 
+// IsVariantEnd checks if the game is won by the variant rule, the side that moved last is the winner
+func (c *Chess) IsVariantEnd() bool {
+	return c.Position.isVariantEnd()
+}
+
+// IsKingExploded checks if the side to move lost the king in atomic chess
+func (c *Chess) IsKingExploded() bool {
+	return c.Position.isKingExploded(c.Position.Turn)
+}
+
+// IsKingOfTheHill checks if the side that moved last reached the center with the king in king of the hill
+func (c *Chess) IsKingOfTheHill() bool {
+	return c.Position.isKingOnTheHill(c.Position.Turn.Opposite())
+}
+
+// IsThreeCheck checks if the side that moved last gave the third check in three-check
+func (c *Chess) IsThreeCheck() bool {
+	return c.Position.isThreeCheck(c.Position.Turn.Opposite())
+}
+
+// IsAllPiecesCaptured checks if the side to move lost all the pieces in horde
+func (c *Chess) IsAllPiecesCaptured() bool {
+	return c.Position.isHordeCaptured(c.Position.Turn)
+}
+
+// IsRaceFinished checks if the king reached the 8th rank in racing kings and black had no chance to equalize
+func (c *Chess) IsRaceFinished() bool {
+	return c.Position.isRaceFinished()
+}
+
+// VariantOutcome returns the winner of the game ended by the variant rule, the race is drawn when both kings reached the 8th rank
+func (c *Chess) VariantOutcome() Outcome {
+	return c.Position.VariantOutcome()
+}
+
+// IsTerminated checks if the game is over, use the Status directly when the reason is also needed
 func (c *Chess) IsTerminated() bool {
-	return c.IsDraw() || c.IsCheckmate()
+	return c.Status() != StatusUnknown
 }
 
 func (c *Chess) Status() Status {
+	if c.IsKingExploded() {
+		return StatusKingExploded
+	}
+
+	if c.IsKingOfTheHill() {
+		return StatusKingOfTheHill
+	}
+
+	if c.IsThreeCheck() {
+		return StatusThreeCheck
+	}
+
+	if c.IsAllPiecesCaptured() {
+		return StatusAllPiecesCaptured
+	}
+
+	if c.IsRaceFinished() {
+		return StatusRaceFinished
+	}
+
 	if c.IsInsufficientMaterial() {
 		return StatusInsufficientMaterial
 	}
@@ -185,5 +362,9 @@ func (c *Chess) Status() Status {
 		return StatusStalemate
 	}
 
+	if c.IsDeadPosition() {
+		return StatusDeadPosition
+	}
+
 	return StatusUnknown
 }
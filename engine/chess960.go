@@ -0,0 +1,75 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	Chess960StartPositions        = 960
+	Chess960StandardStartPosition = 518
+)
+
+// chess960KnightPlacements are the knight positions among the 5 remaining empty squares by the Scharnagl numbering
+var chess960KnightPlacements = [10][2]int{
+	{0, 1}, {0, 2}, {0, 3}, {0, 4}, {1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4},
+}
+
+// Chess960StartingFEN returns the fen of the chess960 start position by its Scharnagl index (0-959), 518 is the standard start position
+func Chess960StartingFEN(index int) (string, error) {
+	if index < 0 || index >= Chess960StartPositions {
+		return "", fmt.Errorf("invalid chess960 start position index %d", index)
+	}
+
+	var rank [8]byte
+
+	n := index
+
+	// light squared bishop on b, d, f or h file
+	rank[2*(n%4)+1] = 'b'
+	n /= 4
+
+	// dark squared bishop on a, c, e or g file
+	rank[2*(n%4)] = 'b'
+	n /= 4
+
+	placeOnEmpty := func(nth int, piece byte) {
+		for i := range rank {
+			if rank[i] != 0 {
+				continue
+			}
+
+			if nth == 0 {
+				rank[i] = piece
+				return
+			}
+
+			nth--
+		}
+	}
+
+	placeOnEmpty(n%6, 'q')
+	n /= 6
+
+	knights := chess960KnightPlacements[n]
+	// the second knight index is among the empty squares after the first knight is placed
+	placeOnEmpty(knights[0], 'n')
+	placeOnEmpty(knights[1]-1, 'n')
+
+	// the king is always between the rooks
+	placeOnEmpty(0, 'r')
+	placeOnEmpty(0, 'k')
+	placeOnEmpty(0, 'r')
+
+	black := string(rank[:])
+
+	return fmt.Sprintf("%s/pppppppp/8/8/8/8/PPPPPPPP/%s w KQkq - 0 1", black, strings.ToUpper(black)), nil
+}
+
+// NewChess960 starts the chess960 game, the castle moves are always in the king takes rook notation
+func NewChess960(fen string) (*Chess, error) {
+	// the standard looking fen is still the chess960 game
+	return newChess(fen, func(p *Position) {
+		p.Chess960 = true
+	})
+}
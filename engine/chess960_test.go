@@ -0,0 +1,172 @@
+package engine
+
+import (
+	"testing"
+)
+
+func TestChess960Perft(t *testing.T) {
+	testCases := map[string]struct {
+		fen   string
+		nodes []int64
+	}{
+		"position 1":  {fen: "bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR w HFhf - 2 9", nodes: []int64{21, 528, 12189, 326672}},
+		"position 2":  {fen: "2nnrbkr/p1qppppp/8/1ppb4/6PP/3PP3/PPP2P2/BQNNRBKR w HEhe - 1 9", nodes: []int64{21, 807, 18002, 667366}},
+		"position 3":  {fen: "b1q1rrkb/pppppppp/3nn3/8/P7/1PPP4/4PPPP/BQNNRKRB w GE - 1 9", nodes: []int64{20, 479, 10471, 273318}},
+		"position 4":  {fen: "qbbnnrkr/2pp2pp/p7/1p2pp2/8/P3PP2/1PPP1KPP/QBBNNR1R w hf - 0 9", nodes: []int64{22, 593, 13440, 382958}},
+		"position 5":  {fen: "1nbbnrkr/p1p1ppp1/3p4/1p3P1p/3Pq2P/8/PPP1P1P1/QNBBNRKR w HFhf - 0 9", nodes: []int64{28, 1120, 31058, 1171749}},
+		"position 6":  {fen: "qnbnr1kr/ppp1b1pp/4p3/3p1p2/8/2NPP3/PPP1BPPP/QNB1R1KR w HEhe - 1 9", nodes: []int64{29, 899, 26578, 824055}},
+		"position 7":  {fen: "q1bnrkr1/ppppp2p/2n2p2/4b1p1/2NP4/8/PPP1PPPP/QNB1RRKB w ge - 1 9", nodes: []int64{30, 860, 24566, 732757}},
+		"position 8":  {fen: "qbn1brkr/ppp1p1p1/2n4p/3p1p2/P7/6PP/QPPPPP2/1BNNBRKR w HFhf - 0 9", nodes: []int64{25, 635, 17054, 465806}},
+		"position 9":  {fen: "qnnbbrkr/1p2ppp1/2pp3p/p7/1P5P/2NP4/P1P1PPP1/Q1NBBRKR w HFhf - 0 9", nodes: []int64{24, 572, 15243, 384260}},
+		"position 10": {fen: "qn1rbbkr/ppp2p1p/1n1pp1p1/8/3P4/P6P/1PP1PPPK/QNNRBB1R w hd - 2 9", nodes: []int64{28, 811, 23175, 679699}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for i, want := range tc.nodes {
+				depth := i + 1
+				if testing.Short() && depth > 3 {
+					break
+				}
+
+				if got := Perft(tc.fen, depth); got != want {
+					t.Fatalf("invalid perft nodes at depth %d: want %d, got %d", depth, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestChess960StartingFEN(t *testing.T) {
+	testCases := map[string]struct {
+		index   int
+		want    string
+		wantErr bool
+	}{
+		"first":           {index: 0, want: "bbqnnrkr/pppppppp/8/8/8/8/PPPPPPPP/BBQNNRKR w KQkq - 0 1"},
+		"standard":        {index: Chess960StandardStartPosition, want: FENStartingPosition},
+		"last":            {index: 959, want: "rkrnnqbb/pppppppp/8/8/8/8/PPPPPPPP/RKRNNQBB w KQkq - 0 1"},
+		"fails negative":  {index: -1, wantErr: true},
+		"fails too large": {index: 960, wantErr: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Chess960StartingFEN(tc.index)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("invalid error: want %v, got %v", tc.wantErr, err)
+			}
+
+			if got != tc.want {
+				t.Fatalf("invalid chess960 fen: want %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestChess960StartingFENAllUnique(t *testing.T) {
+	seen := make(map[string]int, Chess960StartPositions)
+
+	for i := range Chess960StartPositions {
+		fen, err := Chess960StartingFEN(i)
+		if err != nil {
+			t.Fatalf("invalid chess960 fen %d: %v", i, err)
+		}
+
+		if prev, ok := seen[fen]; ok {
+			t.Fatalf("duplicate chess960 fen %s: indexes %d and %d", fen, prev, i)
+		}
+
+		seen[fen] = i
+
+		c, err := NewChess960(fen)
+		if err != nil {
+			t.Fatalf("invalid chess960 fen %s: %v", fen, err)
+		}
+
+		if c.Position.CastleRights != WhiteKingSideCastle|WhiteQueenSideCastle|BlackKingSideCastle|BlackQueenSideCastle {
+			t.Fatalf("invalid castle rights for %s: want KQkq, got %s", fen, c.Position.CastleRights)
+		}
+	}
+}
+
+func TestChess960CastleRightsFEN(t *testing.T) {
+	testCases := map[string]struct {
+		fen      string
+		xfen     string
+		shredder string
+	}{
+		"shredder outer rooks":    {fen: "bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR w HFhf - 2 9", xfen: "bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR w KQkq - 2 9", shredder: "bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR w HFhf - 2 9"},
+		"x-fen outer rooks":       {fen: "2nnrbkr/p1qppppp/8/1ppb4/6PP/3PP3/PPP2P2/BQNNRBKR w KQkq - 1 9", xfen: "2nnrbkr/p1qppppp/8/1ppb4/6PP/3PP3/PPP2P2/BQNNRBKR w KQkq - 1 9", shredder: "2nnrbkr/p1qppppp/8/1ppb4/6PP/3PP3/PPP2P2/BQNNRBKR w HEhe - 1 9"},
+		"one side only":           {fen: "qn1rbbkr/ppp2p1p/1n1pp1p1/8/3P4/P6P/1PP1PPPK/QNNRBB1R w hd - 2 9", xfen: "qn1rbbkr/ppp2p1p/1n1pp1p1/8/3P4/P6P/1PP1PPPK/QNNRBB1R w kq - 2 9", shredder: "qn1rbbkr/ppp2p1p/1n1pp1p1/8/3P4/P6P/1PP1PPPK/QNNRBB1R w hd - 2 9"},
+		"inner rook needs file":   {fen: "4k3/8/8/8/8/8/8/1K1R2R1 w D - 0 1", xfen: "4k3/8/8/8/8/8/8/1K1R2R1 w D - 0 1", shredder: "4k3/8/8/8/8/8/8/1K1R2R1 w D - 0 1"},
+		"K is the outermost rook": {fen: "4k3/8/8/8/8/8/8/1K1R2R1 w K - 0 1", xfen: "4k3/8/8/8/8/8/8/1K1R2R1 w K - 0 1", shredder: "4k3/8/8/8/8/8/8/1K1R2R1 w G - 0 1"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := &Position{}
+			if err := p.LoadFromFEN(tc.fen); err != nil {
+				t.Fatalf("invalid fen: %v", err)
+			}
+
+			if !p.Chess960 {
+				t.Fatalf("invalid chess960: want true, got false")
+			}
+
+			if got := p.Fen(); got != tc.xfen {
+				t.Fatalf("invalid x-fen: want %s, got %s", tc.xfen, got)
+			}
+
+			if got := p.ShredderFen(); got != tc.shredder {
+				t.Fatalf("invalid shredder fen: want %s, got %s", tc.shredder, got)
+			}
+		})
+	}
+}
+
+func TestChess960CastleUCI(t *testing.T) {
+	testCases := map[string]struct {
+		fen      string
+		chess960 bool
+		move     string
+		wantUCI  string
+		wantFen  string
+		isCastle bool
+	}{
+		"standard castle":                    {fen: "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", move: "e1g1", wantUCI: "e1g1", wantFen: "r3k2r/8/8/8/8/8/8/R4RK1 b kq - 1 1", isCastle: true},
+		"standard castle king takes rook":    {fen: "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", move: "e1a1", wantUCI: "e1c1", wantFen: "r3k2r/8/8/8/8/8/8/2KR3R b kq - 1 1", isCastle: true},
+		"chess960 castle king takes rook":    {fen: "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", chess960: true, move: "e1h1", wantUCI: "e1h1", wantFen: "r3k2r/8/8/8/8/8/8/R4RK1 b kq - 1 1", isCastle: true},
+		"chess960 castle king destination":   {fen: "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", chess960: true, move: "e1c1", wantUCI: "e1a1", wantFen: "r3k2r/8/8/8/8/8/8/2KR3R b kq - 1 1", isCastle: true},
+		"chess960 king step is not a castle": {fen: "1k6/8/8/8/8/8/8/RK6 w A - 0 1", move: "b1c1", wantUCI: "b1c1", wantFen: "1k6/8/8/8/8/8/8/R1K5 b - - 1 1"},
+		"chess960 castle next to the rook":   {fen: "1k6/8/8/8/8/8/8/RK6 w A - 0 1", move: "b1a1", wantUCI: "b1a1", wantFen: "1k6/8/8/8/8/8/8/2KR4 b - - 1 1", isCastle: true},
+		"chess960 king stays on its square":  {fen: "1k6/8/8/8/8/8/8/6KR w H - 0 1", move: "g1h1", wantUCI: "g1h1", wantFen: "1k6/8/8/8/8/8/8/5RK1 b - - 1 1", isCastle: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			newChessFn := NewChess
+			if tc.chess960 {
+				newChessFn = NewChess960
+			}
+
+			c, err := newChessFn(tc.fen)
+			if err != nil {
+				t.Fatalf("invalid fen: %v", err)
+			}
+
+			m, err := c.MakeMoveUCI(tc.move)
+			if err != nil {
+				t.Fatalf("invalid move: %v", err)
+			}
+
+			if m.IsCastle() != tc.isCastle {
+				t.Fatalf("invalid castle: want %v, got %v", tc.isCastle, m.IsCastle())
+			}
+
+			if m.ToUCI() != tc.wantUCI {
+				t.Fatalf("invalid uci: want %s, got %s", tc.wantUCI, m.ToUCI())
+			}
+
+			if got := c.Position.Fen(); got != tc.wantFen {
+				t.Fatalf("invalid fen after castle: want %s, got %s", tc.wantFen, got)
+			}
+		})
+	}
+}
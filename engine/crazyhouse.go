@@ -0,0 +1,144 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	fenPocketOpen   = "["
+	fenPocketClose  = "]"
+	fenPromotedMark = "~"
+
+	// maxPocketCount is the most pieces of one kind in the pocket (all 16 pawns)
+	maxPocketCount = 16
+)
+
+// Pockets are the crazyhouse captured pieces that can be dropped by color and piece kind, the king is never in the pocket
+type Pockets [2][6]uint8
+
+// Count returns how many pieces of the kind the color can drop
+func (pk Pockets) Count(c Color, kind PieceKind) int {
+	return int(pk[c][kind])
+}
+
+// IsEmpty checks whether neither side has anything to drop
+func (pk Pockets) IsEmpty() bool {
+	return pk == Pockets{}
+}
+
+// String returns the fen pocket content e.g. "QNpp", the white pieces are first
+func (pk Pockets) String() string {
+	var sb strings.Builder
+
+	for _, color := range colors {
+		for _, kind := range pieceKinds {
+			sb.WriteString(strings.Repeat(NewPiece(kind, color).FENSymbol(), int(pk[color][kind])))
+		}
+	}
+
+	return sb.String()
+}
+
+// parsePockets parses the fen pocket content without the brackets
+func parsePockets(s string) (Pockets, error) {
+	var pk Pockets
+
+	for _, char := range s {
+		piece, err := NewPieceFromFenSymbol(string(char))
+		if err != nil || piece.IsKing() {
+			return pk, fmt.Errorf("invalid pocket piece %q", char)
+		}
+
+		if pk[piece.Color()][piece.Kind()] == maxPocketCount {
+			return pk, fmt.Errorf("too many %s pieces in the pocket", piece.FENSymbol())
+		}
+
+		pk[piece.Color()][piece.Kind()]++
+	}
+
+	return pk, nil
+}
+
+// splitFenPocket splits the fen position part from the crazyhouse pocket written as the `[...]` suffix or as the 9th rank
+func splitFenPocket(position string) (string, string, bool) {
+	if strings.HasSuffix(position, fenPocketClose) {
+		if i := strings.LastIndex(position, fenPocketOpen); i >= 0 {
+			return position[:i], position[i+1 : len(position)-1], true
+		}
+	}
+
+	if strings.Count(position, fenPositionSeparator) == boardSize {
+		i := strings.LastIndex(position, fenPositionSeparator)
+		return position[:i], position[i+1:], true
+	}
+
+	return position, "", false
+}
+
+// generateDropMoves generates the crazyhouse drops of the pocket pieces to the empty squares, pawns can't be dropped on the 1st and 8th rank
+// in check the pieces can be dropped only between the king and the checker
+func (p *Position) generateDropMoves(ml *MoveList, masks moveMasks) {
+	empty := ^p.Board.sideOccupancies[Both] & masks.target
+
+	for _, kind := range pieceKinds {
+		if kind == King || p.Pockets[p.Turn][kind] == 0 {
+			continue
+		}
+
+		targets := empty
+		if kind == Pawn {
+			targets &^= bitboardUniverseRanksMask[Rank1] | bitboardUniverseRanksMask[Rank8]
+		}
+
+		piece := NewPiece(kind, p.Turn)
+
+		for targets > 0 {
+			ml.add(newDropMove(Square(targets.PopLS1B()), piece))
+		}
+	}
+}
+
+// dropPiece puts the piece from the pocket on the board
+func (p *Position) dropPiece(m Move) {
+	piece := m.Piece()
+
+	p.setPocketCount(p.Turn, piece.Kind(), p.Pockets[p.Turn][piece.Kind()]-1)
+	p.Hash ^= defaultZobrist.occupanciesKeys[p.Turn][piece.Kind()][m.Dest()]
+}
+
+// pocketCapturedPiece puts the captured piece into the pocket of the side to move, the promoted pieces become pawns again
+func (p *Position) pocketCapturedPiece(sq Square, captured Piece) {
+	kind := captured.Kind()
+
+	if p.Promoted.bitIsSet(sq) {
+		kind = Pawn
+		p.Promoted.clearBit(sq)
+	}
+
+	if p.Pockets[p.Turn][kind] < maxPocketCount {
+		p.setPocketCount(p.Turn, kind, p.Pockets[p.Turn][kind]+1)
+	}
+}
+
+// movePromoted keeps track of the promoted pieces so they can be pocketed as pawns when captured
+func (p *Position) movePromoted(m Move) {
+	if m.IsDrop() {
+		return
+	}
+
+	if p.Promoted.bitIsSet(m.Src()) {
+		p.Promoted.clearBit(m.Src())
+		p.Promoted.setBit(m.Dest())
+	}
+
+	if m.Promotion().IsPromotion() {
+		p.Promoted.setBit(m.Dest())
+	}
+}
+
+func (p *Position) setPocketCount(c Color, kind PieceKind, count uint8) {
+	p.Hash ^= zobristPocketKey(c, kind, p.Pockets[c][kind])
+	p.Pockets[c][kind] = count
+	p.Hash ^= zobristPocketKey(c, kind, count)
+}
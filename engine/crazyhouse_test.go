@@ -0,0 +1,159 @@
+package engine
+
+import (
+	"testing"
+)
+
+func TestCrazyhousePerft(t *testing.T) {
+	testCases := map[string]struct {
+		fen   string
+		nodes []int64
+	}{
+		"starting position":        {fen: "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[] w KQkq - 0 1", nodes: []int64{20, 400, 8902, 197281}},
+		"full pockets":             {fen: "2k5/8/8/8/8/8/8/4K3[QRBNPqrbnp] w - - 0 1", nodes: []int64{301, 75353}},
+		"pawn pockets":             {fen: "r1bqkb1r/ppp2ppp/2n2n2/3pp3/4P3/2N2N2/PPPP1PPP/R1BQKB1R[Pp] w KQkq - 0 5", nodes: []int64{58, 3651, 163942}},
+		"kiwipete with knights":    {fen: "r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R[Nn] w KQkq - 0 1", nodes: []int64{80, 5852, 403417}},
+		"captured promotion":       {fen: "3rk3/1P6/8/8/8/8/8/4K3[] w - - 0 1", nodes: []int64{7, 79, 784, 12341}},
+		"promoted queen":           {fen: "4k3/8/8/8/8/8/1q6/Q~3K3[] b - - 0 1", nodes: []int64{28, 362, 7981, 143507}},
+		"drops block the check":    {fen: "rnb1kbnr/ppp2ppp/8/3pp3/4P2q/5P2/PPPP2PP/RNBQKBNR[] w KQkq - 1 4", nodes: []int64{2, 92, 1981}},
+		"nine ranks pocket format": {fen: "2k5/8/8/8/8/8/8/4K3/QRBNPqrbnp w - - 0 1", nodes: []int64{301, 75353}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for i, want := range tc.nodes {
+				if got := Perft(tc.fen, i+1); got != want {
+					t.Fatalf("invalid perft nodes at depth %d: want %d, got %d", i+1, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCrazyhouseFen(t *testing.T) {
+	testCases := map[string]struct {
+		fen     string
+		want    string
+		wantErr bool
+	}{
+		"empty pockets":         {fen: "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[] w KQkq - 0 1", want: "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[] w KQkq - 0 1"},
+		"pockets are ordered":   {fen: "4k3/8/8/8/8/8/8/4K3[pPnQqP] w - - 0 1", want: "4k3/8/8/8/8/8/8/4K3[QPPqnp] w - - 0 1"},
+		"nine ranks pocket":     {fen: "4k3/8/8/8/8/8/8/4K3/Qp w - - 0 1", want: "4k3/8/8/8/8/8/8/4K3[Qp] w - - 0 1"},
+		"promoted pieces":       {fen: "4k1Q~1/8/8/8/8/8/8/n~3K3[] b - - 0 1", want: "4k1Q~1/8/8/8/8/8/8/n~3K3[] b - - 0 1"},
+		"fails with the king":   {fen: "4k3/8/8/8/8/8/8/4K3[K] w - - 0 1", wantErr: true},
+		"fails invalid piece":   {fen: "4k3/8/8/8/8/8/8/4K3[X] w - - 0 1", wantErr: true},
+		"fails promoted mark":   {fen: "4k3/8/8/8/8/8/8/~4K3[] w - - 0 1", wantErr: true},
+		"fails too many pieces": {fen: "4k3/8/8/8/8/8/8/4K3[PPPPPPPPPPPPPPPPP] w - - 0 1", wantErr: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := &Position{}
+
+			err := p.LoadFromFEN(tc.fen)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("invalid error: want %v, got %v", tc.wantErr, err)
+			}
+
+			if tc.wantErr {
+				return
+			}
+
+			if p.Variant != VariantCrazyhouse {
+				t.Fatalf("invalid variant: want %s, got %s", VariantCrazyhouse, p.Variant)
+			}
+
+			if got := p.Fen(); got != tc.want {
+				t.Fatalf("invalid fen: want %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCrazyhouseMoves(t *testing.T) {
+	testCases := map[string]struct {
+		fen     string
+		moves   []string
+		wantFen string
+	}{
+		"capture goes to the pocket":          {fen: FENStartingPosition, moves: []string{"e2e4", "d7d5", "e4d5"}, wantFen: "rnbqkbnr/ppp1pppp/8/3P4/8/8/PPPP1PPP/RNBQKBNR[P] b KQkq - 0 2"},
+		"drop from the pocket":                {fen: FENStartingPosition, moves: []string{"e2e4", "d7d5", "e4d5", "d8d5", "P@e4"}, wantFen: "rnb1kbnr/ppp1pppp/8/3q4/4P3/8/PPPP1PPP/RNBQKBNR[p] b KQkq - 0 3"},
+		"en passant goes to the pocket":       {fen: "4k3/8/8/3pP3/8/8/8/4K3[] w - d6 0 1", moves: []string{"e5d6"}, wantFen: "4k3/8/3P4/8/8/8/8/4K3[P] b - - 0 1"},
+		"captured promotion is pocketed pawn": {fen: "3rk3/1P6/8/8/8/8/8/4K3[] w - - 0 1", moves: []string{"b7b8q", "d8b8"}, wantFen: "1r2k3/8/8/8/8/8/8/4K3[p] w - - 0 2"},
+		"promoted piece keeps the mark":       {fen: "4k3/1P6/8/8/8/8/8/4K3[] w - - 0 1", moves: []string{"b7b8q", "e8e7", "b8b1"}, wantFen: "8/4k3/8/8/8/8/8/1Q~2K3[] b - - 2 2"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewChessVariant(tc.fen, VariantCrazyhouse)
+			if err != nil {
+				t.Fatalf("invalid fen: %v", err)
+			}
+
+			for _, uci := range tc.moves {
+				if _, err := c.MakeMoveUCI(uci); err != nil {
+					t.Fatalf("failed to make move %s: %v", uci, err)
+				}
+			}
+
+			if got := c.Position.Fen(); got != tc.wantFen {
+				t.Fatalf("invalid fen: want %s, got %s", tc.wantFen, got)
+			}
+
+			p := &Position{}
+			if err := p.LoadFromFEN(tc.wantFen); err != nil {
+				t.Fatalf("invalid fen: %v", err)
+			}
+
+			if p.Hash != c.Position.Hash {
+				t.Fatalf("invalid hash after the moves: want %d, got %d", p.Hash, c.Position.Hash)
+			}
+		})
+	}
+}
+
+func TestCrazyhouseDropSAN(t *testing.T) {
+	testCases := map[string]struct {
+		fen     string
+		san     string
+		uci     string
+		wantSAN string
+		wantErr bool
+	}{
+		"knight drop":           {fen: "4k3/8/8/8/8/8/8/4K3[N] w - - 0 1", san: "N@f3", uci: "N@f3", wantSAN: "N@f3"},
+		"pawn drop":             {fen: "4k3/8/8/8/8/8/8/4K3[P] w - - 0 1", san: "P@e4", uci: "P@e4", wantSAN: "P@e4"},
+		"pawn drop short":       {fen: "4k3/8/8/8/8/8/8/4K3[P] w - - 0 1", san: "@e4", uci: "P@e4", wantSAN: "P@e4"},
+		"drop check":            {fen: "4k3/8/8/8/8/8/8/4K3[Q] w - - 0 1", san: "Q@e2+", uci: "Q@e2", wantSAN: "Q@e2+"},
+		"fails pawn on 8th":     {fen: "4k3/8/8/8/8/8/8/4K3[P] w - - 0 1", san: "P@a8", wantErr: true},
+		"fails occupied square": {fen: "4k3/8/8/8/8/8/8/4K3[N] w - - 0 1", san: "N@e1", wantErr: true},
+		"fails empty pocket":    {fen: "4k3/8/8/8/8/8/8/4K3[n] w - - 0 1", san: "N@f3", wantErr: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := &Position{}
+			if err := p.LoadFromFEN(tc.fen); err != nil {
+				t.Fatalf("invalid fen: %v", err)
+			}
+
+			legalMoves := p.LegalMoves()
+
+			m, err := ParseSAN(tc.san, legalMoves)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("invalid error: want %v, got %v", tc.wantErr, err)
+			}
+
+			if tc.wantErr {
+				return
+			}
+
+			if m.ToUCI() != tc.uci {
+				t.Fatalf("invalid uci: want %s, got %s", tc.uci, m.ToUCI())
+			}
+
+			p.MakeMove(m)
+			isCheck := p.Check
+			p.UnmakeMove()
+
+			if got := m.ToSAN(p, isCheck, false, legalMoves); got != tc.wantSAN {
+				t.Fatalf("invalid san: want %s, got %s", tc.wantSAN, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package engine
+
+import "slices"
+
+// deadPositionMaxPositions bounds the reachable positions explored by the dead position search, the position is
+// considered alive when the bound is hit before all of them are seen
+const deadPositionMaxPositions = 50_000
+
+type deadPositionState uint8
+
+const (
+	deadPositionUnknown deadPositionState = iota
+	deadPositionAlive
+	deadPositionDead
+)
+
+// deadPositionKey is the pawn structure and the piece set of the locked position, the kings and the bishops wander
+// around the same structure so the positions with the same key reached in the game share the dead position result
+type deadPositionKey struct {
+	pawns        [2]bitboard
+	bishops      [2]uint8
+	lightBishops bool
+}
+
+func (p *Position) deadPositionKey() deadPositionKey {
+	b := p.Board
+
+	return deadPositionKey{
+		pawns:        [2]bitboard{b.pieceOccupancies[White][Pawn], b.pieceOccupancies[Black][Pawn]},
+		bishops:      [2]uint8{b.pieceOccupancies[White][Bishop].populationCount(), b.pieceOccupancies[Black][Bishop].populationCount()},
+		lightBishops: (b.pieceOccupancies[White][Bishop]|b.pieceOccupancies[Black][Bishop])&bitboardLightSquares != 0,
+	}
+}
+
+// IsDeadPosition checks if neither side can checkmate by any series of legal moves, it covers the insufficient material,
+// the bishops of the same square color and the locked pawn structures where the kings and bishops can't break through.
+// only the standard variant positions are analysed, the other variants fall back to the insufficient material
+func (p *Position) IsDeadPosition() bool {
+	if p.IsInsufficientMaterial() {
+		return true
+	}
+
+	if p.Variant != VariantStandard {
+		return false
+	}
+
+	if p.isSameColorBishopsOnly() {
+		return true
+	}
+
+	if !p.isLocked() {
+		return false
+	}
+
+	mated, complete := p.reachableCheckmates([2]bool{true, true})
+
+	return complete && !mated[White] && !mated[Black]
+}
+
+// CanCheckmate checks if the side can checkmate the opponent by any series of legal moves, it is used to adjudicate
+// the timeout as a draw when the opponent of the flagged side has no way to win
+func (p *Position) CanCheckmate(side Color) bool {
+	if p.Variant != VariantStandard {
+		return !p.IsInsufficientMaterial()
+	}
+
+	if p.Board.sideOccupancies[side] == p.Board.pieceOccupancies[side][King] || p.IsDeadPosition() {
+		return false
+	}
+
+	if !p.isLocked() {
+		return true
+	}
+
+	var targets [2]bool
+	targets[side.Opposite()] = true
+
+	mated, complete := p.reachableCheckmates(targets)
+
+	return !complete || mated[side.Opposite()]
+}
+
+// isSameColorBishopsOnly checks if the kings have only the bishops of the same square color left
+func (p *Position) isSameColorBishopsOnly() bool {
+	b := p.Board
+	bishops := b.pieceOccupancies[White][Bishop] | b.pieceOccupancies[Black][Bishop]
+	kings := b.pieceOccupancies[White][King] | b.pieceOccupancies[Black][King]
+
+	if b.sideOccupancies[Both] != bishops|kings {
+		return false
+	}
+
+	return bishops&bitboardLightSquares == 0 || bishops&bitboardDarkSquares == 0
+}
+
+// isLocked checks if the position is small enough for the reachability search, there are only the kings, the bishops
+// of one square color and the pawns blocked head-on by the enemy pawns which the enemy bishops can't attack
+func (p *Position) isLocked() bool {
+	b := p.Board
+
+	for _, c := range colors {
+		if b.pieceOccupancies[c][Knight]|b.pieceOccupancies[c][Rook]|b.pieceOccupancies[c][Queen] != 0 {
+			return false
+		}
+	}
+
+	if b.pieceOccupancies[White][Pawn]<<8 != b.pieceOccupancies[Black][Pawn] {
+		return false
+	}
+
+	bishops := b.pieceOccupancies[White][Bishop] | b.pieceOccupancies[Black][Bishop]
+
+	bishopSquares := bitboardLightSquares
+	if bishops&bitboardLightSquares != 0 {
+		if bishops&bitboardDarkSquares != 0 {
+			return false
+		}
+	} else {
+		bishopSquares = bitboardDarkSquares
+	}
+
+	for _, c := range colors {
+		if b.pieceOccupancies[c][Bishop] != 0 && b.pieceOccupancies[c.Opposite()][Pawn]&bishopSquares != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// reachableCheckmates walks the positions reachable from the position and reports the colors which can get checkmated,
+// the walk stops at the first checkmate of the targeted colors. complete is false when the bound is hit before that
+func (p *Position) reachableCheckmates(targets [2]bool) (mated [2]bool, complete bool) {
+	pos := p.Copy()
+	seen := make(map[uint64]struct{})
+
+	var (
+		ml    MoveList
+		stack [][]Move
+	)
+
+	// enter pushes the moves of the position that wasn't seen yet, the walk is iterative because the paths of the
+	// wandering kings get as deep as the number of the positions
+	enter := func() bool {
+		if _, ok := seen[pos.Hash]; ok {
+			return false
+		}
+
+		seen[pos.Hash] = struct{}{}
+
+		pos.GenerateLegalMoves(&ml)
+		if ml.Len() == 0 && pos.Check {
+			mated[pos.Turn] = true
+		}
+
+		stack = append(stack, slices.Clone(ml.Moves()))
+
+		return true
+	}
+
+	enter()
+
+	for len(stack) > 0 {
+		if mated[White] && targets[White] || mated[Black] && targets[Black] {
+			return mated, true
+		}
+
+		if len(seen) > deadPositionMaxPositions {
+			return mated, false
+		}
+
+		top := &stack[len(stack)-1]
+		if len(*top) == 0 {
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 {
+				pos.UnmakeMove()
+			}
+
+			continue
+		}
+
+		m := (*top)[0]
+		*top = (*top)[1:]
+
+		pos.MakeMove(m)
+		if !enter() {
+			pos.UnmakeMove()
+		}
+	}
+
+	return mated, true
+}
@@ -0,0 +1,123 @@
+package engine
+
+import "testing"
+
+const fenLockedPawnWall = "4k3/8/8/1p1p1p1p/pPpPpPpP/P1P1P1P1/8/4K3 w - - 0 1"
+
+func TestIsDeadPosition(t *testing.T) {
+	testCases := map[string]struct {
+		fen  string
+		want bool
+	}{
+		"starting position":                {fen: FENStartingPosition, want: false},
+		"kings only":                       {fen: "4k3/8/8/8/8/8/8/4K3 w - - 0 1", want: true},
+		"bishops of the same color":        {fen: "4kb2/8/8/8/8/8/1B6/2B1K3 w - - 0 1", want: true},
+		"bishops of the opposite colors":   {fen: "4kb2/8/8/8/8/8/8/3BK3 w - - 0 1", want: false},
+		"locked pawn wall":                 {fen: fenLockedPawnWall, want: true},
+		"locked wall with the safe bishop": {fen: "4k3/8/8/1p1p1p1p/pPpPpPpP/P1P1P1P1/8/2B1K3 w - - 0 1", want: true},
+		"bishop attacking the wall":        {fen: "4k3/8/8/1p1p1p1p/pPpPpPpP/P1P1P1P1/8/4KB2 w - - 0 1", want: false},
+		"wall with the sealed gap":         {fen: "4k3/8/8/1p1p1p2/pPpPpPp1/P1P1P1P1/8/4K3 w - - 0 1", want: true},
+		"blocked pawns the kings can win":  {fen: "4k3/8/8/4p3/4P3/8/8/4K3 w - - 0 1", want: false},
+		"locked wall with the knight":      {fen: "4k3/8/8/1p1p1p1p/pPpPpPpP/P1P1P1P1/8/4KN2 w - - 0 1", want: false},
+		"checkmate":                        {fen: "R5k1/5ppp/8/8/8/8/8/6K1 b - - 0 1", want: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewChess(tc.fen)
+			if err != nil {
+				t.Fatalf("failed to load fen: %v", err)
+			}
+
+			if got := c.Position.IsDeadPosition(); got != tc.want {
+				t.Fatalf("invalid dead position: want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCanCheckmate(t *testing.T) {
+	testCases := map[string]struct {
+		fen   string
+		white bool
+		black bool
+	}{
+		"queen against the lone king":    {fen: "4k3/8/8/8/8/8/8/4K2Q w - - 0 1", white: true, black: false},
+		"knight against the pawn":        {fen: "4k3/4p3/8/8/8/8/8/4KN2 w - - 0 1", white: true, black: true},
+		"bishops of the opposite colors": {fen: "4kb2/8/8/8/8/8/8/3BK3 w - - 0 1", white: true, black: true},
+		"locked pawn wall":               {fen: fenLockedPawnWall, white: false, black: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewChess(tc.fen)
+			if err != nil {
+				t.Fatalf("failed to load fen: %v", err)
+			}
+
+			if got := c.Position.CanCheckmate(White); got != tc.white {
+				t.Fatalf("invalid white can checkmate: want %v, got %v", tc.white, got)
+			}
+
+			if got := c.Position.CanCheckmate(Black); got != tc.black {
+				t.Fatalf("invalid black can checkmate: want %v, got %v", tc.black, got)
+			}
+		})
+	}
+}
+
+func TestStatusDeadPosition(t *testing.T) {
+	c, err := NewChess(fenLockedPawnWall)
+	if err != nil {
+		t.Fatalf("failed to load fen: %v", err)
+	}
+
+	if got := c.Status(); got != StatusDeadPosition {
+		t.Fatalf("invalid status: want %v, got %v", StatusDeadPosition, got)
+	}
+
+	if !c.IsDraw() || !c.IsTerminated() {
+		t.Fatalf("invalid draw: want the dead position to be terminated")
+	}
+}
+
+func TestChessDeadPositionCache(t *testing.T) {
+	testCases := map[string]struct {
+		fen        string
+		moves      []string
+		want       bool
+		wantCached int
+	}{
+		"alive locked structure":   {fen: "4k3/8/8/4p3/4P3/8/8/4K3 w - - 0 1", moves: []string{"e1d1", "e8d8", "d1e1", "d8e8"}, want: false, wantCached: 1},
+		"dead locked wall":         {fen: fenLockedPawnWall, moves: []string{"e1d1", "e8d8", "d1e1"}, want: true, wantCached: 1},
+		"free pawns are not saved": {fen: "4k3/8/8/4p3/8/3P4/8/4K3 w - - 0 1", moves: []string{"e1d1", "e8d8", "d3d4", "d8d7"}, want: false, wantCached: 0},
+		"not locked is not saved":  {fen: FENStartingPosition, moves: []string{"g1f3", "g8f6"}, want: false, wantCached: 0},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewChess(tc.fen)
+			if err != nil {
+				t.Fatalf("failed to load fen: %v", err)
+			}
+
+			for _, uci := range tc.moves {
+				if _, err := c.MakeMoveUCI(uci); err != nil {
+					t.Fatalf("failed to make move %s: %v", uci, err)
+				}
+
+				if got := c.IsDeadPosition(); got != tc.want {
+					t.Fatalf("invalid dead position after %s: want %v, got %v", uci, tc.want, got)
+				}
+
+				if got := c.IsDeadPosition() == (c.Status() == StatusDeadPosition); !got {
+					t.Fatalf("invalid status after %s: want the same dead position result", uci)
+				}
+			}
+
+			if got := len(c.deadPositions); got != tc.wantCached {
+				t.Fatalf("invalid cached structures: want %d, got %d", tc.wantCached, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,480 @@
+package engine
+
+import "sync/atomic"
+
+// the evaluation is tapered between the middlegame and the endgame scores by the game phase
+// piece values and piece-square tables are PeSTO values (https://www.chessprogramming.org/PeSTO%27s_Evaluation_Function)
+
+const (
+	maxGamePhase    = 24
+	pawnHashSize    = 1 << 14
+	kingDangerScale = 4
+)
+
+type score struct {
+	mg int
+	eg int
+}
+
+func (s *score) add(other score) {
+	s.mg += other.mg
+	s.eg += other.eg
+}
+
+func (s *score) sub(other score) {
+	s.mg -= other.mg
+	s.eg -= other.eg
+}
+
+var (
+	pieceValueMg = [6]int{King: 0, Queen: 1025, Rook: 477, Bishop: 365, Knight: 337, Pawn: 82}
+	pieceValueEg = [6]int{King: 0, Queen: 936, Rook: 512, Bishop: 297, Knight: 281, Pawn: 94}
+
+	gamePhaseIncrement = [6]int{King: 0, Queen: 4, Rook: 2, Bishop: 1, Knight: 1, Pawn: 0}
+
+	// mobility bonus per attacked square, the baseline is subtracted so the average piece scores around 0
+	mobilityWeightMg = [6]int{Queen: 1, Rook: 2, Bishop: 5, Knight: 4}
+	mobilityWeightEg = [6]int{Queen: 2, Rook: 4, Bishop: 5, Knight: 4}
+	mobilityBaseline = [6]int{Queen: 14, Rook: 7, Bishop: 7, Knight: 4}
+
+	// kingAttackWeight is the danger of the piece attacking the squares around the enemy king
+	kingAttackWeight = [6]int{Queen: 5, Rook: 3, Bishop: 2, Knight: 2}
+
+	doubledPawnPenalty  = score{mg: 10, eg: 20}
+	isolatedPawnPenalty = score{mg: 15, eg: 10}
+	// passed pawn bonus by the relative rank
+	passedPawnBonusMg = [8]int{0, 5, 10, 15, 25, 40, 60, 0}
+	passedPawnBonusEg = [8]int{0, 10, 20, 35, 60, 100, 150, 0}
+
+	// pawn shield bonus for the own pawns one and two ranks in front of the king
+	pawnShieldBonus = [3]int{0, 10, 5}
+)
+
+// piece-square tables are from the white point of view with a8 as the first square (index = sq ^ 56 for white)
+var (
+	pstMg = [6][64]int{
+		King: {
+			-65, 23, 16, -15, -56, -34, 2, 13,
+			29, -1, -20, -7, -8, -4, -38, -29,
+			-9, 24, 2, -16, -20, 6, 22, -22,
+			-17, -20, -12, -27, -30, -25, -14, -36,
+			-49, -1, -27, -39, -46, -44, -33, -51,
+			-14, -14, -22, -46, -44, -30, -15, -27,
+			1, 7, -8, -64, -43, -16, 9, 8,
+			-15, 36, 12, -54, 8, -28, 24, 14,
+		},
+		Queen: {
+			-28, 0, 29, 12, 59, 44, 43, 45,
+			-24, -39, -5, 1, -16, 57, 28, 54,
+			-13, -17, 7, 8, 29, 56, 47, 57,
+			-27, -27, -16, -16, -1, 17, -2, 1,
+			-9, -26, -9, -10, -2, -4, 3, -3,
+			-14, 2, -11, -2, -5, 2, 14, 5,
+			-35, -8, 11, 2, 8, 15, -3, 1,
+			-1, -18, -9, 10, -15, -25, -31, -50,
+		},
+		Rook: {
+			32, 42, 32, 51, 63, 9, 31, 43,
+			27, 32, 58, 62, 80, 67, 26, 44,
+			-5, 19, 26, 36, 17, 45, 61, 16,
+			-24, -11, 7, 26, 24, 35, -8, -20,
+			-36, -26, -12, -1, 9, -7, 6, -23,
+			-45, -25, -16, -17, 3, 0, -5, -33,
+			-44, -16, -20, -9, -1, 11, -6, -71,
+			-19, -13, 1, 17, 16, 7, -37, -26,
+		},
+		Bishop: {
+			-29, 4, -82, -37, -25, -42, 7, -8,
+			-26, 16, -18, -13, 30, 59, 18, -47,
+			-16, 37, 43, 40, 35, 50, 37, -2,
+			-4, 5, 19, 50, 37, 37, 7, -2,
+			-6, 13, 13, 26, 34, 12, 10, 4,
+			0, 15, 15, 15, 14, 27, 18, 10,
+			4, 15, 16, 0, 7, 21, 33, 1,
+			-33, -3, -14, -21, -13, -12, -39, -21,
+		},
+		Knight: {
+			-167, -89, -34, -49, 61, -97, -15, -107,
+			-73, -41, 72, 36, 23, 62, 7, -17,
+			-47, 60, 37, 65, 84, 129, 73, 44,
+			-9, 17, 19, 53, 37, 69, 18, 22,
+			-13, 4, 16, 13, 28, 19, 21, -8,
+			-23, -9, 12, 10, 19, 17, 25, -16,
+			-29, -53, -12, -3, -1, 18, -14, -19,
+			-105, -21, -58, -33, -17, -28, -19, -23,
+		},
+		Pawn: {
+			0, 0, 0, 0, 0, 0, 0, 0,
+			98, 134, 61, 95, 68, 126, 34, -11,
+			-6, 7, 26, 31, 65, 56, 25, -20,
+			-14, 13, 6, 21, 23, 12, 17, -23,
+			-27, -2, -5, 12, 17, 6, 10, -25,
+			-26, -4, -4, -10, 3, 3, 33, -12,
+			-35, -1, -20, -23, -15, 24, 38, -22,
+			0, 0, 0, 0, 0, 0, 0, 0,
+		},
+	}
+
+	pstEg = [6][64]int{
+		King: {
+			-74, -35, -18, -18, -11, 15, 4, -17,
+			-12, 17, 14, 17, 17, 38, 23, 11,
+			10, 17, 23, 15, 20, 45, 44, 13,
+			-8, 22, 24, 27, 26, 33, 26, 3,
+			-18, -4, 21, 24, 27, 23, 9, -11,
+			-19, -3, 11, 21, 23, 16, 7, -9,
+			-27, -11, 4, 13, 14, 4, -5, -17,
+			-53, -34, -21, -11, -28, -14, -24, -43,
+		},
+		Queen: {
+			-9, 22, 22, 27, 27, 19, 10, 20,
+			-17, 20, 32, 41, 58, 25, 30, 0,
+			-20, 6, 9, 49, 47, 35, 19, 9,
+			3, 22, 24, 45, 57, 40, 57, 36,
+			-18, 28, 19, 47, 31, 34, 39, 23,
+			-16, -27, 15, 6, 9, 17, 10, 5,
+			-22, -23, -30, -16, -16, -23, -36, -32,
+			-33, -28, -22, -43, -5, -32, -20, -41,
+		},
+		Rook: {
+			13, 10, 18, 15, 12, 12, 8, 5,
+			11, 13, 13, 11, -3, 3, 8, 3,
+			7, 7, 7, 5, 4, -3, -5, -3,
+			4, 3, 13, 1, 2, 1, -1, 2,
+			3, 5, 8, 4, -5, -6, -8, -11,
+			-4, 0, -5, -1, -7, -12, -8, -16,
+			-6, -6, 0, 2, -9, -9, -11, -3,
+			-9, 2, 3, -1, -5, -13, 4, -20,
+		},
+		Bishop: {
+			-14, -21, -11, -8, -7, -9, -17, -24,
+			-8, -4, 7, -12, -3, -13, -4, -14,
+			2, -8, 0, -1, -2, 6, 0, 4,
+			-3, 9, 12, 9, 14, 10, 3, 2,
+			-6, 3, 13, 19, 7, 10, -3, -9,
+			-12, -3, 8, 10, 13, 3, -7, -15,
+			-14, -18, -7, -1, 4, -9, -15, -27,
+			-23, -9, -23, -5, -9, -16, -5, -17,
+		},
+		Knight: {
+			-58, -38, -13, -28, -31, -27, -63, -99,
+			-25, -8, -25, -2, -9, -25, -24, -52,
+			-24, -20, 10, 9, -1, -9, -19, -41,
+			-17, 3, 22, 22, 22, 11, 8, -18,
+			-18, -6, 16, 25, 16, 17, 4, -18,
+			-23, -3, -1, 15, 10, -3, -20, -22,
+			-42, -20, -10, -5, -2, -20, -23, -44,
+			-29, -51, -23, -15, -22, -18, -50, -64,
+		},
+		Pawn: {
+			0, 0, 0, 0, 0, 0, 0, 0,
+			178, 173, 158, 134, 147, 132, 165, 187,
+			94, 100, 85, 67, 56, 53, 82, 84,
+			32, 24, 13, 5, -2, 4, 17, 17,
+			13, 9, -3, -7, -7, -8, 3, -1,
+			4, 7, -6, 1, 0, -5, -1, -8,
+			13, 8, 8, 10, 13, 0, 2, -7,
+			0, 0, 0, 0, 0, 0, 0, 0,
+		},
+	}
+)
+
+var (
+	evalFileMasks         [8]bitboard
+	evalAdjacentFileMasks [8]bitboard
+	// evalPassedPawnMasks are the squares in front of the pawn on the same and adjacent files
+	evalPassedPawnMasks [2][64]bitboard
+)
+
+func initEvalMasks() {
+	for f := range 8 {
+		evalFileMasks[f] = bitboard(0x0101010101010101) << f
+	}
+
+	for f := range 8 {
+		if f > 0 {
+			evalAdjacentFileMasks[f] |= evalFileMasks[f-1]
+		}
+
+		if f < 7 {
+			evalAdjacentFileMasks[f] |= evalFileMasks[f+1]
+		}
+	}
+
+	for sq := A1; sq <= H8; sq++ {
+		files := evalFileMasks[sq.File()] | evalAdjacentFileMasks[sq.File()]
+		rank := int(sq.Rank())
+
+		var inFrontWhite, inFrontBlack bitboard
+
+		for r := rank + 1; r < 8; r++ {
+			inFrontWhite |= bitboard(0xFF) << (8 * r)
+		}
+
+		for r := range rank {
+			inFrontBlack |= bitboard(0xFF) << (8 * r)
+		}
+
+		evalPassedPawnMasks[White][sq] = files & inFrontWhite
+		evalPassedPawnMasks[Black][sq] = files & inFrontBlack
+	}
+}
+
+// pawnHashEntry is stored locklessly, the check is the key xored with the data so the torn writes are detected
+type pawnHashEntry struct {
+	check atomic.Uint64
+	data  atomic.Uint64
+}
+
+var pawnHashTable [pawnHashSize]pawnHashEntry
+
+func probePawnHash(key uint64) (score, bool) {
+	e := &pawnHashTable[key%pawnHashSize]
+
+	data := e.data.Load()
+	if e.check.Load()^data != key {
+		return score{}, false
+	}
+
+	return score{mg: int(int32(data >> 32)), eg: int(int32(data))}, true
+}
+
+func storePawnHash(key uint64, s score) {
+	e := &pawnHashTable[key%pawnHashSize]
+
+	data := uint64(uint32(int32(s.mg)))<<32 | uint64(uint32(int32(s.eg)))
+	e.data.Store(data)
+	e.check.Store(key ^ data)
+}
+
+// Evaluate returns the static evaluation of the position in centipawns from the side to move point of view
+func Evaluate(p *Position) int {
+	b := p.Board
+
+	var (
+		total score
+		phase int
+	)
+
+	for _, color := range colors {
+		var side score
+
+		for _, pk := range pieceKinds {
+			occ := b.pieceOccupancies[color][pk]
+
+			for occ > 0 {
+				sq := Square(occ.PopLS1B())
+
+				idx := sq
+				if color == White {
+					idx ^= 56
+				}
+
+				side.mg += pieceValueMg[pk] + pstMg[pk][idx]
+				side.eg += pieceValueEg[pk] + pstEg[pk][idx]
+				phase += gamePhaseIncrement[pk]
+			}
+
+			// the crazyhouse pocket pieces are worth their material because they can be dropped anywhere
+			if n := p.Pockets.Count(color, pk); n > 0 {
+				side.mg += n * pieceValueMg[pk]
+				side.eg += n * pieceValueEg[pk]
+				phase += n * gamePhaseIncrement[pk]
+			}
+		}
+
+		side.add(evaluateMobility(b, color))
+		side.add(evaluateKingSafety(b, color))
+
+		if color == White {
+			total.add(side)
+		} else {
+			total.sub(side)
+		}
+	}
+
+	total.add(evaluatePawnStructure(b))
+
+	phase = min(phase, maxGamePhase)
+	eval := (total.mg*phase + total.eg*(maxGamePhase-phase)) / maxGamePhase
+
+	if p.Turn.IsBlack() {
+		return -eval
+	}
+
+	return eval
+}
+
+// evaluatePawnStructure scores the doubled, isolated and passed pawns from the white point of view
+// the result depends only on the pawns so it is cached in the pawn hash table
+func evaluatePawnStructure(b *Board) score {
+	key := pawnHashKey(b)
+
+	if s, ok := probePawnHash(key); ok {
+		return s
+	}
+
+	var total score
+
+	for _, color := range colors {
+		var side score
+
+		own := b.pieceOccupancies[color][Pawn]
+		enemy := b.pieceOccupancies[color.Opposite()][Pawn]
+
+		for f := range 8 {
+			if count := int((own & evalFileMasks[f]).populationCount()); count > 1 {
+				side.mg -= doubledPawnPenalty.mg * (count - 1)
+				side.eg -= doubledPawnPenalty.eg * (count - 1)
+			}
+		}
+
+		pawns := own
+		for pawns > 0 {
+			sq := Square(pawns.PopLS1B())
+
+			if own&evalAdjacentFileMasks[sq.File()] == 0 {
+				side.sub(isolatedPawnPenalty)
+			}
+
+			if enemy&evalPassedPawnMasks[color][sq] == 0 {
+				rank := int(sq.Rank())
+				if color == Black {
+					rank = 7 - rank
+				}
+
+				side.mg += passedPawnBonusMg[rank]
+				side.eg += passedPawnBonusEg[rank]
+			}
+		}
+
+		if color == White {
+			total.add(side)
+		} else {
+			total.sub(side)
+		}
+	}
+
+	storePawnHash(key, total)
+
+	return total
+}
+
+func pawnHashKey(b *Board) uint64 {
+	var key uint64
+
+	for _, color := range colors {
+		pawns := b.pieceOccupancies[color][Pawn]
+		for pawns > 0 {
+			key ^= defaultZobrist.occupanciesKeys[color][Pawn][pawns.PopLS1B()]
+		}
+	}
+
+	return key
+}
+
+// evaluateMobility scores the squares attacked by the pieces that are not occupied by the own pieces or attacked by the enemy pawns
+func evaluateMobility(b *Board, color Color) score {
+	var s score
+
+	enemyPawnAttacks := pawnAttacks(b, color.Opposite())
+	available := ^b.sideOccupancies[color] &^ enemyPawnAttacks
+	occupancy := b.sideOccupancies[Both]
+
+	for _, pk := range [4]PieceKind{Queen, Rook, Bishop, Knight} {
+		occ := b.pieceOccupancies[color][pk]
+
+		for occ > 0 {
+			sq := Square(occ.PopLS1B())
+			count := int((pieceAttacks(pk, sq, occupancy) & available).populationCount()) - mobilityBaseline[pk]
+
+			s.mg += count * mobilityWeightMg[pk]
+			s.eg += count * mobilityWeightEg[pk]
+		}
+	}
+
+	return s
+}
+
+// evaluateKingSafety scores the pawn shield in front of the king and the enemy pieces attacking the squares around it
+// it only matters in the middlegame so the endgame score is always 0
+func evaluateKingSafety(b *Board, color Color) score {
+	kings := b.pieceOccupancies[color][King]
+	if kings == 0 {
+		return score{}
+	}
+
+	kingSq := Square(kings.LS1B())
+	ownPawns := b.pieceOccupancies[color][Pawn]
+	shieldFiles := evalFileMasks[kingSq.File()] | evalAdjacentFileMasks[kingSq.File()]
+
+	var s score
+
+	for distance := 1; distance <= 2; distance++ {
+		rank := int(kingSq.Rank()) + distance
+		if color == Black {
+			rank = int(kingSq.Rank()) - distance
+		}
+
+		if rank < 0 || rank > 7 {
+			continue
+		}
+
+		shield := ownPawns & shieldFiles & (bitboard(0xFF) << (8 * rank))
+		s.mg += int(shield.populationCount()) * pawnShieldBonus[distance]
+	}
+
+	zone := kingAttacksMask[kingSq] | kings
+	occupancy := b.sideOccupancies[Both]
+	enemy := color.Opposite()
+
+	var attackers, danger int
+
+	for _, pk := range [4]PieceKind{Queen, Rook, Bishop, Knight} {
+		occ := b.pieceOccupancies[enemy][pk]
+
+		for occ > 0 {
+			sq := Square(occ.PopLS1B())
+
+			if hits := int((pieceAttacks(pk, sq, occupancy) & zone).populationCount()); hits > 0 {
+				attackers++
+				danger += hits * kingAttackWeight[pk]
+			}
+		}
+	}
+
+	// a single attacker is rarely dangerous
+	if attackers > 1 {
+		s.mg -= danger * kingDangerScale * (attackers - 1) / 2
+	}
+
+	return s
+}
+
+func pieceAttacks(pk PieceKind, sq Square, occupancy bitboard) bitboard {
+	switch pk {
+	case Queen:
+		return getQueenAttacks(sq, occupancy)
+	case Rook:
+		return getRookAttacks(sq, occupancy)
+	case Bishop:
+		return getBishopAttacks(sq, occupancy)
+	case Knight:
+		return knightsAttacksMask[sq]
+	case King:
+		return kingAttacksMask[sq]
+	}
+
+	return 0
+}
+
+func pawnAttacks(b *Board, color Color) bitboard {
+	var attacks bitboard
+
+	pawns := b.pieceOccupancies[color][Pawn]
+	for pawns > 0 {
+		attacks |= pawnAttacksMask[color][pawns.PopLS1B()]
+	}
+
+	return attacks
+}
@@ -0,0 +1,71 @@
+package engine
+
+import "testing"
+
+func TestEvaluateSymmetry(t *testing.T) {
+	testCases := map[string]struct {
+		fen      string
+		mirrored string
+	}{
+		"starting position": {fen: FENStartingPosition, mirrored: "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR b KQkq - 0 1"},
+		"italian":           {fen: "r1bqk1nr/pppp1ppp/2n5/2b1p3/2B1P3/5N2/PPPP1PPP/RNBQK2R w KQkq - 4 4", mirrored: "rnbqk2r/pppp1ppp/5n2/2b1p3/2B1P3/2N5/PPPP1PPP/R1BQK1NR b KQkq - 4 4"},
+		"passed pawns":      {fen: "8/5k2/8/2P5/8/8/1p3K2/8 w - - 0 1", mirrored: "8/1P3k2/8/8/2p5/8/5K2/8 b - - 0 1"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p, mirrored := &Position{}, &Position{}
+
+			if err := p.LoadFromFEN(tc.fen); err != nil {
+				t.Fatalf("failed to load fen: %v", err)
+			}
+
+			if err := mirrored.LoadFromFEN(tc.mirrored); err != nil {
+				t.Fatalf("failed to load mirrored fen: %v", err)
+			}
+
+			if got, want := Evaluate(mirrored), Evaluate(p); got != want {
+				t.Fatalf("invalid mirrored evaluation: want %d, got %d", want, got)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	testCases := map[string]struct {
+		fen      string
+		positive bool
+	}{
+		"white up a queen":         {fen: "rnb1kbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1", positive: true},
+		"white up a queen, black":  {fen: "rnb1kbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR b KQkq - 0 1", positive: false},
+		"advanced passed pawn":     {fen: "8/1P3k2/8/8/8/8/5K2/8 w - - 0 1", positive: true},
+		"doubled and isolated":     {fen: "4k3/pp6/8/8/8/P7/P7/4K3 w - - 0 1", positive: false},
+		"exposed king under siege": {fen: "6k1/5p1p/6p1/8/8/5qn1/5P2/6K1 w - - 0 1", positive: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := &Position{}
+			if err := p.LoadFromFEN(tc.fen); err != nil {
+				t.Fatalf("failed to load fen: %v", err)
+			}
+
+			if got := Evaluate(p); (got > 0) != tc.positive {
+				t.Fatalf("invalid evaluation sign: want positive %v, got %d", tc.positive, got)
+			}
+		})
+	}
+}
+
+func TestPawnHash(t *testing.T) {
+	p := &Position{}
+	if err := p.LoadFromFEN("4k3/pp3p2/8/3P4/8/P7/P4P2/4K3 w - - 0 1"); err != nil {
+		t.Fatalf("failed to load fen: %v", err)
+	}
+
+	want := evaluatePawnStructure(p.Board)
+
+	if got, ok := probePawnHash(pawnHashKey(p.Board)); !ok || got != want {
+		t.Fatalf("invalid pawn hash entry: want %v, got %v (found %v)", want, got, ok)
+	}
+}
@@ -1,7 +1,10 @@
 package engine
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
+	"io"
 	"regexp"
 	"strconv"
 	"strings"
@@ -17,18 +20,35 @@ const (
 )
 
 var (
-	reIsDigit        = regexp.MustCompile(`^[0-9]$`)
-	reEnpSquare      = regexp.MustCompile(`^(-|[abcdefgh][36])$`)
-	reCastleRights   = regexp.MustCompile(`(?m)^(-|\bK?Q?k?q?)$`)
+	reIsDigit   = regexp.MustCompile(`^[0-9]$`)
+	reEnpSquare = regexp.MustCompile(`^(-|[abcdefgh][36])$`)
+	// castle rights can be standard (KQkq), X-FEN (KQkq or the rook file letters) or Shredder-FEN (the rook file letters e.g. HAha)
+	reCastleRights   = regexp.MustCompile(`^(-|(K|[A-H])?(Q|[A-H])?(k|[a-h])?(q|[a-h])?)$`)
+	reStandardCastle = regexp.MustCompile(`^(-|K?Q?k?q?)$`)
 	reTurnColor      = regexp.MustCompile(`^(w|b)$`)
 	reFenPieceSymbol = regexp.MustCompile(`^[prnbqkPRNBQK]$`)
+	reEPDPerftDepth  = regexp.MustCompile(`^D([1-9][0-9]*)$`)
+)
+
+var ErrEPDInvalid = errors.New("invalid EPD")
+
+const (
+	epdPartsLength       = 4
+	epdOperationEnd      = ';'
+	epdQuote             = '"'
+	epdDefaultHalfClock  = "0"
+	epdDefaultFullClock  = "1"
+	epdCommentLinePrefix = "#"
 )
 
 type fenToken struct {
+	// checks are the checks given by each color, threeCheck is set when the fen has the three-check suffix
+	checks        [2]uint8
+	threeCheck    bool
 	halfMoveClock uint8
 	fullMoveClock uint16
 	enpSquare     Square
-	castleRights  CastleRights
+	castleToken   string
 	turnColor     Color
 	position      string
 }
@@ -38,8 +58,24 @@ type fenToken struct {
 func validateFenMetadataParts(fen string, opts validateFenOps) (fenToken, error) {
 	var emptyRet fenToken
 
-	// tokens length must be 6 after splitting the fen by a single space delimiter
+	// tokens length must be 6 after splitting the fen by a single space delimiter, the three-check fen has the 7th checks token
 	tokens := strings.Split(fen, fenSeparator)
+
+	var (
+		checks     [2]uint8
+		threeCheck bool
+	)
+
+	if len(tokens) == fenPartsLength+1 {
+		var err error
+		if checks, err = parseFenChecks(tokens[fenPartsLength]); err != nil {
+			return emptyRet, err
+		}
+
+		threeCheck = true
+		tokens = tokens[:fenPartsLength]
+	}
+
 	if len(tokens) != fenPartsLength {
 		return emptyRet, fmt.Errorf("invalid FEN: length must be exactly 6 after splitting by a single space delimiter")
 	}
@@ -106,47 +142,31 @@ func validateFenMetadataParts(fen string, opts validateFenOps) (fenToken, error)
 	}
 
 	// castle rights string must be of valid fen castle string format
-	if !reCastleRights.MatchString(castleRightsToken) {
+	if castleRightsToken == "" || !reCastleRights.MatchString(castleRightsToken) {
 		return emptyRet, fmt.Errorf("invalid FEN: invalid castling rights string")
 	}
 
-	var cr CastleRights
-
-	if castleRightsToken != fenNoneSymbol {
-		if strings.Contains(castleRightsToken, "K") {
-			cr |= WhiteKingSideCastle
-		}
-
-		if strings.Contains(castleRightsToken, "Q") {
-			cr |= WhiteQueenSideCastle
-		}
-
-		if strings.Contains(castleRightsToken, "k") {
-			cr |= BlackKingSideCastle
-		}
-
-		if strings.Contains(castleRightsToken, "q") {
-			cr |= BlackQueenSideCastle
-		}
-	}
-
 	return fenToken{
+		checks:        checks,
+		threeCheck:    threeCheck,
 		halfMoveClock: uint8(halfMoveClock),
 		fullMoveClock: uint16(fullMoveClock),
 		enpSquare:     enpSquare,
-		castleRights:  cr,
+		castleToken:   castleRightsToken,
 		turnColor:     turn,
 		position:      positionToken,
 	}, nil
 }
 
-// validatePositionPart validates squares and pieces
-func validatePositionPart(ft fenToken, opts validateFenOps) (map[Square]Piece, error) {
+// validatePositionPart validates squares and pieces, the crazyhouse promoted pieces are marked with `~` after the piece
+func validatePositionPart(ft fenToken, opts validateFenOps) (map[Square]Piece, bitboard, error) {
 	ranks := strings.Split(ft.position, fenPositionSeparator)
 	if len(ranks) != boardSize {
-		return nil, fmt.Errorf("invalid FEN: it does not contain 8 ranks delimited by %q character", fenPositionSeparator)
+		return nil, 0, fmt.Errorf("invalid FEN: it does not contain 8 ranks delimited by %q character", fenPositionSeparator)
 	}
 
+	var promoted bitboard
+
 	piecesCount := make(map[Piece]uint8, 0)
 	squares := make(map[Square]Piece, boardTotalSquares)
 
@@ -157,14 +177,24 @@ func validatePositionPart(ft fenToken, opts validateFenOps) (map[Square]Piece, e
 		)
 
 		for f := range len(ranks[r]) {
+			if string(ranks[r][f]) == fenPromotedMark {
+				if f == 0 || reIsDigit.MatchString(string(ranks[r][f-1])) {
+					return nil, 0, fmt.Errorf("invalid FEN: promoted mark must follow the piece")
+				}
+
+				promoted.setBit(Square((7-r)*8 + int(sumSquaresInRank) - 1))
+
+				continue
+			}
+
 			if reIsDigit.MatchString(string(ranks[r][f])) {
 				if previousWasNumber {
-					return nil, fmt.Errorf("invalid FEN: position string is invalid, it has consecutive numbers")
+					return nil, 0, fmt.Errorf("invalid FEN: position string is invalid, it has consecutive numbers")
 				}
 
 				n, err := strconv.ParseUint(string(ranks[r][f]), 10, 8)
 				if err != nil {
-					return nil, fmt.Errorf("invalid FEN: failed to parse row number")
+					return nil, 0, fmt.Errorf("invalid FEN: failed to parse row number")
 				}
 
 				sumSquaresInRank += uint8(n)
@@ -172,7 +202,7 @@ func validatePositionPart(ft fenToken, opts validateFenOps) (map[Square]Piece, e
 			} else {
 				piece, err := NewPieceFromFenSymbol(string(ranks[r][f]))
 				if err != nil {
-					return nil, fmt.Errorf("invalid FEN: position string contains invalid piece symbol")
+					return nil, 0, fmt.Errorf("invalid FEN: position string contains invalid piece symbol")
 				}
 
 				sq := Square((7-r)*8 + int(sumSquaresInRank))
@@ -185,48 +215,64 @@ func validatePositionPart(ft fenToken, opts validateFenOps) (map[Square]Piece, e
 		}
 
 		if sumSquaresInRank != boardSize {
-			return nil, fmt.Errorf("invalid FEN: position string is invalid, too many squares in rank")
+			return nil, 0, fmt.Errorf("invalid FEN: position string is invalid, too many squares in rank")
 		}
 	}
 
-	if piecesCount[WhiteKing] == 0 {
-		return nil, fmt.Errorf("invalid FEN: position is missing white king")
-	}
+	// the horde side plays without the king but the other side still needs one
+	if opts.variant == VariantHorde {
+		if piecesCount[WhiteKing] == 0 && piecesCount[BlackKing] == 0 {
+			return nil, 0, fmt.Errorf("invalid FEN: position is missing both kings")
+		}
+	} else {
+		if piecesCount[WhiteKing] == 0 {
+			return nil, 0, fmt.Errorf("invalid FEN: position is missing white king")
+		}
 
-	if piecesCount[BlackKing] == 0 {
-		return nil, fmt.Errorf("invalid FEN: position is missing black king")
+		if piecesCount[BlackKing] == 0 {
+			return nil, 0, fmt.Errorf("invalid FEN: position is missing black king")
+		}
 	}
 
 	if c := piecesCount[WhiteKing]; c > 1 {
-		return nil, fmt.Errorf("invalid FEN: position is having too many white kings (%d)", c)
+		return nil, 0, fmt.Errorf("invalid FEN: position is having too many white kings (%d)", c)
 	}
 
 	if c := piecesCount[BlackKing]; c > 1 {
-		return nil, fmt.Errorf("invalid FEN: position is having too many black kings (%d)", c)
+		return nil, 0, fmt.Errorf("invalid FEN: position is having too many black kings (%d)", c)
 	}
 
 	for _, char := range ranks[0] {
 		if string(char) == WhitePawn.String() {
-			return nil, fmt.Errorf("invalid FEN: white pawn is on 8th rank")
+			return nil, 0, fmt.Errorf("invalid FEN: white pawn is on 8th rank")
 		}
 	}
 
 	for _, char := range ranks[7] {
 		if string(char) == BlackPawn.String() {
-			return nil, fmt.Errorf("invalid FEN: black pawn is on 1st rank")
+			return nil, 0, fmt.Errorf("invalid FEN: black pawn is on 1st rank")
 		}
 	}
 
-	return squares, nil
+	return squares, promoted, nil
 }
 
 type validateFenOps struct {
 	strict bool
+	// variant relaxes the standard rules (e.g. the horde has no king)
+	variant Variant
 }
 
 type positionMeta struct {
 	fenToken
-	squares map[Square]Piece
+	squares      map[Square]Piece
+	castleRights CastleRights
+	castleRooks  [2][2]Square
+	chess960     bool
+	// crazyhouse is set when the fen has the pocket part
+	crazyhouse bool
+	pockets    Pockets
+	promoted   bitboard
 }
 
 // validateFEN validates the fen string
@@ -236,15 +282,253 @@ func validateFEN(fen string, opts validateFenOps) (*positionMeta, error) {
 		return nil, err
 	}
 
-	squares, err := validatePositionPart(tkn, opts)
+	position, pocket, crazyhouse := splitFenPocket(tkn.position)
+	tkn.position = position
+
+	pockets, err := parsePockets(pocket)
+	if err != nil {
+		return nil, fmt.Errorf("invalid FEN: %w", err)
+	}
+
+	squares, promoted, err := validatePositionPart(tkn, opts)
 	if err != nil {
 		return nil, err
 	}
 
+	castleRights, castleRooks, chess960, err := resolveCastleRights(tkn.castleToken, squares)
+	if err != nil {
+		return nil, fmt.Errorf("invalid FEN: %w", err)
+	}
+
 	meta := positionMeta{
-		fenToken: tkn,
-		squares:  squares,
+		fenToken:     tkn,
+		squares:      squares,
+		castleRights: castleRights,
+		castleRooks:  castleRooks,
+		chess960:     chess960,
+		crazyhouse:   crazyhouse,
+		pockets:      pockets,
+		promoted:     promoted,
 	}
 
 	return &meta, nil
 }
+
+// EPD is the extended position description record: the first 4 fen fields followed by the operations (e.g. `bm Nf3; id "test.1";`)
+type EPD struct {
+	// FEN is the full fen, the clocks are taken from the hmvc and fmvn operations or from the clocks after the fen fields
+	FEN     string
+	ID      string
+	Comment string
+	// BestMoves and AvoidMoves are the san moves of the bm and am operations
+	BestMoves  []string
+	AvoidMoves []string
+	// Perft are the expected leaf nodes by the depth of the D1..D6 operations
+	Perft map[int]int64
+	// Operations are the operands by the opcode with the quotes removed
+	Operations map[string][]string
+}
+
+// ParseEPD parses the EPD record, the fen clocks are also accepted after the fen fields (e.g. `8/8/8/8/8/8/8/K1k5 w - - 0 1;D1 3`)
+func ParseEPD(record string) (*EPD, error) {
+	rest := strings.TrimSpace(record)
+	fields := make([]string, 0, epdPartsLength+2)
+
+	for range epdPartsLength {
+		end := strings.IndexAny(rest, " \t;")
+		if end < 0 {
+			end = len(rest)
+		}
+
+		if end == 0 {
+			return nil, fmt.Errorf("%w: fen must have %d fields", ErrEPDInvalid, epdPartsLength)
+		}
+
+		fields = append(fields, rest[:end])
+		rest = strings.TrimLeft(rest[end:], " \t")
+	}
+
+	halfMoveClock, fullMoveClock := epdDefaultHalfClock, epdDefaultFullClock
+
+	// the clocks are the two numbers before the first operation
+	segment, _, _ := strings.Cut(rest, string(epdOperationEnd))
+	if clocks := strings.Fields(segment); len(clocks) == 2 && isEPDNumber(clocks[0]) && isEPDNumber(clocks[1]) {
+		halfMoveClock, fullMoveClock = clocks[0], clocks[1]
+		rest = strings.TrimPrefix(strings.TrimLeft(rest[len(segment):], " \t"), string(epdOperationEnd))
+	}
+
+	e := &EPD{Operations: make(map[string][]string)}
+
+	for _, operation := range splitEPDOperations(rest) {
+		tokens := splitEPDOperands(operation)
+		if len(tokens) == 0 {
+			continue
+		}
+
+		opcode, operands := tokens[0], tokens[1:]
+		e.Operations[opcode] = operands
+
+		if err := e.applyOperation(opcode, operands); err != nil {
+			return nil, err
+		}
+	}
+
+	if clock, ok := e.Operations["hmvc"]; ok {
+		halfMoveClock = clock[0]
+	}
+
+	if clock, ok := e.Operations["fmvn"]; ok {
+		fullMoveClock = clock[0]
+	}
+
+	e.FEN = strings.Join(append(fields, halfMoveClock, fullMoveClock), fenSeparator)
+
+	if _, err := validateFEN(e.FEN, validateFenOps{}); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrEPDInvalid, err)
+	}
+
+	return e, nil
+}
+
+// ReadEPD reads the EPD records line by line, the empty lines and the lines starting with `#` are skipped
+func ReadEPD(r io.Reader) ([]*EPD, error) {
+	var records []*EPD
+
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		record := strings.TrimSpace(sc.Text())
+		if record == "" || strings.HasPrefix(record, epdCommentLinePrefix) {
+			continue
+		}
+
+		e, err := ParseEPD(record)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		records = append(records, e)
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read epd: %w", err)
+	}
+
+	return records, nil
+}
+
+// applyOperation sets the fields of the known opcodes, the other opcodes are only kept in the operations
+func (e *EPD) applyOperation(opcode string, operands []string) error {
+	if m := reEPDPerftDepth.FindStringSubmatch(opcode); m != nil {
+		if len(operands) != 1 {
+			return fmt.Errorf("%w: %s must have one operand", ErrEPDInvalid, opcode)
+		}
+
+		depth, err := strconv.Atoi(m[1])
+		if err != nil {
+			return fmt.Errorf("%w: invalid %s depth", ErrEPDInvalid, opcode)
+		}
+
+		nodes, err := strconv.ParseInt(operands[0], 10, 64)
+		if err != nil || nodes < 0 {
+			return fmt.Errorf("%w: invalid %s nodes %q", ErrEPDInvalid, opcode, operands[0])
+		}
+
+		if e.Perft == nil {
+			e.Perft = make(map[int]int64)
+		}
+
+		e.Perft[depth] = nodes
+
+		return nil
+	}
+
+	switch opcode {
+	case "bm", "am":
+		if len(operands) == 0 {
+			return fmt.Errorf("%w: %s must have at least one move", ErrEPDInvalid, opcode)
+		}
+
+		if opcode == "bm" {
+			e.BestMoves = operands
+		} else {
+			e.AvoidMoves = operands
+		}
+	case "id", "c0":
+		if len(operands) != 1 {
+			return fmt.Errorf("%w: %s must have one operand", ErrEPDInvalid, opcode)
+		}
+
+		if opcode == "id" {
+			e.ID = operands[0]
+		} else {
+			e.Comment = operands[0]
+		}
+	case "hmvc", "fmvn":
+		if len(operands) != 1 || !isEPDNumber(operands[0]) {
+			return fmt.Errorf("%w: %s must be a number", ErrEPDInvalid, opcode)
+		}
+	}
+
+	return nil
+}
+
+func isEPDNumber(s string) bool {
+	_, err := strconv.ParseUint(s, 10, 16)
+	return err == nil
+}
+
+// splitEPDOperations splits the operations by the semicolons which are not quoted
+func splitEPDOperations(s string) []string {
+	var (
+		operations []string
+		quoted     bool
+		start      int
+	)
+
+	for i := range len(s) {
+		switch s[i] {
+		case epdQuote:
+			quoted = !quoted
+		case epdOperationEnd:
+			if !quoted {
+				operations = append(operations, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(operations, s[start:])
+}
+
+// splitEPDOperands splits the operation by the whitespace which is not quoted, the quotes are removed
+func splitEPDOperands(operation string) []string {
+	var (
+		tokens []string
+		token  strings.Builder
+		quoted bool
+		found  bool
+	)
+
+	for _, r := range operation {
+		switch {
+		case r == epdQuote:
+			quoted = !quoted
+			found = true
+		case !quoted && (r == ' ' || r == '\t'):
+			if found {
+				tokens = append(tokens, token.String())
+				token.Reset()
+				found = false
+			}
+		default:
+			token.WriteRune(r)
+			found = true
+		}
+	}
+
+	if found {
+		tokens = append(tokens, token.String())
+	}
+
+	return tokens
+}
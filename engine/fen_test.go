@@ -1,6 +1,12 @@
 package engine
 
-import "testing"
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
 
 func TestValidateFen(t *testing.T) {
 	testCases := map[string]struct {
@@ -71,3 +77,76 @@ func TestValidateFen(t *testing.T) {
 		})
 	}
 }
+
+func TestParseEPD(t *testing.T) {
+	testCases := map[string]struct {
+		record         string
+		wantFEN        string
+		wantID         string
+		wantComment    string
+		wantBestMoves  []string
+		wantAvoidMoves []string
+		wantPerft      map[int]int64
+		wantErr        error
+	}{
+		"best move":         {record: `2rr3k/pp3pp1/1nnqbN1p/3pN3/2pP4/2P3Q1/PPB4P/R4RK1 w - - bm Qg6; id "WAC.001";`, wantFEN: "2rr3k/pp3pp1/1nnqbN1p/3pN3/2pP4/2P3Q1/PPB4P/R4RK1 w - - 0 1", wantID: "WAC.001", wantBestMoves: []string{"Qg6"}},
+		"several moves":     {record: `r1b1k2r/ppppnppp/2n2q2/2b5/3NP3/2P1B3/PP3PPP/RN1QKB1R w KQkq - bm Nf5 Nxc6; am Qd2;`, wantFEN: "r1b1k2r/ppppnppp/2n2q2/2b5/3NP3/2P1B3/PP3PPP/RN1QKB1R w KQkq - 0 1", wantBestMoves: []string{"Nf5", "Nxc6"}, wantAvoidMoves: []string{"Qd2"}},
+		"quoted semicolon":  {record: `4k3/8/8/8/8/8/8/4K2R w K - c0 "castle; or not"; id "q.1";`, wantFEN: "4k3/8/8/8/8/8/8/4K2R w K - 0 1", wantID: "q.1", wantComment: "castle; or not"},
+		"clock operations":  {record: `4k3/8/8/8/8/8/8/4K3 b - - hmvc 12; fmvn 40;`, wantFEN: "4k3/8/8/8/8/8/8/4K3 b - - 12 40"},
+		"perft with clocks": {record: "8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 1;D1 14;D2 191 ;D3 2812", wantFEN: "8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 1", wantPerft: map[int]int64{1: 14, 2: 191, 3: 2812}},
+		"perft operations":  {record: "4k3/8/8/8/8/8/8/4K3 w - - D1 5; D2 25;", wantFEN: "4k3/8/8/8/8/8/8/4K3 w - - 0 1", wantPerft: map[int]int64{1: 5, 2: 25}},
+		"missing fields":    {record: "4k3/8/8/8/8/8/8/4K3 w", wantErr: ErrEPDInvalid},
+		"invalid position":  {record: "4k3/8/8/8/8/8/8/4K3 x - - bm Kd1;", wantErr: ErrEPDInvalid},
+		"invalid perft":     {record: "4k3/8/8/8/8/8/8/4K3 w - - D1 many;", wantErr: ErrEPDInvalid},
+		"missing best move": {record: "4k3/8/8/8/8/8/8/4K3 w - - bm;", wantErr: ErrEPDInvalid},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseEPD(tc.record)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("invalid error: want %v, got %v", tc.wantErr, err)
+			}
+
+			if tc.wantErr != nil {
+				return
+			}
+
+			if got.FEN != tc.wantFEN {
+				t.Fatalf("invalid fen: want %q, got %q", tc.wantFEN, got.FEN)
+			}
+
+			if got.ID != tc.wantID || got.Comment != tc.wantComment {
+				t.Fatalf("invalid id and comment: want %q %q, got %q %q", tc.wantID, tc.wantComment, got.ID, got.Comment)
+			}
+
+			if !slices.Equal(got.BestMoves, tc.wantBestMoves) || !slices.Equal(got.AvoidMoves, tc.wantAvoidMoves) {
+				t.Fatalf("invalid moves: want %v %v, got %v %v", tc.wantBestMoves, tc.wantAvoidMoves, got.BestMoves, got.AvoidMoves)
+			}
+
+			if !maps.Equal(got.Perft, tc.wantPerft) {
+				t.Fatalf("invalid perft: want %v, got %v", tc.wantPerft, got.Perft)
+			}
+		})
+	}
+}
+
+func TestReadEPD(t *testing.T) {
+	suite := `# perft suite
+4k3/8/8/8/8/8/8/4K3 w - - D1 5;
+
+8/8/8/8/8/8/8/K1k5 w - - 0 1;D1 3
+`
+
+	records, err := ReadEPD(strings.NewReader(suite))
+	if err != nil {
+		t.Fatalf("failed to read epd: %v", err)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("invalid records: want 2, got %d", len(records))
+	}
+
+	if _, err := ReadEPD(strings.NewReader("4k3/8/8/8/8/8/8/4K3 w - - D1 5;\nbad")); !errors.Is(err, ErrEPDInvalid) || !strings.HasPrefix(err.Error(), "line 2") {
+		t.Fatalf("invalid error: want line 2 %v, got %v", ErrEPDInvalid, err)
+	}
+}
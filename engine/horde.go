@@ -0,0 +1,6 @@
+package engine
+
+// isHordeCaptured checks if the side lost all the pieces in horde, it is the only way to beat the horde without the king
+func (p *Position) isHordeCaptured(side Color) bool {
+	return p.Variant == VariantHorde && p.Board.sideOccupancies[side] == 0
+}
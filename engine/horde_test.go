@@ -0,0 +1,108 @@
+package engine
+
+import (
+	"testing"
+)
+
+func TestHordePerft(t *testing.T) {
+	testCases := map[string]struct {
+		fen   string
+		nodes []int64
+	}{
+		"starting position":         {fen: FENStartingPositionHorde, nodes: []int64{8, 128, 1274, 23310}},
+		"horde middlegame":          {fen: "4k3/pp4q1/3P2p1/8/P3PP2/PPP2r2/PPP5/PPPP4 b - - 0 1", nodes: []int64{30, 241, 6633, 56539}},
+		"en passant in the horde":   {fen: "rnbqkbnr/6p1/2p1Pp1P/P1PPPP2/Pp4PP/1p2PPPP/1P2PPPP/PP1nPPPP b kq a3 0 18", nodes: []int64{34, 435, 14481}},
+		"first rank double step":    {fen: "r3k2r/8/8/8/8/8/8/PPPP1PPP w kq - 0 1", nodes: []int64{14, 358, 4726, 126505}},
+		"last pawn can be captured": {fen: "4k3/8/8/8/8/8/8/3P4 b - - 0 1", nodes: []int64{5, 10, 68, 102, 679}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for i, want := range tc.nodes {
+				if got := PerftVariant(tc.fen, VariantHorde, i+1); got != want {
+					t.Fatalf("invalid perft nodes at depth %d: want %d, got %d", i+1, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestHordeFen(t *testing.T) {
+	testCases := map[string]struct {
+		fen     string
+		variant Variant
+		wantErr bool
+	}{
+		"horde without the king":    {fen: FENStartingPositionHorde, variant: VariantHorde},
+		"black horde":               {fen: "4K3/8/8/8/8/8/8/8 w - - 0 1", variant: VariantHorde},
+		"fails without the king":    {fen: FENStartingPositionHorde, variant: VariantStandard, wantErr: true},
+		"fails without both kings":  {fen: "8/8/8/8/8/8/8/PPPP4 w - - 0 1", variant: VariantHorde, wantErr: true},
+		"fails with too many kings": {fen: "4kk2/8/8/8/8/8/8/PPPP4 w - - 0 1", variant: VariantHorde, wantErr: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := &Position{}
+
+			err := p.LoadFromFENVariant(tc.fen, tc.variant)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("invalid error: want %v, got %v", tc.wantErr, err)
+			}
+
+			if tc.wantErr {
+				return
+			}
+
+			if got := p.Fen(); got != tc.fen {
+				t.Fatalf("invalid fen: want %s, got %s", tc.fen, got)
+			}
+		})
+	}
+}
+
+func TestHordeStatus(t *testing.T) {
+	testCases := map[string]struct {
+		fen         string
+		moves       []string
+		wantFen     string
+		wantStatus  Status
+		wantOutcome Outcome
+	}{
+		"double step without en passant": {fen: "4k3/8/8/8/8/8/8/PPPP4 w - - 0 1", moves: []string{"a1a3"}, wantFen: "4k3/8/8/8/8/P7/8/1PPP4 b - - 0 1", wantStatus: StatusUnknown},
+		"all pieces captured":            {fen: "4k3/8/8/8/8/8/8/2qP4 b - - 0 1", moves: []string{"c1d1"}, wantFen: "4k3/8/8/8/8/8/8/3q4 w - - 0 2", wantStatus: StatusAllPiecesCaptured, wantOutcome: OutcomeBlackWon},
+		"stalemated horde":               {fen: "k7/8/8/8/8/8/p7/P6r b - - 0 1", moves: []string{"h1h2"}, wantFen: "k7/8/8/8/8/8/p6r/P7 w - - 1 2", wantStatus: StatusStalemate},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewChessVariant(tc.fen, VariantHorde)
+			if err != nil {
+				t.Fatalf("invalid fen: %v", err)
+			}
+
+			for _, uci := range tc.moves {
+				if _, err := c.MakeMoveUCI(uci); err != nil {
+					t.Fatalf("failed to make move %s: %v", uci, err)
+				}
+			}
+
+			if got := c.Position.Fen(); got != tc.wantFen {
+				t.Fatalf("invalid fen: want %s, got %s", tc.wantFen, got)
+			}
+
+			if got := c.Status(); got != tc.wantStatus {
+				t.Fatalf("invalid status: want %d, got %d", tc.wantStatus, got)
+			}
+
+			if got := c.VariantOutcome(); got != tc.wantOutcome {
+				t.Fatalf("invalid outcome: want %d, got %d", tc.wantOutcome, got)
+			}
+
+			p := &Position{}
+			if err := p.LoadFromFENVariant(tc.wantFen, VariantHorde); err != nil {
+				t.Fatalf("invalid fen: %v", err)
+			}
+
+			if p.Hash != c.Position.Hash {
+				t.Fatalf("invalid hash after the moves: want %d, got %d", p.Hash, c.Position.Hash)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Command magicgen finds the magic numbers of the sliding pieces and writes them as the go source of the engine package.
+// The search is seeded so the generated file is reproducible, run it with go generate in the engine package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"math/bits"
+	"math/rand/v2"
+	"os"
+)
+
+var (
+	rookDirections   = [4][2]int{{0, 1}, {1, 0}, {0, -1}, {-1, 0}}
+	bishopDirections = [4][2]int{{1, 1}, {1, -1}, {-1, 1}, {-1, -1}}
+)
+
+func main() {
+	output := flag.String("o", "magics.go", "output file")
+	seed := flag.Uint64("seed", 1, "random seed of the magic numbers search")
+	flag.Parse()
+
+	rnd := rand.New(rand.NewPCG(*seed, *seed))
+
+	var bishopMagics, rookMagics [64]uint64
+
+	for sq := range 64 {
+		bishopMagics[sq] = findMagic(rnd, sq, bishopDirections)
+		rookMagics[sq] = findMagic(rnd, sq, rookDirections)
+	}
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by magicgen -seed %d; DO NOT EDIT.\n\npackage engine\n\n", *seed)
+	writeMagics(&buf, "bishopMagics are the magic numbers indexing the bishop attacks by the relevant occupancy", "bishopMagics", bishopMagics)
+	buf.WriteString("\n")
+	writeMagics(&buf, "rookMagics are the magic numbers indexing the rook attacks by the relevant occupancy", "rookMagics", rookMagics)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format magics: %v", err)
+	}
+
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatalf("failed to write magics: %v", err)
+	}
+}
+
+func writeMagics(buf *bytes.Buffer, doc, name string, magics [64]uint64) {
+	fmt.Fprintf(buf, "// %s\nvar %s = [64]bitboard{\n", doc, name)
+
+	for i, m := range magics {
+		fmt.Fprintf(buf, "%#x,", m)
+
+		if i%4 == 3 {
+			buf.WriteString("\n")
+		} else {
+			buf.WriteString(" ")
+		}
+	}
+
+	buf.WriteString("}\n")
+}
+
+// findMagic finds the magic number which maps every relevant occupancy of the square to the index without the conflicting attacks
+func findMagic(rnd *rand.Rand, sq int, directions [4][2]int) uint64 {
+	mask := relevantOccupancyMask(sq, directions)
+	shift := 64 - bits.OnesCount64(mask)
+
+	var occupancies, attacks []uint64
+
+	// the carry-rippler enumerates all the subsets of the mask
+	for occ := uint64(0); ; occ = (occ - mask) & mask {
+		occupancies = append(occupancies, occ)
+		attacks = append(attacks, slidingAttacks(sq, occ, directions))
+
+		if occ == mask {
+			break
+		}
+	}
+
+	used := make([]uint64, len(occupancies))
+	epoch := make([]int, len(occupancies))
+
+	for try := 1; ; try++ {
+		magic := rnd.Uint64() & rnd.Uint64() & rnd.Uint64()
+
+		if bits.OnesCount64((mask*magic)>>56) < 6 {
+			continue
+		}
+
+		ok := true
+
+		for i, occ := range occupancies {
+			idx := (occ * magic) >> shift
+
+			if epoch[idx] != try {
+				epoch[idx] = try
+				used[idx] = attacks[i]
+			} else if used[idx] != attacks[i] {
+				ok = false
+				break
+			}
+		}
+
+		if ok {
+			return magic
+		}
+	}
+}
+
+// relevantOccupancyMask are the squares whose blockers change the attacks, the last square of every ray doesn't
+func relevantOccupancyMask(sq int, directions [4][2]int) uint64 {
+	var mask uint64
+
+	for _, d := range directions {
+		f, r := sq%8+d[0], sq/8+d[1]
+
+		for onBoard(f+d[0], r+d[1]) {
+			mask |= 1 << (r*8 + f)
+			f, r = f+d[0], r+d[1]
+		}
+	}
+
+	return mask
+}
+
+func slidingAttacks(sq int, occupancy uint64, directions [4][2]int) uint64 {
+	var attacks uint64
+
+	for _, d := range directions {
+		f, r := sq%8+d[0], sq/8+d[1]
+
+		for onBoard(f, r) {
+			attacks |= 1 << (r*8 + f)
+
+			if occupancy&(1<<(r*8+f)) != 0 {
+				break
+			}
+
+			f, r = f+d[0], r+d[1]
+		}
+	}
+
+	return attacks
+}
+
+func onBoard(f, r int) bool {
+	return f >= 0 && f < 8 && r >= 0 && r < 8
+}
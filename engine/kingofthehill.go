@@ -0,0 +1,9 @@
+package engine
+
+// bitboardHill are the center squares the king has to reach to win in king of the hill
+var bitboardHill = D4.occupancyMask() | E4.occupancyMask() | D5.occupancyMask() | E5.occupancyMask()
+
+// isKingOnTheHill checks if the side's king reached the center in king of the hill
+func (p *Position) isKingOnTheHill(side Color) bool {
+	return p.Variant == VariantKingOfTheHill && p.Board.pieceOccupancies[side][King]&bitboardHill != 0
+}
@@ -0,0 +1,62 @@
+package engine
+
+import (
+	"testing"
+)
+
+func TestKingOfTheHillPerft(t *testing.T) {
+	testCases := map[string]struct {
+		fen   string
+		nodes []int64
+	}{
+		"starting position":   {fen: FENStartingPosition, nodes: []int64{20, 400, 8902, 197281}},
+		"kiwipete":            {fen: "r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1", nodes: []int64{48, 2039, 97862}},
+		"kings near the hill": {fen: "r1bq1b1r/ppp1kppp/2n2n2/3pp3/3PP3/2N1KN2/PPP2PPP/R1BQ1B1R w - - 0 1", nodes: []int64{36, 1190, 41256}},
+		"bare kings":          {fen: "8/8/8/8/8/8/2k5/4K3 w - - 0 1", nodes: []int64{3, 21, 128, 806}},
+		"king is on the hill": {fen: "8/2k5/8/3K4/8/8/8/8 b - - 0 1", nodes: []int64{0, 0}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for i, want := range tc.nodes {
+				if got := PerftVariant(tc.fen, VariantKingOfTheHill, i+1); got != want {
+					t.Fatalf("invalid perft nodes at depth %d: want %d, got %d", i+1, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestKingOfTheHillStatus(t *testing.T) {
+	testCases := map[string]struct {
+		fen        string
+		moves      []string
+		wantStatus Status
+	}{
+		"king reaches the hill":       {fen: "8/2k5/8/8/8/3K4/8/8 w - - 0 1", moves: []string{"d3d4"}, wantStatus: StatusKingOfTheHill},
+		"bare kings are not a draw":   {fen: "8/2k5/8/8/8/3K4/8/8 w - - 0 1", moves: []string{"d3c3"}, wantStatus: StatusUnknown},
+		"checkmate before the hill":   {fen: "6k1/5ppp/8/8/8/8/8/K3R3 w - - 0 1", moves: []string{"e1e8"}, wantStatus: StatusCheckmate},
+		"black king reaches the hill": {fen: "8/8/3k4/8/8/8/8/K7 b - - 0 1", moves: []string{"d6e5"}, wantStatus: StatusKingOfTheHill},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewChessVariant(tc.fen, VariantKingOfTheHill)
+			if err != nil {
+				t.Fatalf("invalid fen: %v", err)
+			}
+
+			for _, uci := range tc.moves {
+				if _, err := c.MakeMoveUCI(uci); err != nil {
+					t.Fatalf("failed to make move %s: %v", uci, err)
+				}
+			}
+
+			if got := c.Status(); got != tc.wantStatus {
+				t.Fatalf("invalid status: want %d, got %d", tc.wantStatus, got)
+			}
+
+			if tc.wantStatus == StatusKingOfTheHill && len(c.LegalMoves) != 0 {
+				t.Fatalf("invalid legal moves after the game end: want 0, got %d", len(c.LegalMoves))
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Code generated by magicgen -seed 1; DO NOT EDIT.
+
+package engine
+
+// bishopMagics are the magic numbers indexing the bishop attacks by the relevant occupancy
+var bishopMagics = [64]bitboard{
+	0x84025a6208090102, 0x61010a00810230, 0x8090008210400000, 0x608248106080200,
+	0x4242040000020, 0x8002011108010800, 0x9182150420540020, 0x10230410041241,
+	0x9200484080040, 0x2140088104040040, 0x140208482100200c, 0x8000090403060000,
+	0x8000011040410000, 0x407024120a00000, 0x904402084000, 0x4020060880841000,
+	0x100a001010990808, 0x2000040b044d10, 0x800640800280a, 0x20204220200c0,
+	0x20800400e02000, 0x2000800b08200a02, 0x3c000200a20800, 0x830080004c443002,
+	0x10101008021041, 0x1110100a48410100, 0x222011c020c0400, 0x23021008280080a0,
+	0x30101001004010, 0x480201a9618418, 0x28448401008802, 0xc22002080808800,
+	0x8044000108220, 0x8801090e044808, 0x42010102101040, 0x2000040400080120,
+	0x1d740040c4040100, 0x2610020120220480, 0x21492c08010400, 0x101240900008041,
+	0x4240504804040, 0x100451410022042, 0x1000110801000800, 0x220242008c2800,
+	0x8011402810400201, 0x4c0408c01000090, 0x210210810a080100, 0x8004088410d00103,
+	0x80909010100080, 0x84400a410a004, 0x8048080020, 0x6000000484044060,
+	0x8404042820084, 0x41002c8084020, 0x8040434140c2000, 0x2008180820803210,
+	0x4185052804060884, 0x2002010108220208, 0x120484020841004, 0x24200000a0420221,
+	0x76010010202200, 0x82020084200c9300, 0x508122002040050, 0x3040020c04208210,
+}
+
+// rookMagics are the magic numbers indexing the rook attacks by the relevant occupancy
+var rookMagics = [64]bitboard{
+	0x100102041008001, 0x3440004820001000, 0x100200011004008, 0x480080004100080,
+	0xc600020024085020, 0x200010408100200, 0x42000800d2000104, 0x10000822a034100,
+	0x30b800288400024, 0x8000802000804003, 0x4c01002001081042, 0x8004801001880280,
+	0x800800800400, 0x6001008160004, 0x2425000200810044, 0x25208000800c5100,
+	0x80010020804901, 0x10004000200044, 0x2408110020010048, 0x8080210009001000,
+	0x8484028008008004, 0x14480104401060, 0x4002040008100142, 0x10220000489104,
+	0x842380004004, 0x4030024540002001, 0x2000100080200081, 0x101002100081002,
+	0x5040121a00208a00, 0x2003000900020400, 0x10080400100102, 0x2020004200008401,
+	0x800400182800020, 0x10e0004000802092, 0x340200080801000, 0x2010800800801005,
+	0x18100801000500, 0x8080800200800400, 0x20081004003251, 0x6000188502000044,
+	0xc200400080208008, 0x2040804001110020, 0x6150220010040, 0x1048001000808009,
+	0xc404000408008080, 0x81004400090002, 0x40410802040010, 0x8440408c060001,
+	0x8040502100800100, 0x21010c008a000c0, 0x614402001001100, 0x50040040080040,
+	0xd85080004008180, 0x400800200040080, 0x8101000c02000900, 0x1000008400412200,
+	0x9081102441800105, 0x400450218301, 0x1041088a00041, 0x500050020100009,
+	0x11000204080011, 0xc1000208040001, 0x3940122881300804, 0x8000002100408402,
+}
@@ -45,6 +45,23 @@ func newCastleMove(src, dest Square, piece Piece) Move {
 	return newMove(src, dest, piece, PromotionNone, false, false, false, true)
 }
 
+// newChess960CastleMove is the castle move written in the king takes rook notation, the dest is still the king destination
+func newChess960CastleMove(src, dest, rookSq Square, piece Piece) Move {
+	m := newCastleMove(src, dest, piece)
+	m |= 1 << chess960Shift
+	m |= Move(rookSq.File()) << rookFileShift
+
+	return m
+}
+
+// newDropMove is the crazyhouse drop of the pocket piece to the empty square
+func newDropMove(dest Square, piece Piece) Move {
+	m := newQuietMove(dest, dest, piece)
+	m |= 1 << dropShift
+
+	return m
+}
+
 func newPossiblePromotionMoves(src, dest Square, piece Piece) []Move {
 	return []Move{
 		newMove(src, dest, piece, PromotionQueen, false, false, false, false),
@@ -101,13 +118,18 @@ const (
 	doublePawnShift = 21
 	enPassantShift  = 22
 	castleShift     = 23
+	chess960Shift   = 24
+	rookFileShift   = 25
+	dropShift       = 28
 
 	squareMask    = 0x3F
 	pieceMask     = 0xF
 	promotionMask = 0x7
+	fileMask      = 0x7
 )
 
-// Move is mapped from: [0..5] src, [6..11] dest, [12..15] piece, [16..18] promotion, 19 capture, 20 doublePawn, 21 en-passant, 22 castle
+// Move is mapped from: [0..5] src, [6..11] dest, [12..15] piece, [16..18] promotion, 19 capture, 20 doublePawn, 21 en-passant, 22 castle,
+// 24 chess960 castle, [25..27] chess960 castle rook file and 28 crazyhouse drop (the src is the same as the dest)
 type Move int32
 
 func newMove(src, dest Square, piece Piece, promotion Promotion, capture, doublePawn, enPassant, castle bool) Move {
@@ -184,12 +206,27 @@ func (m Move) IsCastle() bool {
 
 // IsKingSideCastle checks whether the move is castle king side
 func (m Move) IsKingSideCastle() bool {
-	return m.IsCastle() && m.Piece().Kind() == King && m.Dest().File() > m.Src().File()
+	return m.IsCastle() && m.Piece().Kind() == King && m.Dest().File() == FileG
 }
 
 // IsQueenSideCastle checks whether the move is castle queen side
 func (m Move) IsQueenSideCastle() bool {
-	return m.IsCastle() && m.Piece().Kind() == King && m.Dest().File() < m.Src().File()
+	return m.IsCastle() && m.Piece().Kind() == King && m.Dest().File() == FileC
+}
+
+// IsDrop checks whether the move is the crazyhouse drop
+func (m Move) IsDrop() bool {
+	return ((m >> dropShift) & 1) == 1
+}
+
+// IsChess960Castle checks whether the castle move is written in the chess960 king takes rook notation
+func (m Move) IsChess960Castle() bool {
+	return m.IsCastle() && ((m>>chess960Shift)&1) == 1
+}
+
+// CastleRookSquare is the starting square of the castling rook for the chess960 castle move
+func (m Move) CastleRookSquare() Square {
+	return NewSquare(File((m>>rookFileShift)&fileMask), m.Src().Rank())
 }
 
 func (m Move) String() string {
@@ -212,6 +249,16 @@ func (m Move) ToUCI() string {
 		}
 	}
 
+	// the drop is written as the uppercase piece, `@` and the square e.g. N@f3, P@e4
+	if m.IsDrop() {
+		return fmt.Sprintf("%s@%s", strings.ToUpper(m.Piece().Kind().String()), m.Dest())
+	}
+
+	// chess960 castle is written as the king capturing its own rook
+	if m.IsChess960Castle() {
+		return fmt.Sprintf("%s%s", m.Src(), m.CastleRookSquare())
+	}
+
 	return fmt.Sprintf("%s%s%s", m.Src(), m.Dest(), promo)
 }
 
@@ -219,6 +266,10 @@ func (m Move) ToUCI() string {
 // <LAN move descriptor pawn moves>  ::= <from square>['-'|'x']<to square>[<promoted to>]
 // <Piece symbol> ::= 'N' | 'B' | 'R' | 'Q' | 'K'
 func (m Move) ToLAN(p *Position, isCheck, isCheckmate bool) string {
+	if m.IsDrop() {
+		return m.dropNotation(isCheck, isCheckmate)
+	}
+
 	var piece string
 	if m.Piece().Kind() != Pawn {
 		piece = strings.ToUpper(m.Piece().Kind().String())
@@ -250,6 +301,10 @@ func (m Move) ToLAN(p *Position, isCheck, isCheckmate bool) string {
 // <SAN move descriptor pawn captures> ::= <from file>[<from rank>] 'x' <to square>[<promoted to>]
 // <SAN move descriptor pawn push>     ::= <to square>[<promoted to>]
 func (m Move) ToSAN(p *Position, isCheck, isCheckmate bool, legalMoves []Move) string {
+	if m.IsDrop() {
+		return m.dropNotation(isCheck, isCheckmate)
+	}
+
 	if m.IsCastle() {
 		if m.IsKingSideCastle() {
 			if isCheckmate {
@@ -287,7 +342,7 @@ func (m Move) ToSAN(p *Position, isCheck, isCheckmate bool, legalMoves []Move) s
 		ambiguous := make([]Move, 0)
 
 		for _, lm := range legalMoves {
-			if lm != m && lm.Dest() == m.Dest() && lm.Piece() == m.Piece() {
+			if lm != m && !lm.IsDrop() && lm.Dest() == m.Dest() && lm.Piece() == m.Piece() {
 				ambiguous = append(ambiguous, lm)
 			}
 		}
@@ -340,3 +395,15 @@ func (m Move) ToSAN(p *Position, isCheck, isCheckmate bool, legalMoves []Move) s
 
 	return fmt.Sprintf("%s%s%s%s%s%s", piece, disambiguation, capture, m.Dest().String(), promo, checkOrCheckmate)
 }
+
+// dropNotation is the same in the uci, lan and san notation e.g. N@f3+, P@e4
+func (m Move) dropNotation(isCheck, isCheckmate bool) string {
+	switch {
+	case isCheckmate:
+		return m.ToUCI() + "#"
+	case isCheck:
+		return m.ToUCI() + "+"
+	}
+
+	return m.ToUCI()
+}
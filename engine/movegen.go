@@ -0,0 +1,436 @@
+package engine
+
+// MaxMoves is the capacity of the move list, the standard chess has at most 218 moves but the crazyhouse drops add many more
+const MaxMoves = 1024
+
+// MoveList is the fixed size list the moves are generated into, it is owned by the caller so the generation doesn't allocate
+type MoveList struct {
+	moves [MaxMoves]Move
+	count int
+}
+
+// Len returns the number of the moves in the list
+func (ml *MoveList) Len() int {
+	return ml.count
+}
+
+// At returns the move at the index
+func (ml *MoveList) At(i int) Move {
+	return ml.moves[i]
+}
+
+// Moves returns the moves in the list, the slice shares the list memory and is valid until the list is filled again
+func (ml *MoveList) Moves() []Move {
+	return ml.moves[:ml.count]
+}
+
+// Clear empties the list
+func (ml *MoveList) Clear() {
+	ml.count = 0
+}
+
+func (ml *MoveList) add(m Move) {
+	ml.moves[ml.count] = m
+	ml.count++
+}
+
+// addPieceMoves adds the quiet moves and then the captures of the piece to the attacked squares
+func (ml *MoveList) addPieceMoves(src Square, attacks, enemies bitboard, piece Piece, kind moveKind) {
+	if kind&genQuiets != 0 {
+		for quiets := attacks & ^enemies; quiets > 0; {
+			ml.add(newQuietMove(src, Square(quiets.PopLS1B()), piece))
+		}
+	}
+
+	if kind&genCaptures != 0 {
+		for captures := attacks & enemies; captures > 0; {
+			ml.add(newCaptureMove(src, Square(captures.PopLS1B()), piece))
+		}
+	}
+}
+
+func (ml *MoveList) addPromotions(src, dest Square, piece Piece, capture bool) {
+	for _, promo := range [4]Promotion{PromotionQueen, PromotionRook, PromotionBishop, PromotionKnight} {
+		ml.add(newMove(src, dest, piece, promo, capture, false, false, false))
+	}
+}
+
+// moveKind selects the subset of the moves to generate
+type moveKind uint8
+
+const (
+	// genCaptures are the captures and all the promotions
+	genCaptures moveKind = 1 << iota
+	// genQuiets are the rest of the moves including the castles and drops
+	genQuiets
+
+	genAll = genCaptures | genQuiets
+)
+
+// moveMasks restrict the generated moves to the legal ones, they are computed once for the position instead of making every move
+type moveMasks struct {
+	// target are the squares the pieces other than the king can move to, they capture or block the single checker and none in double check
+	target   bitboard
+	pinned   bitboard
+	checkers bitboard
+	king     Square
+	// legal is unset for the pseudo-legal generation, then every move is made and checked
+	legal bool
+	kind  moveKind
+}
+
+// GenerateLegalMoves fills the list with the legal moves of the side to move
+func (p *Position) GenerateLegalMoves(ml *MoveList) {
+	p.generateLegalMoves(ml, genAll)
+}
+
+// GenerateCaptures fills the list with the legal captures, en-passant captures and promotions
+func (p *Position) GenerateCaptures(ml *MoveList) {
+	p.generateLegalMoves(ml, genCaptures)
+}
+
+// GenerateQuiets fills the list with the legal moves which are neither captures nor promotions, castles and drops included
+func (p *Position) GenerateQuiets(ml *MoveList) {
+	p.generateLegalMoves(ml, genQuiets)
+}
+
+// GenerateEvasions fills the list with the legal moves out of check, the list is empty when the side to move is not in check
+func (p *Position) GenerateEvasions(ml *MoveList) {
+	ml.Clear()
+
+	if !p.isInCheck(p.Turn) {
+		return
+	}
+
+	p.generateLegalMoves(ml, genAll)
+}
+
+// GenerateChecks fills the list with the legal moves giving check, they are found by making the moves to include the discovered checks
+func (p *Position) GenerateChecks(ml *MoveList) {
+	p.generateLegalMoves(ml, genAll)
+
+	n := 0
+
+	for i := range ml.count {
+		m := ml.moves[i]
+
+		p.MakeMove(m)
+		if p.Check {
+			ml.moves[n] = m
+			n++
+		}
+		p.UnmakeMove()
+	}
+
+	ml.count = n
+}
+
+func (p *Position) generateLegalMoves(ml *MoveList, kind moveKind) {
+	ml.Clear()
+
+	if p.isVariantEnd() {
+		return
+	}
+
+	// the explosions in atomic chess and the forbidden checks in racing kings decide the legality too so those moves are made and checked
+	if p.Variant == VariantAtomic || p.Variant == VariantRacingKings {
+		p.generateMoves(ml, moveMasks{target: bitboardFull, king: SquareNone, kind: kind})
+		p.filterIllegalMoves(ml)
+		return
+	}
+
+	masks := p.legalMoveMasks()
+	masks.kind = kind
+
+	p.generateMoves(ml, masks)
+}
+
+// LegalMoves returns the legal moves of the side to move in the new slice, GenerateLegalMoves is used where allocations matter
+func (p *Position) LegalMoves() []Move {
+	var ml MoveList
+	p.GenerateLegalMoves(&ml)
+
+	moves := make([]Move, ml.Len())
+	copy(moves, ml.Moves())
+
+	return moves
+}
+
+// legalMoveMasks computes the checkers and the pinned pieces of the side to move
+func (p *Position) legalMoveMasks() moveMasks {
+	masks := moveMasks{target: bitboardFull, king: SquareNone, legal: true}
+
+	kings := p.Board.pieceOccupancies[p.Turn][King]
+	// the horde has no king to protect
+	if kings == 0 {
+		return masks
+	}
+
+	masks.king = Square(kings.LS1B())
+
+	enemy := p.Turn.Opposite()
+	occupancy := p.Board.sideOccupancies[Both]
+
+	masks.checkers = p.Board.attackersTo(masks.king, enemy, occupancy)
+
+	switch masks.checkers.populationCount() {
+	case 0:
+	case 1:
+		masks.target = betweenMask[masks.king][masks.checkers.LS1B()] | masks.checkers
+	default:
+		masks.target = 0
+	}
+
+	queens := p.Board.pieceOccupancies[enemy][Queen]
+	snipers := getRookAttacks(masks.king, 0)&(p.Board.pieceOccupancies[enemy][Rook]|queens) |
+		getBishopAttacks(masks.king, 0)&(p.Board.pieceOccupancies[enemy][Bishop]|queens)
+
+	for snipers > 0 {
+		sq := Square(snipers.PopLS1B())
+		blockers := betweenMask[masks.king][sq] & occupancy
+
+		if blockers.populationCount() == 1 && blockers&p.Board.sideOccupancies[p.Turn] != 0 {
+			masks.pinned |= blockers
+		}
+	}
+
+	return masks
+}
+
+// filterIllegalMoves keeps only the moves which pass isMoveLegal after making them
+func (p *Position) filterIllegalMoves(ml *MoveList) {
+	n := 0
+
+	for i := range ml.count {
+		m := ml.moves[i]
+
+		p.MakeMove(m)
+		if p.isMoveLegal() {
+			ml.moves[n] = m
+			n++
+		}
+		p.UnmakeMove()
+	}
+
+	ml.count = n
+}
+
+func (p *Position) generateMoves(ml *MoveList, masks moveMasks) {
+	p.generateKingMoves(ml, masks)
+
+	// only the king can escape the double check
+	if masks.target == 0 {
+		return
+	}
+
+	for _, kind := range [4]PieceKind{Queen, Rook, Bishop, Knight} {
+		p.generatePieceMoves(ml, kind, masks)
+	}
+
+	p.generatePawnMoves(ml, masks)
+
+	if p.Variant == VariantCrazyhouse && masks.kind&genQuiets != 0 {
+		p.generateDropMoves(ml, masks)
+	}
+}
+
+func (p *Position) generatePieceMoves(ml *MoveList, kind PieceKind, masks moveMasks) {
+	var attacks bitboard
+
+	enemies := p.Board.sideOccupancies[p.Turn.Opposite()]
+	occupancy := p.Board.sideOccupancies[Both]
+
+	piece := NewPiece(kind, p.Turn)
+	pieces := p.Board.pieceOccupancies[p.Turn][kind]
+
+	for pieces > 0 {
+		src := Square(pieces.PopLS1B())
+
+		switch kind {
+		case Queen:
+			attacks = getQueenAttacks(src, occupancy)
+		case Rook:
+			attacks = getRookAttacks(src, occupancy)
+		case Bishop:
+			attacks = getBishopAttacks(src, occupancy)
+		default:
+			attacks = knightsAttacksMask[src]
+		}
+
+		attacks &= ^p.Board.sideOccupancies[p.Turn] & masks.target
+
+		// the pinned piece can only move along the pin line
+		if masks.pinned.bitIsSet(src) {
+			attacks &= lineMask[masks.king][src]
+		}
+
+		ml.addPieceMoves(src, attacks, enemies, piece, masks.kind)
+	}
+}
+
+func (p *Position) generateKingMoves(ml *MoveList, masks moveMasks) {
+	enemies := p.Board.sideOccupancies[p.Turn.Opposite()]
+
+	piece := NewPiece(King, p.Turn)
+	kings := p.Board.pieceOccupancies[p.Turn][King]
+
+	// the horde has no king and the king is exploded in atomic chess
+	if kings == 0 {
+		return
+	}
+
+	src := Square(kings.LS1B())
+	attacks := kingAttacksMask[src] & ^p.Board.sideOccupancies[p.Turn]
+
+	// the king can't capture in atomic chess because it would explode itself
+	if p.Variant == VariantAtomic {
+		attacks &= ^enemies
+	}
+
+	if masks.legal {
+		// the king is removed so it can't step back along the line of the checking slider
+		occupancy := p.Board.sideOccupancies[Both] &^ src.occupancyMask()
+
+		for dests := attacks; dests > 0; {
+			dest := Square(dests.PopLS1B())
+			if p.Board.isSquareAttacked(dest, p.Turn.Opposite(), occupancy) {
+				attacks.clearBit(dest)
+			}
+		}
+	}
+
+	ml.addPieceMoves(src, attacks, enemies, piece, masks.kind)
+
+	if masks.kind&genQuiets == 0 {
+		return
+	}
+
+	inCheck := masks.checkers != 0
+	if !masks.legal {
+		inCheck = p.isInCheck(p.Turn)
+	}
+
+	if !inCheck {
+		p.generateCastleMoves(ml, src, piece)
+	}
+}
+
+// generateCastleMoves generates the castle moves for both standard chess and chess960
+// the squares between the king and rook and their destinations must be empty and the king must not pass through the attacked square
+func (p *Position) generateCastleMoves(ml *MoveList, kingSq Square, piece Piece) {
+	for _, side := range [2]CastleSide{KingSide, QueenSide} {
+		if p.CastleRights&castleRight(p.Turn, side) == 0 {
+			continue
+		}
+
+		rookSq := p.CastleRooks[p.Turn][side]
+		kingDest, rookDest := castleDestinations(p.Turn, side)
+
+		occupancy := p.Board.sideOccupancies[Both] &^ (kingSq.occupancyMask() | rookSq.occupancyMask())
+		if occupancy&(rankSpan(kingSq, kingDest)|rankSpan(rookSq, rookDest)) != 0 {
+			continue
+		}
+
+		kingPath := rankSpan(kingSq, kingDest)
+		if p.Variant == VariantAtomic {
+			kingPath = p.atomicCastlePathAttacks(kingPath)
+		}
+
+		if p.Board.GetAttackedSquares(p.Turn.Opposite(), kingPath, occupancy) != 0 {
+			continue
+		}
+
+		if p.Chess960 {
+			ml.add(newChess960CastleMove(kingSq, kingDest, rookSq, piece))
+		} else {
+			ml.add(newCastleMove(kingSq, kingDest, piece))
+		}
+	}
+}
+
+func (p *Position) generatePawnMoves(ml *MoveList, masks moveMasks) {
+	enemies := p.Board.sideOccupancies[p.Turn.Opposite()]
+	empty := ^p.Board.sideOccupancies[Both]
+
+	piece := NewPiece(Pawn, p.Turn)
+	pawns := p.Board.pieceOccupancies[p.Turn][Pawn]
+
+	push, promotionRank, doublePushRank, hordeRank := Square(8), Rank7, Rank2, Rank1
+	if p.Turn.IsBlack() {
+		push, promotionRank, doublePushRank, hordeRank = -8, Rank2, Rank7, Rank8
+	}
+
+	for pawns > 0 {
+		src := Square(pawns.PopLS1B())
+
+		allowed := masks.target
+		if masks.pinned.bitIsSet(src) {
+			allowed &= lineMask[masks.king][src]
+		}
+
+		captures := pawnAttacksMask[p.Turn][src] & enemies & allowed
+		if masks.kind&genCaptures == 0 {
+			captures = 0
+		}
+
+		for captures > 0 {
+			dest := Square(captures.PopLS1B())
+
+			if src.Rank() == promotionRank {
+				ml.addPromotions(src, dest, piece, true)
+			} else {
+				ml.add(newCaptureMove(src, dest, piece))
+			}
+		}
+
+		if dest := src + push; empty.bitIsSet(dest) {
+			if allowed.bitIsSet(dest) {
+				if src.Rank() == promotionRank {
+					if masks.kind&genCaptures != 0 {
+						ml.addPromotions(src, dest, piece, false)
+					}
+				} else if masks.kind&genQuiets != 0 {
+					ml.add(newQuietMove(src, dest, piece))
+				}
+			}
+
+			if double := dest + push; masks.kind&genQuiets != 0 && empty.bitIsSet(double) && allowed.bitIsSet(double) {
+				if src.Rank() == doublePushRank {
+					ml.add(newDoublePawnMove(src, double, piece))
+				}
+
+				// the horde pawns can move two squares from the first rank too but it doesn't give the en-passant square
+				if p.Variant == VariantHorde && src.Rank() == hordeRank {
+					ml.add(newQuietMove(src, double, piece))
+				}
+			}
+		}
+
+		if masks.kind&genCaptures != 0 && p.EpSquare != SquareNone && pawnAttacksMask[p.Turn][src].bitIsSet(p.EpSquare) && (!masks.legal || p.isEnPassantLegal(src, push, masks)) {
+			ml.add(newEnpCaptureMove(src, p.EpSquare, piece))
+		}
+	}
+}
+
+// isEnPassantLegal checks the en-passant capture on the board after it, both pawns leave their squares so the king can be exposed on the rank too
+func (p *Position) isEnPassantLegal(src, push Square, masks moveMasks) bool {
+	if masks.king == SquareNone {
+		return true
+	}
+
+	enemy := p.Turn.Opposite()
+	captured := p.EpSquare - push
+	occupancy := p.Board.sideOccupancies[Both]&^(src.occupancyMask()|captured.occupancyMask()) | p.EpSquare.occupancyMask()
+
+	queens := p.Board.pieceOccupancies[enemy][Queen]
+	if getRookAttacks(masks.king, occupancy)&(p.Board.pieceOccupancies[enemy][Rook]|queens) != 0 {
+		return false
+	}
+
+	if getBishopAttacks(masks.king, occupancy)&(p.Board.pieceOccupancies[enemy][Bishop]|queens) != 0 {
+		return false
+	}
+
+	// the knight check or the check of the other pawn remains
+	return masks.checkers&^captured.occupancyMask()&(p.Board.pieceOccupancies[enemy][Knight]|p.Board.pieceOccupancies[enemy][Pawn]) == 0
+}
@@ -0,0 +1,84 @@
+package engine
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGenerateMoveSubsets(t *testing.T) {
+	testCases := map[string]struct {
+		fen          string
+		variant      Variant
+		wantCaptures int
+		wantQuiets   int
+		wantEvasions int
+		wantChecks   int
+	}{
+		"kiwipete":              {fen: "r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1", wantCaptures: 8, wantQuiets: 40},
+		"en-passant and pins":   {fen: "8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 1", wantCaptures: 1, wantQuiets: 13, wantChecks: 2},
+		"promotions":            {fen: "n1n5/PPPk4/8/8/8/8/4Kppp/5N1N b - - 0 1", wantCaptures: 15, wantQuiets: 9, wantChecks: 3},
+		"single check":          {fen: "4k3/8/8/8/8/8/4r3/4K3 w - - 0 1", wantCaptures: 1, wantQuiets: 2, wantEvasions: 3},
+		"double check":          {fen: "4k3/8/8/8/1b6/8/3N4/r3K3 w - - 0 1", wantCaptures: 0, wantQuiets: 2, wantEvasions: 2},
+		"crazyhouse drops":      {fen: "4k3/8/8/8/8/8/8/4K3[Nn] w - - 0 1", variant: VariantCrazyhouse, wantCaptures: 0, wantQuiets: 67, wantChecks: 4},
+		"crazyhouse block":      {fen: "4k3/8/8/8/8/8/8/r3K3[Q] w - - 0 1", variant: VariantCrazyhouse, wantCaptures: 0, wantQuiets: 6, wantEvasions: 6},
+		"atomic":                {fen: "rnbqkbnr/ppp1pppp/8/3p4/4P3/8/PPPP1PPP/RNBQKBNR w KQkq - 0 2", variant: VariantAtomic, wantCaptures: 1, wantQuiets: 30, wantChecks: 1},
+		"three-check":           {fen: "rnbqkbnr/ppp2ppp/8/3pp3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq - 0 3 +0+0", variant: VariantThreeCheck, wantCaptures: 1, wantQuiets: 29, wantChecks: 1},
+		"racing kings no check": {fen: FENStartingPositionRacingKings, variant: VariantRacingKings, wantCaptures: 1, wantQuiets: 20},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := &Position{}
+			if err := p.LoadFromFENVariant(tc.fen, tc.variant); err != nil {
+				t.Fatalf("failed to load fen: %v", err)
+			}
+
+			var legal, captures, quiets, evasions, checks MoveList
+			p.GenerateLegalMoves(&legal)
+			p.GenerateCaptures(&captures)
+			p.GenerateQuiets(&quiets)
+			p.GenerateEvasions(&evasions)
+			p.GenerateChecks(&checks)
+
+			if captures.Len() != tc.wantCaptures {
+				t.Fatalf("invalid captures: want %d, got %d", tc.wantCaptures, captures.Len())
+			}
+
+			if quiets.Len() != tc.wantQuiets {
+				t.Fatalf("invalid quiets: want %d, got %d", tc.wantQuiets, quiets.Len())
+			}
+
+			if evasions.Len() != tc.wantEvasions {
+				t.Fatalf("invalid evasions: want %d, got %d", tc.wantEvasions, evasions.Len())
+			}
+
+			if checks.Len() != tc.wantChecks {
+				t.Fatalf("invalid checks: want %d, got %d", tc.wantChecks, checks.Len())
+			}
+
+			for _, m := range captures.Moves() {
+				if !m.IsCapture() && !m.Promotion().IsPromotion() {
+					t.Fatalf("invalid capture: %s is neither a capture nor a promotion", m)
+				}
+			}
+
+			for _, m := range quiets.Moves() {
+				if m.IsCapture() || m.Promotion().IsPromotion() {
+					t.Fatalf("invalid quiet: %s is a capture or a promotion", m)
+				}
+			}
+
+			all := append(slices.Clone(captures.Moves()), quiets.Moves()...)
+			if !sameMoves(all, legal.Moves()) {
+				t.Fatalf("invalid captures and quiets: want %v, got %v", legal.Moves(), all)
+			}
+		})
+	}
+}
+
+func sameMoves(a, b []Move) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+
+	return slices.Equal(a, b)
+}
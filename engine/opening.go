@@ -0,0 +1,112 @@
+package engine
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ecoData is the tab separated eco, name and pgn moves of the named openings
+//
+//go:embed openings/eco.tsv
+var ecoData []byte
+
+// Opening is the named opening of the position, the name is the opening family and the variation is the named line of it
+type Opening struct {
+	ECO       string
+	Name      string
+	Variation string
+}
+
+func (o Opening) String() string {
+	if o.Variation == "" {
+		return o.Name
+	}
+
+	return o.Name + ": " + o.Variation
+}
+
+// openings maps the polyglot key of the position reached by the opening moves to the opening, keying by the position
+// instead of the moves classifies the transpositions too
+var openings = sync.OnceValue(func() map[uint64]Opening {
+	m, err := parseOpenings(ecoData)
+	if err != nil {
+		panic(fmt.Sprintf("invalid embedded openings: %v", err))
+	}
+
+	return m
+})
+
+// parseOpenings plays the moves of every line from the starting position, the first line of the same position wins
+func parseOpenings(data []byte) (map[uint64]Opening, error) {
+	m := make(map[uint64]Opening)
+
+	sc := bufio.NewScanner(bytes.NewReader(data))
+
+	for line := 0; sc.Scan(); line++ {
+		if line == 0 || sc.Text() == "" {
+			continue
+		}
+
+		fields := strings.Split(sc.Text(), "\t")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("line %d: want 3 fields, got %d", line+1, len(fields))
+		}
+
+		c, err := NewChess(FENStartingPosition)
+		if err != nil {
+			return nil, err
+		}
+
+		for tok := range strings.FieldsSeq(fields[2]) {
+			if strings.HasSuffix(tok, ".") {
+				continue
+			}
+
+			if _, err := c.MakeMoveSAN(tok); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line+1, err)
+			}
+		}
+
+		key := c.Position.PolyglotKey()
+		if _, ok := m[key]; ok {
+			continue
+		}
+
+		name, variation, _ := strings.Cut(fields[1], ": ")
+		m[key] = Opening{ECO: fields[0], Name: name, Variation: variation}
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ClassifyOpening returns the opening of the latest named position of the game, the positions after
+// the game leaves the known lines keep the last opening that was reached
+func ClassifyOpening(history []History) (Opening, bool) {
+	for i := len(history) - 1; i >= 0; i-- {
+		pos := history[i].Position()
+		if o, ok := pos.Opening(); ok {
+			return o, true
+		}
+	}
+
+	return Opening{}, false
+}
+
+// Opening returns the named opening of the position, only the standard variant positions are classified
+func (p *Position) Opening() (Opening, bool) {
+	if p.Variant != VariantStandard {
+		return Opening{}, false
+	}
+
+	o, ok := openings()[p.PolyglotKey()]
+
+	return o, ok
+}
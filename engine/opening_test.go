@@ -0,0 +1,89 @@
+package engine
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestOpeningsData(t *testing.T) {
+	m, err := parseOpenings(ecoData)
+	if err != nil {
+		t.Fatalf("failed to parse openings: %v", err)
+	}
+
+	// every line has to reach a different position, otherwise the later line is never classified
+	lines := bytes.Count(bytes.TrimSpace(ecoData), []byte("\n"))
+	if len(m) != lines {
+		t.Fatalf("invalid openings: want %d positions, got %d", lines, len(m))
+	}
+}
+
+func TestClassifyOpening(t *testing.T) {
+	testCases := map[string]struct {
+		moves  []string
+		want   Opening
+		wantOK bool
+	}{
+		"no moves":        {moves: nil},
+		"first move":      {moves: []string{"e4"}, want: Opening{ECO: "B00", Name: "King's Pawn Game"}, wantOK: true},
+		"family only":     {moves: []string{"e4", "c5"}, want: Opening{ECO: "B20", Name: "Sicilian Defense"}, wantOK: true},
+		"najdorf":         {moves: []string{"e4", "c5", "Nf3", "d6", "d4", "cxd4", "Nxd4", "Nf6", "Nc3", "a6"}, want: Opening{ECO: "B90", Name: "Sicilian Defense", Variation: "Najdorf Variation"}, wantOK: true},
+		"left the book":   {moves: []string{"e4", "e5", "Nf3", "Nc6", "Bb5", "a6", "Ba4", "Nf6", "O-O", "Be7", "Re1", "b5", "Bb3", "d6", "c3", "O-O", "h3", "Na5", "Bc2", "c5", "d4", "Qc7", "Nbd2", "Bd7"}, want: Opening{ECO: "C97", Name: "Ruy Lopez", Variation: "Closed, Chigorin Defense, Yugoslav System"}, wantOK: true},
+		"caro-kann 3.Nd2": {moves: []string{"e4", "c6", "d4", "d5", "Nd2", "dxe4", "Nxe4", "Bf5"}, want: Opening{ECO: "B18", Name: "Caro-Kann Defense", Variation: "Classical Variation"}, wantOK: true},
+		"reti to qp game": {moves: []string{"Nf3", "d5", "d4"}, want: Opening{ECO: "D02", Name: "Queen's Pawn Game", Variation: "Zukertort Variation"}, wantOK: true},
+		"english to qgd":  {moves: []string{"c4", "e6", "Nc3", "d5", "d4", "Nf6"}, want: Opening{ECO: "D35", Name: "Queen's Gambit Declined", Variation: "Normal Defense"}, wantOK: true},
+		"unnamed":         {moves: []string{"Nc3", "a5"}, want: Opening{}, wantOK: false},
+		"nimzo sideline":  {moves: []string{"d4", "Nf6", "c4", "e6", "Nc3", "Bb4", "Qc2", "O-O", "a3", "Bxc3+", "Qxc3", "b6", "Bg5", "Bb7"}, want: Opening{ECO: "E32", Name: "Nimzo-Indian Defense", Variation: "Classical Variation, Keres Defense"}, wantOK: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewChess(FENStartingPosition)
+			if err != nil {
+				t.Fatalf("failed to start game: %v", err)
+			}
+
+			for _, san := range tc.moves {
+				if _, err := c.MakeMoveSAN(san); err != nil {
+					t.Fatalf("failed to make move %s: %v", san, err)
+				}
+			}
+
+			got, ok := ClassifyOpening(c.History)
+			if got != tc.want || ok != tc.wantOK {
+				t.Fatalf("invalid opening: want %+v %v, got %+v %v", tc.want, tc.wantOK, got, ok)
+			}
+		})
+	}
+}
+
+func TestPositionOpeningVariant(t *testing.T) {
+	c, err := NewChessVariant(FENStartingPosition, VariantKingOfTheHill)
+	if err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+
+	if _, err := c.MakeMoveSAN("e4"); err != nil {
+		t.Fatalf("failed to make move: %v", err)
+	}
+
+	if got, ok := ClassifyOpening(c.History); ok {
+		t.Fatalf("invalid opening: want none, got %+v", got)
+	}
+}
+
+func TestOpeningString(t *testing.T) {
+	testCases := map[string]struct {
+		opening Opening
+		want    string
+	}{
+		"family":    {opening: Opening{ECO: "B20", Name: "Sicilian Defense"}, want: "Sicilian Defense"},
+		"variation": {opening: Opening{ECO: "B90", Name: "Sicilian Defense", Variation: "Najdorf Variation"}, want: "Sicilian Defense: Najdorf Variation"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.opening.String(); got != tc.want {
+				t.Fatalf("invalid string: want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
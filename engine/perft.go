@@ -1,34 +1,44 @@
 package engine
 
 import (
-	"bufio"
+	"context"
+	"errors"
 	"fmt"
-	"os"
-	"os/exec"
-	"sort"
-	"strconv"
+	"io"
+	"maps"
+	"runtime"
+	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"text/tabwriter"
 	"time"
+	"unsafe"
+
+	"github.com/dankobg/juicer/engine/uciclient"
 )
 
+var ErrPerftMismatch = errors.New("perft mismatch")
+
+// traverse counts the leaf nodes, the moves at the last depth are only counted and not made
 func traverse(p *Position, depth int) int64 {
 	if depth == 0 {
 		return 1
 	}
 
-	var num int64
-
-	pseudo := p.generateAllPseudoLegalMoves()
+	var ml MoveList
+	p.GenerateLegalMoves(&ml)
 
-	for i := range pseudo {
-		unmakeMove := p.MakeMove(pseudo[i])
+	if depth == 1 {
+		return int64(ml.Len())
+	}
 
-		if !p.Board.IsInCheck(p.Turn.Opposite()) {
-			num += traverse(p, depth-1)
-		}
+	var num int64
 
-		unmakeMove()
+	for _, m := range ml.Moves() {
+		p.MakeMove(m)
+		num += traverse(p, depth-1)
+		p.UnmakeMove()
 	}
 
 	return num
@@ -45,162 +55,335 @@ func Perft(fen string, depth int) int64 {
 	return nodes
 }
 
-func Divide(fen string, depth int) {
+// PerftVariant counts the nodes of the position played by the variant rules
+func PerftVariant(fen string, variant Variant, depth int) int64 {
 	p := &Position{}
-	if err := p.LoadFromFEN(fen); err != nil {
+	if err := p.LoadFromFENVariant(fen, variant); err != nil {
 		panic(err)
 	}
 
-	pseudo := p.generateAllPseudoLegalMoves()
-
-	start := time.Now()
+	return traverse(p, depth)
+}
 
-	sort.Slice(pseudo, func(i, j int) bool {
-		if pseudo[i].String()[0] != pseudo[j].String()[0] {
-			return pseudo[i].String()[0] < pseudo[j].String()[0]
-		}
+// PerftOptions configures the parallel perft
+type PerftOptions struct {
+	// Threads are the goroutines the root moves are split across, 0 uses all cpus
+	Threads int
+	// HashMB is the size of the shared cache of the subtree counts in megabytes, 0 disables it
+	HashMB int
+	// Breakdown counts the captures, en-passants, castles, promotions, checks and checkmates of the leaf moves,
+	// the leaf moves are made and not only counted so it is slower
+	Breakdown bool
+}
 
-		return pseudo[i].String()[1:] < pseudo[j].String()[1:]
-	})
+// PerftStats are the leaf nodes and their breakdown by the last move
+type PerftStats struct {
+	Nodes      int64
+	Captures   int64
+	EnPassants int64
+	Castles    int64
+	Promotions int64
+	Checks     int64
+	Checkmates int64
+}
 
-	var nodesSearched int64
+func (s *PerftStats) add(other PerftStats) {
+	s.Nodes += other.Nodes
+	s.Captures += other.Captures
+	s.EnPassants += other.EnPassants
+	s.Castles += other.Castles
+	s.Promotions += other.Promotions
+	s.Checks += other.Checks
+	s.Checkmates += other.Checkmates
+}
 
-	for _, m := range pseudo {
-		unmakeMove := p.MakeMove(m)
+// PerftMove is the divide entry of the root move
+type PerftMove struct {
+	Move Move
+	PerftStats
+}
 
-		if !p.Board.IsInCheck(p.Turn.Opposite()) {
-			nodes := traverse(p, depth-1)
-			nodesSearched += nodes
-			fmt.Printf("%v: %v\n", m, nodes)
-		}
+// PerftResult is the perft of all root moves which are sorted by the uci notation
+type PerftResult struct {
+	Depth int
+	PerftStats
+	Moves []PerftMove
+	Time  time.Duration
+}
 
-		unmakeMove()
+// NPS is the number of the leaf nodes per second
+func (r *PerftResult) NPS() int64 {
+	if r.Time <= 0 {
+		return 0
 	}
 
-	fmt.Printf("\nNodes searched: %d\n", nodesSearched)
-	fmt.Printf("perft (nps): %v\n\n", (1000000*nodesSearched)/time.Since(start).Microseconds())
+	return int64(float64(r.Nodes) / r.Time.Seconds())
 }
 
-func CompareWithStockfishPerft(fen string, depth int, sfBinaryPath *string) {
+// Divide runs the perft of every root move of the fen in parallel
+func Divide(fen string, depth int, opts PerftOptions) (*PerftResult, error) {
 	p := &Position{}
 	if err := p.LoadFromFEN(fen); err != nil {
-		panic(err)
+		return nil, err
 	}
 
-	pseudo := p.generateAllPseudoLegalMoves()
+	return p.Divide(depth, opts), nil
+}
 
-	sort.Slice(pseudo, func(i, j int) bool {
-		if pseudo[i].String()[0] != pseudo[j].String()[0] {
-			return pseudo[i].String()[0] < pseudo[j].String()[0]
-		}
+// Divide runs the perft of every root move of the position in parallel, the position is not changed
+func (p *Position) Divide(depth int, opts PerftOptions) *PerftResult {
+	start := time.Now()
+	res := &PerftResult{Depth: depth}
 
-		return pseudo[i].String()[1:] < pseudo[j].String()[1:]
-	})
+	if depth <= 0 {
+		res.Nodes = 1
+		res.Time = time.Since(start)
 
-	var nodesSearched int64
+		return res
+	}
 
-	mine := make(map[string]int64)
-	sf := make(map[string]int64)
-	diff := make(map[string]int64)
+	var ml MoveList
+	p.GenerateLegalMoves(&ml)
 
-	for _, m := range pseudo {
-		unmakeMove := p.MakeMove(m)
+	res.Moves = make([]PerftMove, ml.Len())
+	for i, m := range ml.Moves() {
+		res.Moves[i].Move = m
+	}
 
-		if !p.Board.IsInCheck(p.Turn.Opposite()) {
-			nodes := traverse(p, depth-1)
-			nodesSearched += nodes
-			mine[m.String()] = nodes
-		}
+	threads := opts.Threads
+	if threads <= 0 {
+		threads = runtime.NumCPU()
+	}
 
-		unmakeMove()
+	var cache *perftCache
+	if opts.HashMB > 0 {
+		cache = newPerftCache(opts.HashMB)
 	}
 
-	mine["total"] = nodesSearched
+	var (
+		wg   sync.WaitGroup
+		next atomic.Int64
+	)
 
-	sfPath := "/usr/bin/stockfish"
-	if sfBinaryPath != nil {
-		sfPath = *sfBinaryPath
+	for range min(threads, len(res.Moves)) {
+		wg.Go(func() {
+			w := &perftWorker{p: p.Copy(), cache: cache, breakdown: opts.Breakdown}
+
+			for i := int(next.Add(1) - 1); i < len(res.Moves); i = int(next.Add(1) - 1) {
+				res.Moves[i].PerftStats = w.root(res.Moves[i].Move, depth)
+			}
+		})
 	}
 
-	cmd := exec.Command(sfPath)
+	wg.Wait()
 
-	in, err := cmd.StdinPipe()
-	if err != nil {
-		panic("in pipe err: " + err.Error())
+	slices.SortFunc(res.Moves, func(a, b PerftMove) int { return strings.Compare(a.Move.String(), b.Move.String()) })
+
+	for _, m := range res.Moves {
+		res.add(m.PerftStats)
 	}
 
-	out, err := cmd.StdoutPipe()
-	if err != nil {
-		panic("out pipe err: " + err.Error())
+	res.Time = time.Since(start)
+
+	return res
+}
+
+type perftWorker struct {
+	p         *Position
+	cache     *perftCache
+	breakdown bool
+}
+
+func (w *perftWorker) root(m Move, depth int) PerftStats {
+	if depth == 1 {
+		return w.leaf(m)
 	}
 
-	defer func() {
-		_ = out.Close()
-	}()
+	w.p.MakeMove(m)
+	stats := w.count(depth - 1)
+	w.p.UnmakeMove()
 
-	if err := cmd.Start(); err != nil {
-		panic("cmd start err: " + err.Error())
+	return stats
+}
+
+// count counts the leaf nodes of the subtree, the last moves are only counted without the breakdown
+func (w *perftWorker) count(depth int) PerftStats {
+	var stats PerftStats
+
+	if w.cache != nil {
+		if cached, ok := w.cache.probe(w.p.Hash, depth); ok {
+			return cached
+		}
 	}
 
-	send := func(command string) {
-		if _, err := in.Write([]byte(command + "\n")); err != nil {
-			_ = in.Close()
+	var ml MoveList
+	w.p.GenerateLegalMoves(&ml)
+
+	switch {
+	case depth == 1 && !w.breakdown:
+		stats.Nodes = int64(ml.Len())
+	case depth == 1:
+		for _, m := range ml.Moves() {
+			stats.add(w.leaf(m))
+		}
+	default:
+		for _, m := range ml.Moves() {
+			w.p.MakeMove(m)
+			stats.add(w.count(depth - 1))
+			w.p.UnmakeMove()
 		}
 	}
 
-	send("position fen " + fen)
-	send("go perft " + strconv.Itoa(depth))
+	if w.cache != nil {
+		w.cache.store(w.p.Hash, depth, stats)
+	}
 
-	_ = in.Close()
+	return stats
+}
 
-	scanner := bufio.NewScanner(out)
+// leaf counts the leaf move, it is made only for the breakdown of the checks
+func (w *perftWorker) leaf(m Move) PerftStats {
+	stats := PerftStats{Nodes: 1}
 
-	for scanner.Scan() {
-		line := scanner.Text()
+	if !w.breakdown {
+		return stats
+	}
 
-		if strings.HasPrefix(line, "Nodes searched") {
-			pair := strings.Split(line, ": ")
-			nodes, _ := strconv.Atoi(pair[1])
-			sf["total"] = int64(nodes)
-		}
+	if m.IsCapture() {
+		stats.Captures++
+	}
 
-		if !strings.HasPrefix(line, "Stockfish") &&
-			!strings.HasPrefix(line, "info") &&
-			!strings.HasPrefix(line, "Nodes searched") &&
-			line != "" {
-			pair := strings.Split(line, ": ")
-			move := pair[0]
-			nodes, _ := strconv.Atoi(pair[1])
-			sf[move] = int64(nodes)
-		}
+	if m.IsEnPassant() {
+		stats.EnPassants++
 	}
 
-	for m, n := range mine {
-		if m == "total" {
-			continue
+	if m.IsCastle() {
+		stats.Castles++
+	}
+
+	if m.Promotion().IsPromotion() {
+		stats.Promotions++
+	}
+
+	w.p.MakeMove(m)
+
+	if w.p.Check {
+		stats.Checks++
+
+		var ml MoveList
+		if w.p.GenerateLegalMoves(&ml); ml.Len() == 0 {
+			stats.Checkmates++
 		}
+	}
+
+	w.p.UnmakeMove()
+
+	return stats
+}
+
+// perftCacheLocks are the stripes of the locks guarding the cache entries
+const perftCacheLocks = 256
+
+type perftCacheEntry struct {
+	hash  uint64
+	depth int
+	stats PerftStats
+}
+
+// perftCache is the always replace cache of the subtree counts by the zobrist hash and the depth shared by the perft workers
+type perftCache struct {
+	entries []perftCacheEntry
+	locks   [perftCacheLocks]sync.Mutex
+}
+
+func newPerftCache(mb int) *perftCache {
+	n := max(1, mb*1024*1024/int(unsafe.Sizeof(perftCacheEntry{})))
+	return &perftCache{entries: make([]perftCacheEntry, n)}
+}
+
+func (pc *perftCache) probe(hash uint64, depth int) (PerftStats, bool) {
+	i := hash % uint64(len(pc.entries))
+
+	mu := &pc.locks[i%perftCacheLocks]
+	mu.Lock()
+	e := pc.entries[i]
+	mu.Unlock()
+
+	if e.depth != depth || e.hash != hash {
+		return PerftStats{}, false
+	}
 
-		if sf[m] != n {
-			diff[m] = n
+	return e.stats, true
+}
+
+func (pc *perftCache) store(hash uint64, depth int, stats PerftStats) {
+	i := hash % uint64(len(pc.entries))
+
+	mu := &pc.locks[i%perftCacheLocks]
+	mu.Lock()
+	pc.entries[i] = perftCacheEntry{hash: hash, depth: depth, stats: stats}
+	mu.Unlock()
+}
+
+// ComparePerft compares the divide with the other uci engine (e.g. stockfish) and writes the report of the moves
+// with the different counts, it returns ErrPerftMismatch when the engines don't agree
+func ComparePerft(ctx context.Context, client *uciclient.Client, fen string, depth int, w io.Writer) error {
+	mine, err := Divide(fen, depth, PerftOptions{})
+	if err != nil {
+		return err
+	}
+
+	other, err := client.Perft(ctx, fen, depth)
+	if err != nil {
+		return fmt.Errorf("failed %s perft: %w", client.Name(), err)
+	}
+
+	mineMoves := make(map[string]int64, len(mine.Moves))
+	for _, m := range mine.Moves {
+		mineMoves[m.Move.String()] = m.Nodes
+	}
+
+	moves := slices.Sorted(maps.Keys(other.Moves))
+
+	for m := range mineMoves {
+		if _, ok := other.Moves[m]; !ok {
+			moves = append(moves, m)
 		}
 	}
 
-	_ = cmd.Wait()
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
 
-	tw := tabwriter.NewWriter(os.Stdout, 11, 4, 1, ' ', tabwriter.Debug)
+	_, _ = fmt.Fprintf(tw, "Move\t%s\tJuicer\tDiff\t\n", client.Name())
 
-	_, _ = fmt.Fprintf(tw, "+----------------------------------------------+\n")
-	_, _ = fmt.Fprintf(tw, "| Move\t Stockfish\t Juicer\t Diff\t\n")
-	_, _ = fmt.Fprintf(tw, "+----------------------------------------------+\n")
+	var diffs int
 
-	for k, v := range sf {
-		if k != "total" {
-			_, _ = fmt.Fprintf(tw, "| %v\t %v\t %v\t %v\t\n", k, v, mine[k], v-mine[k])
+	for _, m := range moves {
+		theirs, ok := other.Moves[m]
+		ours, mineOk := mineMoves[m]
+
+		switch {
+		case !ok:
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t\n", m, "missing", ours, "extra move")
+		case !mineOk:
+			_, _ = fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t\n", m, theirs, "missing", "missing move")
+		case theirs != ours:
+			_, _ = fmt.Fprintf(tw, "%s\t%d\t%d\t%+d\t\n", m, theirs, ours, ours-theirs)
+		default:
+			continue
 		}
+
+		diffs++
+	}
+
+	_, _ = fmt.Fprintf(tw, "Nodes\t%d\t%d\t%+d\t\n", other.Nodes, mine.Nodes, mine.Nodes-other.Nodes)
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if diffs > 0 || other.Nodes != mine.Nodes {
+		return fmt.Errorf("%w: %d moves differ from %s", ErrPerftMismatch, diffs, client.Name())
 	}
 
-	_, _ = fmt.Fprintf(tw, "+----------------------------------------------+\n")
-	_, _ = fmt.Fprintf(tw, "| %v\t %v\t %v\t %v\t\n", "Nodes", sf["total"], mine["total"], sf["total"]-mine["total"])
-	_, _ = fmt.Fprintf(tw, "+----------------------------------------------+\n")
-	_ = tw.Flush()
+	return nil
 }
@@ -1,120 +1,188 @@
 package engine
 
-// perft tests can be very slow even some at depth 5 can take ~1m
-// they are engine and cpu bound so i would need to super optimize engine first
-
-// import (
-// 	"bufio"
-// 	"fmt"
-// 	"log"
-// 	"os"
-// 	"strconv"
-// 	"strings"
-// 	"sync"
-// 	"time"
-// )
-
-// type Result struct {
-// 	FEN     string
-// 	Depth   int
-// 	Nodes   int
-// 	Calc    int64
-// 	Elapsed time.Duration
-// }
-
-// var maxDepthCheck = 4
-
-// func main() {
-// 	InitPrecalculatedTables()
-
-// 	lines := make(chan string)
-// 	results := make(chan Result)
-// 	wg := new(sync.WaitGroup)
-
-// 	go func() {
-// 		processEpdFile("engine/perft_suite/epds/single_check_1.epd", lines)
-// 		close(lines)
-// 	}()
-
-// 	numWorkers := 128
-// 	for range numWorkers {
-// 		wg.Add(1)
-// 		go worker(lines, results, wg)
-// 	}
-
-// 	go func() {
-// 		wg.Wait()
-// 		close(results)
-// 	}()
-
-// 	for result := range results {
-// 		fmt.Printf("%s, D%d [%d, %d] took %v\n", result.FEN, result.Depth, result.Nodes, result.Calc, result.Elapsed)
-// 	}
-// }
-
-// func processEpdFile(path string, lines chan<- string) {
-// 	f, err := os.Open(path)
-// 	if err != nil {
-// 		log.Fatalf("open epd file err: %v", err)
-// 	}
-// 	defer f.Close()
-// 	sc := bufio.NewScanner(f)
-// 	for sc.Scan() {
-// 		line := strings.TrimSpace(sc.Text())
-// 		if line != "" {
-// 			lines <- line
-// 		}
-// 	}
-// 	if err := sc.Err(); err != nil {
-// 		log.Fatalf("scan epd file err: %v", err)
-// 	}
-// }
-
-// func worker(lines <-chan string, results chan<- Result, wg *sync.WaitGroup) {
-// 	defer wg.Done()
-// 	for line := range lines {
-// 		processLine(line, results)
-// 	}
-// }
-
-// func processLine(line string, results chan<- Result) {
-// 	tokens := strings.SplitN(line, ";", 2)
-// 	if len(tokens) < 2 {
-// 		log.Fatalf("invalid tokens len")
-// 	}
-// 	fen := strings.TrimSpace(tokens[0])
-// 	pairs := strings.Split(tokens[1:][0], ";")
-// 	pairWg := new(sync.WaitGroup)
-// 	for _, pair := range pairs {
-// 		pairWg.Add(1)
-// 		go processPair(pair, fen, results, pairWg)
-// 	}
-// 	pairWg.Wait()
-// }
-
-// func processPair(pair, fen string, results chan<- Result, wg *sync.WaitGroup) {
-// 	defer wg.Done()
-// 	vals := strings.Split(strings.TrimSpace(pair), " ")
-// 	if len(vals) != 2 {
-// 		log.Fatalf("invalid depth-node values")
-// 	}
-// 	depthToken, nodesToken := vals[0], vals[1]
-// 	if !strings.HasPrefix(depthToken, "D") {
-// 		log.Fatalf("no prefix D for depth")
-// 	}
-// 	depth, err := strconv.Atoi(depthToken[1:])
-// 	if err != nil {
-// 		log.Fatalf("failed to parse depth")
-// 	}
-// 	nodes, err := strconv.Atoi(nodesToken)
-// 	if err != nil {
-// 		log.Fatalf("failed to parse nodes")
-// 	}
-// 	if depth > maxDepthCheck {
-// 		return
-// 	}
-// 	start := time.Now()
-// 	calculated := Perft(fen, depth)
-// 	elapsed := time.Since(start)
-// 	results <- Result{FEN: fen, Depth: depth, Nodes: nodes, Calc: calculated, Elapsed: elapsed}
-// }
+import (
+	"maps"
+	"os"
+	"slices"
+	"testing"
+)
+
+// perftMaxNodes keeps the perft suite fast, the deeper entries are checked with the larger budget without the short flag
+const (
+	perftMaxNodes      = 1_000_000
+	perftMaxNodesShort = 50_000
+)
+
+type perftEntry struct {
+	fen   string
+	depth int
+	nodes int64
+}
+
+func readPerftSuite(t *testing.T, path string) []perftEntry {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open perft suite: %v", err)
+	}
+	defer f.Close()
+
+	records, err := ReadEPD(f)
+	if err != nil {
+		t.Fatalf("failed to read perft suite: %v", err)
+	}
+
+	var entries []perftEntry
+
+	for _, e := range records {
+		for _, depth := range slices.Sorted(maps.Keys(e.Perft)) {
+			entries = append(entries, perftEntry{fen: e.FEN, depth: depth, nodes: e.Perft[depth]})
+		}
+	}
+
+	return entries
+}
+
+func TestPerft(t *testing.T) {
+	maxNodes := int64(perftMaxNodes)
+	if testing.Short() {
+		maxNodes = perftMaxNodesShort
+	}
+
+	for _, e := range readPerftSuite(t, "perft_suite/perft.epd") {
+		if e.nodes > maxNodes {
+			continue
+		}
+
+		if got := Perft(e.fen, e.depth); got != e.nodes {
+			t.Fatalf("invalid perft nodes for %q at depth %d: want %d, got %d", e.fen, e.depth, e.nodes, got)
+		}
+	}
+}
+
+func TestDivide(t *testing.T) {
+	kiwipete := "r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1"
+
+	testCases := map[string]struct {
+		fen   string
+		depth int
+		opts  PerftOptions
+		want  PerftStats
+	}{
+		"starting position": {
+			fen:   FENStartingPosition,
+			depth: 4,
+			opts:  PerftOptions{Breakdown: true},
+			want:  PerftStats{Nodes: 197281, Captures: 1576, Checks: 469, Checkmates: 8},
+		},
+		"kiwipete": {
+			fen:   kiwipete,
+			depth: 3,
+			opts:  PerftOptions{Threads: 4, HashMB: 1, Breakdown: true},
+			want:  PerftStats{Nodes: 97862, Captures: 17102, EnPassants: 45, Castles: 3162, Checks: 993, Checkmates: 1},
+		},
+		"promotions": {
+			fen:   "r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 w kq - 0 1",
+			depth: 3,
+			opts:  PerftOptions{Threads: 1, Breakdown: true},
+			want:  PerftStats{Nodes: 9467, Captures: 1021, EnPassants: 4, Promotions: 120, Checks: 38, Checkmates: 22},
+		},
+		"en passant": {
+			fen:   "8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 1",
+			depth: 4,
+			opts:  PerftOptions{HashMB: 1, Breakdown: true},
+			want:  PerftStats{Nodes: 43238, Captures: 3348, EnPassants: 123, Checks: 1680, Checkmates: 17},
+		},
+		"nodes only": {
+			fen:   kiwipete,
+			depth: 4,
+			opts:  PerftOptions{HashMB: 1},
+			want:  PerftStats{Nodes: 4085603},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Divide(tc.fen, tc.depth, tc.opts)
+			if err != nil {
+				t.Fatalf("failed to divide: %v", err)
+			}
+
+			if got.PerftStats != tc.want {
+				t.Fatalf("invalid perft stats: want %+v, got %+v", tc.want, got.PerftStats)
+			}
+
+			var nodes int64
+
+			for i, m := range got.Moves {
+				if i > 0 && got.Moves[i-1].Move.String() >= m.Move.String() {
+					t.Fatalf("invalid move order: want %s before %s", m.Move, got.Moves[i-1].Move)
+				}
+
+				nodes += m.Nodes
+			}
+
+			if nodes != tc.want.Nodes {
+				t.Fatalf("invalid divide nodes: want %d, got %d", tc.want.Nodes, nodes)
+			}
+		})
+	}
+}
+
+func TestDivideInvalidFEN(t *testing.T) {
+	if _, err := Divide("invalid", 1, PerftOptions{}); err == nil {
+		t.Fatalf("invalid error: want error, got nil")
+	}
+}
+
+func BenchmarkPerft(b *testing.B) {
+	benchCases := map[string]struct {
+		fen   string
+		depth int
+	}{
+		"starting position": {fen: FENStartingPosition, depth: 4},
+		"kiwipete":          {fen: "r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1", depth: 3},
+	}
+
+	for name, bc := range benchCases {
+		b.Run(name, func(b *testing.B) {
+			for b.Loop() {
+				Perft(bc.fen, bc.depth)
+			}
+		})
+	}
+}
+
+func BenchmarkGenerateLegalMoves(b *testing.B) {
+	p := &Position{}
+	if err := p.LoadFromFEN("r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1"); err != nil {
+		b.Fatal(err)
+	}
+
+	var ml MoveList
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		p.GenerateLegalMoves(&ml)
+	}
+}
+
+func BenchmarkMakeUnmakeMove(b *testing.B) {
+	p := &Position{}
+	if err := p.LoadFromFEN("r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1"); err != nil {
+		b.Fatal(err)
+	}
+
+	var ml MoveList
+	p.GenerateLegalMoves(&ml)
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		for _, m := range ml.Moves() {
+			p.MakeMove(m)
+			p.UnmakeMove()
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package pgn
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+type gameJSON struct {
+	Tags []tagJSON `json:"tags"`
+	Root *nodeJSON `json:"root"`
+}
+
+type tagJSON struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// nodeJSON is the node with its subtree, the move is in the uci notation and the hash is the hex string
+// because the 64 bit numbers don't fit the javascript numbers
+type nodeJSON struct {
+	Ply             int              `json:"ply"`
+	Move            string           `json:"move,omitempty"`
+	SAN             string           `json:"san,omitempty"`
+	FEN             string           `json:"fen"`
+	Hash            string           `json:"hash"`
+	StartingComment string           `json:"starting_comment,omitempty"`
+	Comment         string           `json:"comment,omitempty"`
+	NAGs            []int            `json:"nags,omitempty"`
+	Arrows          []arrowJSON      `json:"arrows,omitempty"`
+	Squares         []squareMarkJSON `json:"squares,omitempty"`
+	Children        []*nodeJSON      `json:"children,omitempty"`
+}
+
+type arrowJSON struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Color string `json:"color"`
+}
+
+type squareMarkJSON struct {
+	Square string `json:"square"`
+	Color  string `json:"color"`
+}
+
+// MarshalJSON writes the tags and the whole move tree with the variations
+func (g *Game) MarshalJSON() ([]byte, error) {
+	gj := gameJSON{Tags: make([]tagJSON, len(g.Tags)), Root: nodeToJSON(g.Root, 0)}
+
+	for i, tag := range g.Tags {
+		gj.Tags[i] = tagJSON(tag)
+	}
+
+	return json.Marshal(gj)
+}
+
+// UnmarshalJSON replays the moves of the tree from the position of the Variant and FEN tags,
+// the fens, the hashes and the san moves are recalculated
+func (g *Game) UnmarshalJSON(data []byte) error {
+	var gj gameJSON
+	if err := json.Unmarshal(data, &gj); err != nil {
+		return err
+	}
+
+	var tags Tags
+	for _, tag := range gj.Tags {
+		tags.Set(tag.Name, tag.Value)
+	}
+
+	game, err := newGameFromTags(tags)
+	if err != nil {
+		return fmt.Errorf("failed to create pgn game: %w", err)
+	}
+
+	if gj.Root != nil {
+		if err := nodeFromJSON(game.Root, gj.Root); err != nil {
+			return err
+		}
+	}
+
+	*g = *game
+
+	return nil
+}
+
+func nodeToJSON(n *Node, ply int) *nodeJSON {
+	nj := &nodeJSON{
+		Ply:             ply,
+		SAN:             n.SAN,
+		FEN:             n.FEN(),
+		Hash:            strconv.FormatUint(n.Hash(), 16),
+		StartingComment: n.StartingComment,
+		Comment:         n.Comment,
+		NAGs:            n.NAGs,
+	}
+
+	if !n.IsRoot() {
+		nj.Move = n.Move.String()
+	}
+
+	for _, a := range n.Arrows {
+		nj.Arrows = append(nj.Arrows, arrowJSON{From: a.From.String(), To: a.To.String(), Color: a.Color.String()})
+	}
+
+	for _, sm := range n.Squares {
+		nj.Squares = append(nj.Squares, squareMarkJSON{Square: sm.Square.String(), Color: sm.Color.String()})
+	}
+
+	for _, child := range n.Children {
+		nj.Children = append(nj.Children, nodeToJSON(child, ply+1))
+	}
+
+	return nj
+}
+
+// nodeFromJSON sets the annotations of the node and adds its children
+func nodeFromJSON(n *Node, nj *nodeJSON) error {
+	n.StartingComment = nj.StartingComment
+	n.Comment = nj.Comment
+	n.NAGs = nj.NAGs
+
+	for _, aj := range nj.Arrows {
+		color, ok := parseMarkColor(aj.Color)
+		from, to := parseSquare(aj.From), parseSquare(aj.To)
+		if !ok || from == engine.SquareNone || to == engine.SquareNone {
+			return fmt.Errorf("%w: invalid arrow %s%s %s", ErrSyntax, aj.From, aj.To, aj.Color)
+		}
+
+		n.Arrows = append(n.Arrows, Arrow{From: from, To: to, Color: color})
+	}
+
+	for _, sj := range nj.Squares {
+		color, ok := parseMarkColor(sj.Color)
+		sq := parseSquare(sj.Square)
+		if !ok || sq == engine.SquareNone {
+			return fmt.Errorf("%w: invalid square %s %s", ErrSyntax, sj.Square, sj.Color)
+		}
+
+		n.Squares = append(n.Squares, SquareMark{Square: sq, Color: color})
+	}
+
+	for _, cj := range nj.Children {
+		child, err := n.AddMoveUCI(cj.Move)
+		if err != nil {
+			return err
+		}
+
+		if err := nodeFromJSON(child, cj); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
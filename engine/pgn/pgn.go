@@ -0,0 +1,240 @@
+package pgn
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+var (
+	ErrSyntax      = errors.New("pgn syntax error")
+	ErrIllegalMove = errors.New("pgn illegal move")
+)
+
+const (
+	ResultWhiteWon = "1-0"
+	ResultBlackWon = "0-1"
+	ResultDraw     = "1/2-1/2"
+	ResultUnknown  = "*"
+)
+
+// SevenTagRoster are the mandatory tags that are always exported first and in this order
+var SevenTagRoster = []string{"Event", "Site", "Date", "Round", "White", "Black", "Result"}
+
+// sevenTagRosterDefaults are the values exported for the missing seven tag roster tags
+var sevenTagRosterDefaults = map[string]string{
+	"Event":  "?",
+	"Site":   "?",
+	"Date":   "????.??.??",
+	"Round":  "?",
+	"White":  "?",
+	"Black":  "?",
+	"Result": ResultUnknown,
+}
+
+type Tag struct {
+	Name  string
+	Value string
+}
+
+// Tags keeps the tag pairs in the order they were added
+type Tags []Tag
+
+func (t Tags) Get(name string) (string, bool) {
+	for _, tag := range t {
+		if tag.Name == name {
+			return tag.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// Set replaces the value of the existing tag or appends a new one
+func (t *Tags) Set(name, value string) {
+	for i, tag := range *t {
+		if tag.Name == name {
+			(*t)[i].Value = value
+			return
+		}
+	}
+
+	*t = append(*t, Tag{Name: name, Value: value})
+}
+
+func (t *Tags) Delete(name string) {
+	*t = slices.DeleteFunc(*t, func(tag Tag) bool { return tag.Name == name })
+}
+
+// Node is a single move in the game tree
+// the first child continues the main line and the rest of the children are the alternative variations
+type Node struct {
+	Parent   *Node
+	Children []*Node
+	Move     engine.Move
+	SAN      string
+	// Position is the position after the move, root node holds the starting position
+	Position *engine.Position
+	// StartingComment is the comment written before the first move of a variation
+	StartingComment string
+	Comment         string
+	NAGs            []int
+	// Arrows and Squares are the board marks of the `[%cal]` and `[%csl]` comment commands
+	Arrows  []Arrow
+	Squares []SquareMark
+}
+
+func (n *Node) IsRoot() bool {
+	return n.Parent == nil
+}
+
+// Next returns the main line continuation or nil at the end of the line
+func (n *Node) Next() *Node {
+	if len(n.Children) == 0 {
+		return nil
+	}
+
+	return n.Children[0]
+}
+
+// Variations returns the alternatives to the main line continuation
+func (n *Node) Variations() []*Node {
+	if len(n.Children) < 2 {
+		return nil
+	}
+
+	return n.Children[1:]
+}
+
+// AddMove adds the move as the last child of the node, it becomes the main line if the node has no children yet
+func (n *Node) AddMove(m engine.Move) (*Node, error) {
+	legalMoves := n.Position.LegalMoves()
+	if !slices.Contains(legalMoves, m) {
+		return nil, fmt.Errorf("%w: %s", ErrIllegalMove, m)
+	}
+
+	return n.addMove(m, legalMoves), nil
+}
+
+// AddMoveSAN parses the san move in the node position and adds it as the last child
+func (n *Node) AddMoveSAN(san string) (*Node, error) {
+	legalMoves := n.Position.LegalMoves()
+
+	m, err := engine.ParseSAN(san, legalMoves)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrIllegalMove, err)
+	}
+
+	return n.addMove(m, legalMoves), nil
+}
+
+func (n *Node) addMove(m engine.Move, legalMoves []engine.Move) *Node {
+	pos := n.Position.Copy()
+	pos.MakeMove(m)
+
+	isCheckmate := pos.Check && len(pos.LegalMoves()) == 0
+
+	child := &Node{
+		Parent:   n,
+		Move:     m,
+		SAN:      m.ToSAN(n.Position, pos.Check, isCheckmate, legalMoves),
+		Position: pos,
+	}
+
+	n.Children = append(n.Children, child)
+
+	return child
+}
+
+const variantChess960 = "Chess960"
+
+// variantTags are the Variant tag values of the engine variants
+var variantTags = map[engine.Variant]string{
+	engine.VariantCrazyhouse:    "Crazyhouse",
+	engine.VariantAtomic:        "Atomic",
+	engine.VariantKingOfTheHill: "King of the Hill",
+	engine.VariantThreeCheck:    "Three-check",
+	engine.VariantHorde:         "Horde",
+	engine.VariantRacingKings:   "Racing Kings",
+}
+
+type Game struct {
+	Tags Tags
+	Root *Node
+}
+
+// NewGame creates an empty game starting from the fen position
+// the SetUp and FEN tags are set when the fen is not the standard starting position
+func NewGame(fen string) (*Game, error) {
+	return NewGameVariant(fen, engine.VariantStandard)
+}
+
+// NewGameVariant creates an empty game of the variant starting from the fen position, the Variant tag is set for the engine variants
+func NewGameVariant(fen string, variant engine.Variant) (*Game, error) {
+	pos := &engine.Position{}
+	if err := pos.LoadFromFENVariant(fen, variant); err != nil {
+		return nil, fmt.Errorf("failed to create pgn game: %w", err)
+	}
+
+	g := &Game{Root: &Node{Position: pos}}
+
+	if fen != engine.FENStartingPosition {
+		g.Tags.Set("SetUp", "1")
+		g.Tags.Set("FEN", fen)
+	}
+
+	if tag, ok := variantTags[variant]; ok {
+		g.Tags.Set("Variant", tag)
+	}
+
+	return g, nil
+}
+
+// FromChess creates the game main line from the chess history
+func FromChess(c *engine.Chess) *Game {
+	g := &Game{Root: &Node{Position: c.StartPosition.Copy()}}
+
+	if fen := c.StartPosition.Fen(); fen != engine.FENStartingPosition || c.StartPosition.Chess960 {
+		g.Tags.Set("SetUp", "1")
+		g.Tags.Set("FEN", fen)
+	}
+
+	if c.StartPosition.Chess960 {
+		g.Tags.Set("Variant", variantChess960)
+	} else if tag, ok := variantTags[c.StartPosition.Variant]; ok {
+		g.Tags.Set("Variant", tag)
+	}
+
+	node := g.Root
+	for _, h := range c.History {
+		node = node.addMove(h.Move(), node.Position.LegalMoves())
+	}
+
+	return g
+}
+
+// MainLine returns the main line nodes without the root node
+func (g *Game) MainLine() []*Node {
+	var nodes []*Node
+
+	for n := g.Root.Next(); n != nil; n = n.Next() {
+		nodes = append(nodes, n)
+	}
+
+	return nodes
+}
+
+// Result returns the Result tag value or `*` when it is missing
+func (g *Game) Result() string {
+	if result, ok := g.Tags.Get("Result"); ok && isResult(result) {
+		return result
+	}
+
+	return ResultUnknown
+}
+
+func isResult(s string) bool {
+	return s == ResultWhiteWon || s == ResultBlackWon || s == ResultDraw || s == ResultUnknown
+}
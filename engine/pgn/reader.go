@@ -0,0 +1,551 @@
+package pgn
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+type tokenKind uint8
+
+const (
+	tokenEOF tokenKind = iota
+	tokenTagOpen
+	tokenTagClose
+	tokenString
+	tokenSymbol
+	tokenComment
+	tokenNAG
+	tokenVariationOpen
+	tokenVariationClose
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	line  int
+}
+
+// glyphNAGs maps the move suffix annotations to the numeric annotation glyphs
+var glyphNAGs = map[string]int{
+	"!":  1,
+	"?":  2,
+	"!!": 3,
+	"??": 4,
+	"!?": 5,
+	"?!": 6,
+}
+
+// Reader reads the games one by one from the pgn input that may contain many games
+type Reader struct {
+	r      *bufio.Reader
+	line   int
+	prev   rune
+	cur    rune
+	peeked *token
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r), line: 1}
+}
+
+// Parse parses the first game from the pgn string
+func Parse(s string) (*Game, error) {
+	return NewReader(strings.NewReader(s)).Read()
+}
+
+// ReadAll reads all the remaining games
+func (r *Reader) ReadAll() ([]*Game, error) {
+	var games []*Game
+
+	for {
+		g, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return games, nil
+		}
+
+		if err != nil {
+			return games, err
+		}
+
+		games = append(games, g)
+	}
+}
+
+// Read reads the next game, it returns io.EOF when there are no more games
+func (r *Reader) Read() (*Game, error) {
+	tkn, err := r.peek()
+	if err != nil {
+		return nil, err
+	}
+
+	if tkn.kind == tokenEOF {
+		return nil, io.EOF
+	}
+
+	tags, err := r.readTags()
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := newGameFromTags(tags)
+	if err != nil {
+		return nil, fmt.Errorf("%w: line %d: %w", ErrSyntax, tkn.line, err)
+	}
+
+	if err := r.readMovetext(g); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// newGameFromTags creates the empty game from the Variant and FEN tags
+func newGameFromTags(tags Tags) (*Game, error) {
+	// the moves are read by the variant rules (e.g. the crazyhouse fen without the pockets has the empty pockets or the horde has no king)
+	variant := engine.VariantStandard
+	if v, ok := tags.Get("Variant"); ok {
+		for vr, tag := range variantTags {
+			if strings.EqualFold(v, tag) {
+				variant = vr
+			}
+		}
+	}
+
+	fen := variant.StartingFEN()
+	if v, ok := tags.Get("FEN"); ok {
+		fen = v
+	}
+
+	g, err := NewGameVariant(fen, variant)
+	if err != nil {
+		return nil, err
+	}
+
+	g.Tags = tags
+
+	// the castle moves depend on the variant (O-O can be any king move in chess960)
+	if v, ok := tags.Get("Variant"); ok && isChess960Variant(v) {
+		g.Root.Position.Chess960 = true
+	}
+
+	return g, nil
+}
+
+func (r *Reader) readTags() (Tags, error) {
+	var tags Tags
+
+	for {
+		tkn, err := r.peek()
+		if err != nil {
+			return nil, err
+		}
+
+		if tkn.kind != tokenTagOpen {
+			return tags, nil
+		}
+
+		r.peeked = nil
+
+		name, err := r.expect(tokenSymbol, "tag name")
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := r.expect(tokenString, "tag value")
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := r.expect(tokenTagClose, "]"); err != nil {
+			return nil, err
+		}
+
+		tags.Set(name.value, value.value)
+	}
+}
+
+func (r *Reader) readMovetext(g *Game) error {
+	var (
+		current         = g.Root
+		variations      []*Node
+		startingComment string
+		variationStart  bool
+	)
+
+	for {
+		tkn, err := r.next()
+		if err != nil {
+			return err
+		}
+
+		switch tkn.kind {
+		case tokenEOF:
+			if len(variations) > 0 {
+				return fmt.Errorf("%w: line %d: unterminated variation", ErrSyntax, tkn.line)
+			}
+
+			return nil
+
+		case tokenTagOpen:
+			// the next game started without the game termination marker
+			if len(variations) > 0 {
+				return fmt.Errorf("%w: line %d: unterminated variation", ErrSyntax, tkn.line)
+			}
+
+			r.peeked = &tkn
+
+			return nil
+
+		case tokenComment:
+			switch {
+			case variationStart:
+				startingComment = joinComment(startingComment, tkn.value)
+			default:
+				comment, arrows, squares := parseComment(tkn.value)
+				current.Comment = joinComment(current.Comment, comment)
+				current.Arrows = append(current.Arrows, arrows...)
+				current.Squares = append(current.Squares, squares...)
+			}
+
+		case tokenNAG:
+			nag, err := strconv.Atoi(tkn.value)
+			if err != nil {
+				return fmt.Errorf("%w: line %d: invalid nag $%s", ErrSyntax, tkn.line, tkn.value)
+			}
+
+			current.NAGs = append(current.NAGs, nag)
+
+		case tokenVariationOpen:
+			if current.IsRoot() {
+				return fmt.Errorf("%w: line %d: variation without a move", ErrSyntax, tkn.line)
+			}
+
+			// the variation is an alternative to the last move so it starts from the same position
+			variations = append(variations, current)
+			current = current.Parent
+			variationStart = true
+
+		case tokenVariationClose:
+			if len(variations) == 0 {
+				return fmt.Errorf("%w: line %d: unexpected )", ErrSyntax, tkn.line)
+			}
+
+			current = variations[len(variations)-1]
+			variations = variations[:len(variations)-1]
+			variationStart = false
+			startingComment = ""
+
+		case tokenSymbol:
+			if isResult(tkn.value) {
+				if len(variations) > 0 {
+					return fmt.Errorf("%w: line %d: game termination inside a variation", ErrSyntax, tkn.line)
+				}
+
+				if result, ok := g.Tags.Get("Result"); !ok || !isResult(result) || result == ResultUnknown {
+					g.Tags.Set("Result", tkn.value)
+				}
+
+				return nil
+			}
+
+			if isMoveNumber(tkn.value) {
+				continue
+			}
+
+			if nag, ok := glyphNAGs[tkn.value]; ok {
+				current.NAGs = append(current.NAGs, nag)
+				continue
+			}
+
+			san, glyph := splitGlyph(tkn.value)
+
+			child, err := current.AddMoveSAN(san)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", tkn.line, err)
+			}
+
+			if nag, ok := glyphNAGs[glyph]; ok {
+				child.NAGs = append(child.NAGs, nag)
+			}
+
+			if variationStart {
+				child.StartingComment = startingComment
+				startingComment = ""
+				variationStart = false
+			}
+
+			current = child
+
+		default:
+			return fmt.Errorf("%w: line %d: unexpected token %q", ErrSyntax, tkn.line, tkn.value)
+		}
+	}
+}
+
+func (r *Reader) expect(kind tokenKind, what string) (token, error) {
+	tkn, err := r.next()
+	if err != nil {
+		return tkn, err
+	}
+
+	if tkn.kind != kind {
+		return tkn, fmt.Errorf("%w: line %d: expected %s, got %q", ErrSyntax, tkn.line, what, tkn.value)
+	}
+
+	return tkn, nil
+}
+
+func (r *Reader) peek() (token, error) {
+	if r.peeked != nil {
+		return *r.peeked, nil
+	}
+
+	tkn, err := r.scan()
+	if err != nil {
+		return tkn, err
+	}
+
+	r.peeked = &tkn
+
+	return tkn, nil
+}
+
+func (r *Reader) next() (token, error) {
+	if r.peeked != nil {
+		tkn := *r.peeked
+		r.peeked = nil
+
+		return tkn, nil
+	}
+
+	return r.scan()
+}
+
+func (r *Reader) readRune() (rune, error) {
+	ch, _, err := r.r.ReadRune()
+	if err != nil {
+		return 0, err
+	}
+
+	if ch == '\n' {
+		r.line++
+	}
+
+	r.prev, r.cur = r.cur, ch
+
+	return ch, nil
+}
+
+func (r *Reader) unreadRune(ch rune) {
+	_ = r.r.UnreadRune()
+
+	if ch == '\n' {
+		r.line--
+	}
+
+	r.cur = r.prev
+}
+
+// scan reads the next token from the input skipping the whitespace and the escaped `%` lines
+func (r *Reader) scan() (token, error) {
+	for {
+		ch, err := r.readRune()
+		if errors.Is(err, io.EOF) {
+			return token{kind: tokenEOF, line: r.line}, nil
+		}
+
+		if err != nil {
+			return token{}, err
+		}
+
+		switch {
+		case unicode.IsSpace(ch) || ch == '\uFEFF':
+			continue
+		case ch == '%' && (r.prev == 0 || r.prev == '\n'):
+			if _, err := r.readUntil('\n'); err != nil {
+				return token{}, err
+			}
+
+			continue
+		}
+
+		line := r.line
+
+		switch ch {
+		case '[':
+			return token{kind: tokenTagOpen, value: "[", line: line}, nil
+		case ']':
+			return token{kind: tokenTagClose, value: "]", line: line}, nil
+		case '(':
+			return token{kind: tokenVariationOpen, value: "(", line: line}, nil
+		case ')':
+			return token{kind: tokenVariationClose, value: ")", line: line}, nil
+		case '"':
+			return r.scanString(line)
+		case '{':
+			comment, err := r.readUntil('}')
+			if err != nil {
+				return token{}, fmt.Errorf("%w: line %d: unterminated comment", ErrSyntax, line)
+			}
+
+			return token{kind: tokenComment, value: strings.TrimSpace(comment), line: line}, nil
+		case ';':
+			comment, err := r.readUntil('\n')
+			if err != nil {
+				return token{}, err
+			}
+
+			return token{kind: tokenComment, value: strings.TrimSpace(comment), line: line}, nil
+		case '$':
+			return token{kind: tokenNAG, value: r.scanWhile(unicode.IsDigit), line: line}, nil
+		case '.':
+			// periods after the move numbers are skipped
+			continue
+		}
+
+		if isSymbolRune(ch) || ch == '!' || ch == '?' {
+			r.unreadRune(ch)
+
+			return r.scanSymbol(line), nil
+		}
+
+		return token{}, fmt.Errorf("%w: line %d: unexpected character %q", ErrSyntax, line, ch)
+	}
+}
+
+func (r *Reader) scanString(line int) (token, error) {
+	var sb strings.Builder
+
+	for {
+		ch, err := r.readRune()
+		if err != nil {
+			return token{}, fmt.Errorf("%w: line %d: unterminated string", ErrSyntax, line)
+		}
+
+		switch ch {
+		case '"':
+			return token{kind: tokenString, value: sb.String(), line: line}, nil
+		case '\\':
+			escaped, err := r.readRune()
+			if err != nil {
+				return token{}, fmt.Errorf("%w: line %d: unterminated string", ErrSyntax, line)
+			}
+
+			sb.WriteRune(escaped)
+		default:
+			sb.WriteRune(ch)
+		}
+	}
+}
+
+// scanSymbol reads the move numbers, san moves, tag names and game termination markers
+// the periods are kept in the symbols starting with a letter so the `e.p.` suffix stays a part of the move
+func (r *Reader) scanSymbol(line int) token {
+	var sb strings.Builder
+
+	for {
+		ch, err := r.readRune()
+		if err != nil {
+			break
+		}
+
+		if ch == '.' && (sb.Len() == 0 || !unicode.IsLetter(rune(sb.String()[0]))) {
+			r.unreadRune(ch)
+			break
+		}
+
+		if !isSymbolRune(ch) && ch != '.' && ch != '!' && ch != '?' {
+			r.unreadRune(ch)
+			break
+		}
+
+		sb.WriteRune(ch)
+	}
+
+	return token{kind: tokenSymbol, value: sb.String(), line: line}
+}
+
+func (r *Reader) scanWhile(fn func(rune) bool) string {
+	var sb strings.Builder
+
+	for {
+		ch, err := r.readRune()
+		if err != nil {
+			return sb.String()
+		}
+
+		if !fn(ch) {
+			r.unreadRune(ch)
+			return sb.String()
+		}
+
+		sb.WriteRune(ch)
+	}
+}
+
+// readUntil reads everything until the delimiter, the end of the input is a valid end for the new line delimiter
+func (r *Reader) readUntil(delim rune) (string, error) {
+	var sb strings.Builder
+
+	for {
+		ch, err := r.readRune()
+		if errors.Is(err, io.EOF) && delim == '\n' {
+			return sb.String(), nil
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		if ch == delim {
+			return sb.String(), nil
+		}
+
+		sb.WriteRune(ch)
+	}
+}
+
+func isSymbolRune(ch rune) bool {
+	return unicode.IsLetter(ch) || unicode.IsDigit(ch) || strings.ContainsRune("_+#=:-/*@", ch)
+}
+
+func isMoveNumber(s string) bool {
+	for _, ch := range s {
+		if !unicode.IsDigit(ch) {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+// splitGlyph splits the suffix annotation (e.g. `!?`) from the san move
+func splitGlyph(s string) (string, string) {
+	san := strings.TrimRight(s, "!?")
+
+	return san, s[len(san):]
+}
+
+func joinComment(existing, comment string) string {
+	if existing == "" {
+		return comment
+	}
+
+	return existing + " " + comment
+}
+
+func isChess960Variant(variant string) bool {
+	switch strings.ToLower(variant) {
+	case "chess960", "chess 960", "fischerandom", "fischer random":
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,215 @@
+package pgn
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const multiGamePGN = `[Event "F/S Return Match"]
+[Site "Belgrade, Serbia JUG"]
+[Date "1992.11.04"]
+[Round "29"]
+[White "Fischer, Robert J."]
+[Black "Spassky, Boris V."]
+[Result "1/2-1/2"]
+
+1. e4 e5 2. Nf3 Nc6 3. Bb5 a6 {This opening is called the Ruy Lopez.} 4. Ba4 Nf6 5. O-O Be7 6. Re1 b5 7. Bb3 d6 8. c3 O-O 9. h3 Nb8 10. d4 Nbd7
+11. c4 c6 12. cxb5 axb5 13. Nc3 Bb7 14. Bg5 b4 15. Nb1 h6 16. Bh4 c5 17. dxe5
+Nxe4 18. Bxe7 Qxe7 19. exd6 Qf6 20. Nbd2 Nxd6 21. Nc4 Nxc4 22. Bxc4 Nb6
+23. Ne5 Rae8 24. Bxf7+ Rxf7 25. Nxf7 Rxe1+ 26. Qxe1 Kxf7 27. Qe3 Qg5 28. Qxg5
+hxg5 29. b3 Ke6 30. a3 Kd6 31. axb4 cxb4 32. Ra5 Nd5 33. f3 Bc8 34. Kf2 Bf5
+35. Ra7 g6 36. Ra6+ Kc5 37. Ke1 Nf4 38. g3 Nxh3 39. Kd2 Kb5 40. Rd6 Kc5 41. Ra6
+Nf2 42. g4 Bd3 43. Re6 1/2-1/2
+
+% escaped line that is ignored
+[Event "Variations"]
+[Site "?"]
+[Date "????.??.??"]
+[Round "?"]
+[White "?"]
+[Black "?"]
+[Result "*"]
+[Annotator "juicer"]
+
+{Game comment} 1. e4 $1 e5 (1... c5 {Sicilian} 2. Nf3 (2. c3!? d5) 2... d6) (1... e6) 2. Nf3!! ; rest of line comment
+Nc6 *
+
+[Event "No result token"]
+
+1.d4 d5 2.c4 dxc4 3.e3 b5 4.a4 c6 5.axb5 cxb5 6.Qf3
+`
+
+func TestReadAll(t *testing.T) {
+	games, err := NewReader(strings.NewReader(multiGamePGN)).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read games: %v", err)
+	}
+
+	if len(games) != 3 {
+		t.Fatalf("invalid games count: want %d, got %d", 3, len(games))
+	}
+
+	fischer := games[0]
+
+	if white, _ := fischer.Tags.Get("White"); white != "Fischer, Robert J." {
+		t.Fatalf("invalid white tag: want %s, got %s", "Fischer, Robert J.", white)
+	}
+
+	if fischer.Result() != ResultDraw {
+		t.Fatalf("invalid result: want %s, got %s", ResultDraw, fischer.Result())
+	}
+
+	mainLine := fischer.MainLine()
+	if len(mainLine) != 85 {
+		t.Fatalf("invalid main line length: want %d, got %d", 85, len(mainLine))
+	}
+
+	if mainLine[5].Comment != "This opening is called the Ruy Lopez." {
+		t.Fatalf("invalid comment: want %q, got %q", "This opening is called the Ruy Lopez.", mainLine[5].Comment)
+	}
+
+	if want, got := "8/8/4R1p1/2k3p1/1p4P1/1P1b1P2/3K1n2/8 b - - 2 43", mainLine[len(mainLine)-1].Position.Fen(); want != got {
+		t.Fatalf("invalid final fen: want %s, got %s", want, got)
+	}
+
+	variations := games[1]
+
+	if variations.Root.Comment != "Game comment" {
+		t.Fatalf("invalid root comment: want %q, got %q", "Game comment", variations.Root.Comment)
+	}
+
+	e4 := variations.Root.Next()
+	if !slices.Equal(e4.NAGs, []int{1}) {
+		t.Fatalf("invalid nags: want %v, got %v", []int{1}, e4.NAGs)
+	}
+
+	if len(e4.Children) != 3 {
+		t.Fatalf("invalid variations count: want %d, got %d", 3, len(e4.Children))
+	}
+
+	sicilian := e4.Variations()[0]
+	if sicilian.SAN != "c5" || sicilian.Comment != "Sicilian" {
+		t.Fatalf("invalid variation: want c5 {Sicilian}, got %s {%s}", sicilian.SAN, sicilian.Comment)
+	}
+
+	alapin := sicilian.Variations()[0]
+	if alapin.SAN != "c3" || !slices.Equal(alapin.NAGs, []int{5}) {
+		t.Fatalf("invalid nested variation: want c3 $5, got %s %v", alapin.SAN, alapin.NAGs)
+	}
+
+	nf3 := e4.Next().Next()
+	if nf3.Comment != "rest of line comment" || !slices.Equal(nf3.NAGs, []int{3}) {
+		t.Fatalf("invalid move annotations: got %q %v", nf3.Comment, nf3.NAGs)
+	}
+
+	if annotator, _ := variations.Tags.Get("Annotator"); annotator != "juicer" {
+		t.Fatalf("invalid annotator tag: want %s, got %s", "juicer", annotator)
+	}
+
+	noResult := games[2]
+
+	if noResult.Result() != ResultUnknown {
+		t.Fatalf("invalid result: want %s, got %s", ResultUnknown, noResult.Result())
+	}
+
+	if len(noResult.MainLine()) != 11 {
+		t.Fatalf("invalid main line length: want %d, got %d", 11, len(noResult.MainLine()))
+	}
+}
+
+func TestReadFromFEN(t *testing.T) {
+	g, err := Parse(`[SetUp "1"]
+[FEN "8/4P3/8/8/8/8/k7/4K3 w - - 0 1"]
+
+1. e8=Q Kb2 1-0`)
+	if err != nil {
+		t.Fatalf("failed to parse game: %v", err)
+	}
+
+	if want, got := "4Q3/8/8/8/8/8/1k6/4K3 w - - 1 2", g.MainLine()[1].Position.Fen(); want != got {
+		t.Fatalf("invalid fen: want %s, got %s", want, got)
+	}
+
+	if g.Result() != ResultWhiteWon {
+		t.Fatalf("invalid result: want %s, got %s", ResultWhiteWon, g.Result())
+	}
+}
+
+func TestReadChess960(t *testing.T) {
+	g, err := Parse(`[Variant "Chess960"]
+[SetUp "1"]
+[FEN "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1"]
+
+1. O-O O-O-O *`)
+	if err != nil {
+		t.Fatalf("failed to parse game: %v", err)
+	}
+
+	mainLine := g.MainLine()
+
+	if want, got := "e1h1", mainLine[0].Move.ToUCI(); want != got {
+		t.Fatalf("invalid castle move: want %s, got %s", want, got)
+	}
+
+	if want, got := "2kr3r/8/8/8/8/8/8/R4RK1 w - - 2 2", mainLine[1].Position.Fen(); want != got {
+		t.Fatalf("invalid fen: want %s, got %s", want, got)
+	}
+}
+
+func TestReadCrazyhouse(t *testing.T) {
+	g, err := Parse(`[Variant "Crazyhouse"]
+
+1. e4 d5 2. exd5 Qxd5 3. P@e4 *`)
+	if err != nil {
+		t.Fatalf("failed to parse game: %v", err)
+	}
+
+	mainLine := g.MainLine()
+
+	if want, got := "P@e4", mainLine[4].Move.ToUCI(); want != got {
+		t.Fatalf("invalid drop move: want %s, got %s", want, got)
+	}
+
+	if want, got := "rnb1kbnr/ppp1pppp/8/3q4/4P3/8/PPPP1PPP/RNBQKBNR[p] b KQkq - 0 3", mainLine[4].Position.Fen(); want != got {
+		t.Fatalf("invalid fen: want %s, got %s", want, got)
+	}
+}
+
+func TestReadHorde(t *testing.T) {
+	g, err := Parse(`[Variant "Horde"]
+
+1. a5 e6 *`)
+	if err != nil {
+		t.Fatalf("failed to parse game: %v", err)
+	}
+
+	mainLine := g.MainLine()
+
+	if want, got := "rnbqkbnr/pppp1ppp/4p3/PPP2PP1/1PPPPPPP/PPPPPPPP/PPPPPPPP/PPPPPPPP w kq - 0 2", mainLine[len(mainLine)-1].Position.Fen(); want != got {
+		t.Fatalf("invalid fen: want %s, got %s", want, got)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	testCases := map[string]struct {
+		pgn     string
+		wantErr error
+	}{
+		"illegal move":             {pgn: "1. e4 e4 *", wantErr: ErrIllegalMove},
+		"unterminated comment":     {pgn: "1. e4 {comment *", wantErr: ErrSyntax},
+		"unterminated variation":   {pgn: "1. e4 (1. d4 *", wantErr: ErrSyntax},
+		"unexpected variation end": {pgn: "1. e4 ) *", wantErr: ErrSyntax},
+		"variation without move":   {pgn: "(1. e4) *", wantErr: ErrSyntax},
+		"invalid tag":              {pgn: "[Event]\n\n*", wantErr: ErrSyntax},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Parse(tc.pgn); !errors.Is(err, tc.wantErr) {
+				t.Fatalf("invalid error: want %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package pgn
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+var ErrRootNode = errors.New("pgn root node")
+
+// MarkColor is the color of the arrows and the squares drawn on the board
+type MarkColor uint8
+
+const (
+	MarkGreen MarkColor = iota
+	MarkRed
+	MarkYellow
+	MarkBlue
+)
+
+// markColorChars are the color letters of the `[%cal]` and `[%csl]` comment commands
+const markColorChars = "GRYB"
+
+var markColorNames = [...]string{
+	MarkGreen:  "green",
+	MarkRed:    "red",
+	MarkYellow: "yellow",
+	MarkBlue:   "blue",
+}
+
+func (mc MarkColor) String() string {
+	if int(mc) >= len(markColorNames) {
+		return ""
+	}
+
+	return markColorNames[mc]
+}
+
+// char returns the color letter of the comment commands, the unknown colors are written as green
+func (mc MarkColor) char() string {
+	if int(mc) >= len(markColorChars) {
+		return markColorChars[:1]
+	}
+
+	return markColorChars[mc : mc+1]
+}
+
+func parseMarkColor(s string) (MarkColor, bool) {
+	for i, name := range markColorNames {
+		if s == name {
+			return MarkColor(i), true
+		}
+	}
+
+	return 0, false
+}
+
+// Arrow is the arrow drawn from the square to the square
+type Arrow struct {
+	From  engine.Square
+	To    engine.Square
+	Color MarkColor
+}
+
+// SquareMark is the highlighted square
+type SquareMark struct {
+	Square engine.Square
+	Color  MarkColor
+}
+
+// Ply returns the number of the moves from the root to the node
+func (n *Node) Ply() int {
+	ply := 0
+	for p := n; p.Parent != nil; p = p.Parent {
+		ply++
+	}
+
+	return ply
+}
+
+// FEN returns the fen of the position after the move
+func (n *Node) FEN() string {
+	return n.Position.Fen()
+}
+
+// Hash returns the zobrist hash of the position after the move
+func (n *Node) Hash() uint64 {
+	return n.Position.Hash
+}
+
+// Path returns the nodes from the first move to the node
+func (n *Node) Path() []*Node {
+	var path []*Node
+	for p := n; p.Parent != nil; p = p.Parent {
+		path = append(path, p)
+	}
+
+	slices.Reverse(path)
+
+	return path
+}
+
+// AddMoveUCI parses the uci move in the node position and adds it as the last child
+func (n *Node) AddMoveUCI(uci string) (*Node, error) {
+	legalMoves := n.Position.LegalMoves()
+
+	for _, m := range legalMoves {
+		if m.String() == uci {
+			return n.addMove(m, legalMoves), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrIllegalMove, uci)
+}
+
+// Branch plays the move from the node, the existing child with the same move is returned instead of
+// adding it twice, otherwise the move starts the new variation (or the main line if there is none)
+func (n *Node) Branch(m engine.Move) (*Node, error) {
+	if child := n.child(m); child != nil {
+		return child, nil
+	}
+
+	return n.AddMove(m)
+}
+
+// BranchSAN is the Branch with the san move
+func (n *Node) BranchSAN(san string) (*Node, error) {
+	m, err := engine.ParseSAN(san, n.Position.LegalMoves())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrIllegalMove, err)
+	}
+
+	return n.Branch(m)
+}
+
+func (n *Node) child(m engine.Move) *Node {
+	for _, child := range n.Children {
+		if child.Move == m {
+			return child
+		}
+	}
+
+	return nil
+}
+
+// Promote moves the variation one place up among its siblings, the first variation becomes the main line
+func (n *Node) Promote() error {
+	if n.IsRoot() {
+		return ErrRootNode
+	}
+
+	siblings := n.Parent.Children
+	if i := slices.Index(siblings, n); i > 0 {
+		siblings[i-1], siblings[i] = siblings[i], siblings[i-1]
+	}
+
+	return nil
+}
+
+// PromoteToMainLine makes the line leading to the node the main line of the game
+func (n *Node) PromoteToMainLine() error {
+	if n.IsRoot() {
+		return ErrRootNode
+	}
+
+	for p := n; p.Parent != nil; p = p.Parent {
+		siblings := p.Parent.Children
+		if i := slices.Index(siblings, p); i > 0 {
+			copy(siblings[1:i+1], siblings[:i])
+			siblings[0] = p
+		}
+	}
+
+	return nil
+}
+
+// Delete removes the node with all the moves after it, the parent is returned to continue from
+func (n *Node) Delete() (*Node, error) {
+	if n.IsRoot() {
+		return nil, ErrRootNode
+	}
+
+	parent := n.Parent
+	parent.Children = slices.DeleteFunc(parent.Children, func(child *Node) bool { return child == n })
+	n.Parent = nil
+
+	return parent, nil
+}
+
+// GotoPly returns the node at the ply of the node's line, the earlier plies are its ancestors
+// and the later ones follow the main continuation of the node
+func (n *Node) GotoPly(ply int) (*Node, bool) {
+	if ply < 0 {
+		return nil, false
+	}
+
+	node := n
+	current := n.Ply()
+
+	for ; current > ply; current-- {
+		node = node.Parent
+	}
+
+	for ; current < ply; current++ {
+		if node = node.Next(); node == nil {
+			return nil, false
+		}
+	}
+
+	return node, true
+}
+
+// GotoPly returns the main line node at the ply, the ply 0 is the root node
+func (g *Game) GotoPly(ply int) (*Node, bool) {
+	return g.Root.GotoPly(ply)
+}
+
+var reMarkCommand = regexp.MustCompile(`\[%(cal|csl)\s+([^\]]*)\]`)
+
+// parseComment extracts the `[%cal Ge2e4]` arrows and the `[%csl Rd5]` squares from the comment text,
+// the invalid marks are dropped
+func parseComment(comment string) (string, []Arrow, []SquareMark) {
+	matches := reMarkCommand.FindAllStringSubmatch(comment, -1)
+	if matches == nil {
+		return comment, nil, nil
+	}
+
+	var (
+		arrows  []Arrow
+		squares []SquareMark
+	)
+
+	for _, match := range matches {
+		for mark := range strings.SplitSeq(match[2], ",") {
+			mark = strings.TrimSpace(mark)
+
+			switch {
+			case match[1] == "cal" && len(mark) == 5:
+				color, from, to := strings.IndexByte(markColorChars, mark[0]), parseSquare(mark[1:3]), parseSquare(mark[3:5])
+				if color >= 0 && from != engine.SquareNone && to != engine.SquareNone {
+					arrows = append(arrows, Arrow{From: from, To: to, Color: MarkColor(color)})
+				}
+			case match[1] == "csl" && len(mark) == 3:
+				color, sq := strings.IndexByte(markColorChars, mark[0]), parseSquare(mark[1:3])
+				if color >= 0 && sq != engine.SquareNone {
+					squares = append(squares, SquareMark{Square: sq, Color: MarkColor(color)})
+				}
+			}
+		}
+	}
+
+	text := strings.Join(strings.Fields(reMarkCommand.ReplaceAllString(comment, "")), " ")
+
+	return text, arrows, squares
+}
+
+// formatComment writes the squares and the arrows as the comment commands before the comment text
+func formatComment(comment string, arrows []Arrow, squares []SquareMark) string {
+	var parts []string
+
+	if len(squares) > 0 {
+		marks := make([]string, len(squares))
+		for i, sm := range squares {
+			marks[i] = sm.Color.char() + sm.Square.String()
+		}
+
+		parts = append(parts, "[%csl "+strings.Join(marks, ",")+"]")
+	}
+
+	if len(arrows) > 0 {
+		marks := make([]string, len(arrows))
+		for i, a := range arrows {
+			marks[i] = a.Color.char() + a.From.String() + a.To.String()
+		}
+
+		parts = append(parts, "[%cal "+strings.Join(marks, ",")+"]")
+	}
+
+	if comment != "" {
+		parts = append(parts, comment)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func parseSquare(coord string) engine.Square {
+	sq, err := engine.NewSquareFromCoord(coord)
+	if err != nil {
+		return engine.SquareNone
+	}
+
+	return sq
+}
@@ -0,0 +1,279 @@
+package pgn
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+const treeInput = `1. e4 e5 (1... c5 2. Nf3 (2. c3 d5) 2... d6) (1... e6) 2. Nf3 Nc6 *`
+
+// movetext returns the pgn without the tags on a single line
+func movetext(t *testing.T, g *Game) string {
+	t.Helper()
+
+	_, moves, ok := strings.Cut(g.String(), "\n\n")
+	if !ok {
+		t.Fatalf("invalid pgn: missing movetext")
+	}
+
+	return strings.ReplaceAll(strings.TrimSpace(moves), "\n", " ")
+}
+
+func parseTree(t *testing.T) *Game {
+	t.Helper()
+
+	g, err := Parse(treeInput)
+	if err != nil {
+		t.Fatalf("failed to parse game: %v", err)
+	}
+
+	return g
+}
+
+func TestBranch(t *testing.T) {
+	g := parseTree(t)
+
+	e4, _ := g.GotoPly(1)
+
+	existing, err := e4.BranchSAN("c5")
+	if err != nil {
+		t.Fatalf("failed to branch: %v", err)
+	}
+
+	if existing != e4.Children[1] || len(e4.Children) != 3 {
+		t.Fatalf("invalid branch: want the existing c5 node, got %s with %d children", existing.SAN, len(e4.Children))
+	}
+
+	d5, err := e4.BranchSAN("d5")
+	if err != nil {
+		t.Fatalf("failed to branch: %v", err)
+	}
+
+	if _, err := d5.BranchSAN("exd5"); err != nil {
+		t.Fatalf("failed to branch: %v", err)
+	}
+
+	want := "1. e4 e5 (1... c5 2. Nf3 (2. c3 d5) 2... d6) (1... e6) (1... d5 2. exd5) 2. Nf3 Nc6 *"
+	if got := movetext(t, g); got != want {
+		t.Fatalf("invalid movetext: want %q, got %q", want, got)
+	}
+
+	if _, err := e4.BranchSAN("Ke2"); !errors.Is(err, ErrIllegalMove) {
+		t.Fatalf("invalid branch error: want %v, got %v", ErrIllegalMove, err)
+	}
+}
+
+func TestPromote(t *testing.T) {
+	testCases := map[string]struct {
+		path    []int
+		promote func(n *Node) error
+		want    string
+	}{
+		"promote the second variation": {
+			path:    []int{0, 2},
+			promote: (*Node).Promote,
+			want:    "1. e4 e5 (1... e6) (1... c5 2. Nf3 (2. c3 d5) 2... d6) 2. Nf3 Nc6 *",
+		},
+		"promote the first variation": {
+			path:    []int{0, 1},
+			promote: (*Node).Promote,
+			want:    "1. e4 c5 (1... e5 2. Nf3 Nc6) (1... e6) 2. Nf3 (2. c3 d5) 2... d6 *",
+		},
+		"promote the main line": {
+			path:    []int{0, 0},
+			promote: (*Node).Promote,
+			want:    "1. e4 e5 (1... c5 2. Nf3 (2. c3 d5) 2... d6) (1... e6) 2. Nf3 Nc6 *",
+		},
+		"promote the nested variation to the main line": {
+			path:    []int{0, 1, 1, 0},
+			promote: (*Node).PromoteToMainLine,
+			want:    "1. e4 c5 (1... e5 2. Nf3 Nc6) (1... e6) 2. c3 (2. Nf3 d6) 2... d5 *",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			g := parseTree(t)
+
+			n := g.Root
+			for _, i := range tc.path {
+				n = n.Children[i]
+			}
+
+			if err := tc.promote(n); err != nil {
+				t.Fatalf("failed to promote: %v", err)
+			}
+
+			if got := movetext(t, g); got != tc.want {
+				t.Fatalf("invalid movetext: want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	g := parseTree(t)
+
+	c5 := g.Root.Children[0].Children[1]
+
+	parent, err := c5.Children[0].Delete()
+	if err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+
+	if parent != c5 {
+		t.Fatalf("invalid parent: want %s, got %s", c5.SAN, parent.SAN)
+	}
+
+	want := "1. e4 e5 (1... c5 2. c3 d5) (1... e6) 2. Nf3 Nc6 *"
+	if got := movetext(t, g); got != want {
+		t.Fatalf("invalid movetext: want %q, got %q", want, got)
+	}
+
+	if _, err := g.Root.Delete(); !errors.Is(err, ErrRootNode) {
+		t.Fatalf("invalid delete error: want %v, got %v", ErrRootNode, err)
+	}
+}
+
+func TestGotoPly(t *testing.T) {
+	g := parseTree(t)
+
+	// 1. e4 c5 2. c3
+	c3 := g.Root.Children[0].Children[1].Children[1]
+
+	testCases := map[string]struct {
+		from *Node
+		ply  int
+		want string
+		ok   bool
+	}{
+		"main line start":           {from: g.Root, ply: 0, want: "", ok: true},
+		"main line end":             {from: g.Root, ply: 4, want: "Nc6", ok: true},
+		"main line past the end":    {from: g.Root, ply: 5, ok: false},
+		"negative ply":              {from: g.Root, ply: -1, ok: false},
+		"variation ancestor":        {from: c3, ply: 2, want: "c5", ok: true},
+		"variation continuation":    {from: c3, ply: 4, want: "d5", ok: true},
+		"variation past the end":    {from: c3, ply: 5, ok: false},
+		"variation current ply":     {from: c3, ply: 3, want: "c3", ok: true},
+		"variation to the root ply": {from: c3, ply: 0, want: "", ok: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			n, ok := tc.from.GotoPly(tc.ply)
+			if ok != tc.ok {
+				t.Fatalf("invalid found: want %v, got %v", tc.ok, ok)
+			}
+
+			if ok && n.SAN != tc.want {
+				t.Fatalf("invalid node: want %q, got %q", tc.want, n.SAN)
+			}
+		})
+	}
+
+	if got := c3.Ply(); got != 3 {
+		t.Fatalf("invalid ply: want 3, got %d", got)
+	}
+
+	path := make([]string, 0, 3)
+	for _, n := range c3.Path() {
+		path = append(path, n.SAN)
+	}
+
+	if want := []string{"e4", "c5", "c3"}; !slices.Equal(path, want) {
+		t.Fatalf("invalid path: want %v, got %v", want, path)
+	}
+}
+
+func TestCommentMarks(t *testing.T) {
+	input := `1. e4 { [%csl Gd5,Rf7] Center [%cal Gg1f3,Bb1c3] } e5 { [%cal Yd8h4,Xa1a2] } *`
+
+	g, err := Parse(input)
+	if err != nil {
+		t.Fatalf("failed to parse game: %v", err)
+	}
+
+	e4, e5 := g.Root.Children[0], g.Root.Children[0].Children[0]
+
+	if e4.Comment != "Center" {
+		t.Fatalf("invalid comment: want %q, got %q", "Center", e4.Comment)
+	}
+
+	wantArrows := []Arrow{{From: engine.G1, To: engine.F3, Color: MarkGreen}, {From: engine.B1, To: engine.C3, Color: MarkBlue}}
+	if !slices.Equal(e4.Arrows, wantArrows) {
+		t.Fatalf("invalid arrows: want %v, got %v", wantArrows, e4.Arrows)
+	}
+
+	wantSquares := []SquareMark{{Square: engine.D5, Color: MarkGreen}, {Square: engine.F7, Color: MarkRed}}
+	if !slices.Equal(e4.Squares, wantSquares) {
+		t.Fatalf("invalid squares: want %v, got %v", wantSquares, e4.Squares)
+	}
+
+	if want := []Arrow{{From: engine.D8, To: engine.H4, Color: MarkYellow}}; e5.Comment != "" || !slices.Equal(e5.Arrows, want) {
+		t.Fatalf("invalid marks: want %v without the comment, got %v with %q", want, e5.Arrows, e5.Comment)
+	}
+
+	want := "1. e4 { [%csl Gd5,Rf7] [%cal Gg1f3,Bb1c3] Center } 1... e5 { [%cal Yd8h4] } *"
+	if got := movetext(t, g); got != want {
+		t.Fatalf("invalid movetext: want %q, got %q", want, got)
+	}
+}
+
+func TestGameJSON(t *testing.T) {
+	g := parseTree(t)
+	g.Tags.Set("Event", "Study")
+
+	e4 := g.Root.Children[0]
+	e4.Comment = "Best by test"
+	e4.NAGs = []int{1}
+	e4.Arrows = []Arrow{{From: engine.E7, To: engine.E5, Color: MarkRed}}
+	e4.Squares = []SquareMark{{Square: engine.D5, Color: MarkYellow}}
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("failed to marshal game: %v", err)
+	}
+
+	var decoded Game
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("failed to unmarshal game: %v", err)
+	}
+
+	if want, got := g.String(), decoded.String(); got != want {
+		t.Fatalf("invalid json round trip: want\n%s\ngot\n%s", want, got)
+	}
+
+	var tree struct {
+		Root struct {
+			Children []struct {
+				Ply  int    `json:"ply"`
+				Move string `json:"move"`
+				FEN  string `json:"fen"`
+				Hash string `json:"hash"`
+			} `json:"children"`
+		} `json:"root"`
+	}
+
+	if err := json.Unmarshal(data, &tree); err != nil {
+		t.Fatalf("failed to unmarshal tree: %v", err)
+	}
+
+	first := tree.Root.Children[0]
+	if first.Ply != 1 || first.Move != "e2e4" || first.FEN != e4.FEN() || first.Hash == "" {
+		t.Fatalf("invalid node json: got %+v", first)
+	}
+}
+
+func TestGameJSONIllegalMove(t *testing.T) {
+	data := `{"tags":[],"root":{"ply":0,"fen":"","hash":"","children":[{"ply":1,"move":"e2e5"}]}}`
+
+	var g Game
+	if err := json.Unmarshal([]byte(data), &g); !errors.Is(err, ErrIllegalMove) {
+		t.Fatalf("invalid unmarshal error: want %v, got %v", ErrIllegalMove, err)
+	}
+}
@@ -0,0 +1,195 @@
+package pgn
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// maxLineLength is the export format limit for the movetext lines
+const maxLineLength = 80
+
+// Writer writes the games in the pgn export format
+type Writer struct {
+	w       *bufio.Writer
+	written bool
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: bufio.NewWriter(w)}
+}
+
+// Write writes the game and flushes the underlying writer, games are separated with the empty line
+func (w *Writer) Write(g *Game) error {
+	if w.written {
+		if _, err := w.w.WriteString("\n"); err != nil {
+			return err
+		}
+	}
+
+	if _, err := w.w.WriteString(g.String()); err != nil {
+		return err
+	}
+
+	w.written = true
+
+	return w.w.Flush()
+}
+
+// String returns the game in the pgn export format
+func (g *Game) String() string {
+	var sb strings.Builder
+
+	writeTags(&sb, g)
+	sb.WriteString("\n")
+
+	mb := &movetextBuilder{}
+	mb.writeGame(g)
+	mb.add(g.Result())
+
+	sb.WriteString(mb.wrap())
+
+	return sb.String()
+}
+
+// writeTags writes the seven tag roster first and then the rest of the tags in the order they were added
+func writeTags(sb *strings.Builder, g *Game) {
+	for _, name := range SevenTagRoster {
+		value, ok := g.Tags.Get(name)
+		if !ok {
+			value = sevenTagRosterDefaults[name]
+		}
+
+		if name == "Result" {
+			value = g.Result()
+		}
+
+		writeTag(sb, name, value)
+	}
+
+	for _, tag := range g.Tags {
+		if !slices.Contains(SevenTagRoster, tag.Name) {
+			writeTag(sb, tag.Name, tag.Value)
+		}
+	}
+}
+
+func writeTag(sb *strings.Builder, name, value string) {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+
+	fmt.Fprintf(sb, "[%s \"%s\"]\n", name, value)
+}
+
+type movetextBuilder struct {
+	tokens []string
+}
+
+func (mb *movetextBuilder) add(tkn string) {
+	mb.tokens = append(mb.tokens, tkn)
+}
+
+func (mb *movetextBuilder) writeGame(g *Game) {
+	forceNumber := false
+
+	if g.Root.hasComment() {
+		mb.addComment(g.Root.formatComment())
+		forceNumber = true
+	}
+
+	mb.writeLine(g.Root, forceNumber)
+}
+
+// writeLine writes the main line continuation of the node with the variations written right after the move they replace
+func (mb *movetextBuilder) writeLine(node *Node, forceNumber bool) {
+	for ; len(node.Children) > 0; node = node.Children[0] {
+		main := node.Children[0]
+
+		mb.writeNode(main, forceNumber)
+		forceNumber = main.hasComment()
+
+		for _, variation := range node.Children[1:] {
+			mb.add("(")
+			mb.writeNode(variation, true)
+			mb.writeLine(variation, variation.hasComment())
+			mb.add(")")
+
+			forceNumber = true
+		}
+	}
+}
+
+// writeNode writes the move with the move number, black moves get the `N...` number only when forced
+func (mb *movetextBuilder) writeNode(n *Node, forceNumber bool) {
+	if n.StartingComment != "" {
+		mb.addComment(n.StartingComment)
+		forceNumber = true
+	}
+
+	parent := n.Parent.Position
+
+	switch {
+	case parent.Turn.IsWhite():
+		mb.add(strconv.Itoa(int(parent.FullMoveClock)) + ". " + n.SAN)
+	case forceNumber:
+		mb.add(strconv.Itoa(int(parent.FullMoveClock)) + "... " + n.SAN)
+	default:
+		mb.add(n.SAN)
+	}
+
+	for _, nag := range n.NAGs {
+		mb.add("$" + strconv.Itoa(nag))
+	}
+
+	if n.hasComment() {
+		mb.addComment(n.formatComment())
+	}
+}
+
+// hasComment checks if the node has the comment text or the board marks written in the comment
+func (n *Node) hasComment() bool {
+	return n.Comment != "" || len(n.Arrows) > 0 || len(n.Squares) > 0
+}
+
+func (n *Node) formatComment() string {
+	return formatComment(n.Comment, n.Arrows, n.Squares)
+}
+
+func (mb *movetextBuilder) addComment(comment string) {
+	mb.add("{ " + strings.ReplaceAll(comment, "}", "") + " }")
+}
+
+// wrap joins the tokens with spaces and breaks the lines before they exceed the max line length
+// the variation parens stick to the first and the last token of the variation
+func (mb *movetextBuilder) wrap() string {
+	var (
+		sb   strings.Builder
+		line strings.Builder
+	)
+
+	for i, tkn := range mb.tokens {
+		sep := " "
+		if i == 0 || tkn == ")" || mb.tokens[i-1] == "(" {
+			sep = ""
+		}
+
+		if line.Len() > 0 && line.Len()+len(sep)+len(tkn) > maxLineLength {
+			sb.WriteString(line.String())
+			sb.WriteString("\n")
+			line.Reset()
+
+			sep = ""
+		}
+
+		line.WriteString(sep)
+		line.WriteString(tkn)
+	}
+
+	sb.WriteString(line.String())
+	sb.WriteString("\n")
+
+	return sb.String()
+}
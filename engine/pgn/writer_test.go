@@ -0,0 +1,72 @@
+package pgn
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+func TestGameString(t *testing.T) {
+	input := `[Event "Variations"]
+[Annotator "juicer"]
+[White "Alice \"The Rook\""]
+
+{Game comment} 1. e4 $1 e5 (1... c5 {Sicilian} 2. Nf3 (2. c3!? d5) 2... d6) (1... e6) 2. Nf3!! {Knight} Nc6 1-0`
+
+	want := `[Event "Variations"]
+[Site "?"]
+[Date "????.??.??"]
+[Round "?"]
+[White "Alice \"The Rook\""]
+[Black "?"]
+[Result "1-0"]
+[Annotator "juicer"]
+
+{ Game comment } 1. e4 $1 e5 (1... c5 { Sicilian } 2. Nf3 (2. c3 $5 d5) 2... d6)
+(1... e6) 2. Nf3 $3 { Knight } 2... Nc6 1-0
+`
+
+	g, err := Parse(input)
+	if err != nil {
+		t.Fatalf("failed to parse game: %v", err)
+	}
+
+	if got := g.String(); got != want {
+		t.Fatalf("invalid pgn: want\n%s\ngot\n%s", want, got)
+	}
+
+	reparsed, err := Parse(g.String())
+	if err != nil {
+		t.Fatalf("failed to parse written game: %v", err)
+	}
+
+	if got := reparsed.String(); got != want {
+		t.Fatalf("invalid pgn round trip: want\n%s\ngot\n%s", want, got)
+	}
+}
+
+func TestFromChess(t *testing.T) {
+	c, err := engine.NewChess(engine.FENStartingPosition)
+	if err != nil {
+		t.Fatalf("failed to create chess: %v", err)
+	}
+
+	for _, uci := range []string{"f2f3", "e7e5", "g2g4", "d8h4"} {
+		if _, err := c.MakeMoveUCI(uci); err != nil {
+			t.Fatalf("failed to make move: %v", err)
+		}
+	}
+
+	g := FromChess(c)
+	g.Tags.Set("Result", ResultBlackWon)
+
+	var sb strings.Builder
+	if err := NewWriter(&sb).Write(g); err != nil {
+		t.Fatalf("failed to write game: %v", err)
+	}
+
+	if want := "1. f3 e5 2. g4 Qh4# 0-1\n"; !strings.HasSuffix(sb.String(), want) {
+		t.Fatalf("invalid movetext: want suffix %q, got %q", want, sb.String())
+	}
+}
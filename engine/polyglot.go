@@ -0,0 +1,57 @@
+package engine
+
+const (
+	polyglotCastleOffset    = 768
+	polyglotEnPassantOffset = 772
+	polyglotTurnOffset      = 780
+)
+
+// polyglotPieceKinds are the piece kinds in the polyglot order, the black piece comes before the white one of the same kind
+var polyglotPieceKinds = [6]int{
+	Pawn:   0,
+	Knight: 1,
+	Bishop: 2,
+	Rook:   3,
+	Queen:  4,
+	King:   5,
+}
+
+// polyglotCastleRights are the castle rights in the order of the polyglot castle keys
+var polyglotCastleRights = [4]CastleRights{WhiteKingSideCastle, WhiteQueenSideCastle, BlackKingSideCastle, BlackQueenSideCastle}
+
+// PolyglotKey computes the key of the position in the polyglot opening books, it is independent of the internal zobrist hash
+// the en-passant file is hashed only when the pawn of the side to move can capture there
+func (p *Position) PolyglotKey() uint64 {
+	var key uint64
+
+	for _, color := range colors {
+		for _, kind := range pieceKinds {
+			pieceIndex := 2 * polyglotPieceKinds[kind]
+			if color.IsWhite() {
+				pieceIndex++
+			}
+
+			pieces := p.Board.pieceOccupancies[color][kind]
+			for pieces > 0 {
+				sq := pieces.PopLS1B()
+				key ^= polyglotRandom[64*pieceIndex+int(sq)]
+			}
+		}
+	}
+
+	for i, cr := range polyglotCastleRights {
+		if p.CastleRights&cr != 0 {
+			key ^= polyglotRandom[polyglotCastleOffset+i]
+		}
+	}
+
+	if p.EpSquare != SquareNone && pawnAttacksMask[p.Turn.Opposite()][p.EpSquare]&p.Board.pieceOccupancies[p.Turn][Pawn] != 0 {
+		key ^= polyglotRandom[polyglotEnPassantOffset+int(p.EpSquare.File())]
+	}
+
+	if p.Turn.IsWhite() {
+		key ^= polyglotRandom[polyglotTurnOffset]
+	}
+
+	return key
+}
@@ -6,10 +6,20 @@ import (
 )
 
 type Position struct {
-	Board          *Board
-	Turn           Color
-	EpSquare       Square
-	CastleRights   CastleRights
+	Board        *Board
+	Turn         Color
+	EpSquare     Square
+	CastleRights CastleRights
+	// CastleRooks are the starting squares of the castling rooks by color and side, they differ from a/h files only in chess960
+	CastleRooks [2][2]Square
+	// Chess960 writes the castle moves in the king takes rook notation and the fen castle rights in X-FEN
+	Chess960 bool
+	Variant  Variant
+	// Pockets are the crazyhouse pieces to drop and Promoted are the squares of the promoted pieces which are pocketed as pawns
+	Pockets  Pockets
+	Promoted bitboard
+	// Checks are the checks given by each color in three-check
+	Checks         [2]uint8
 	HalfMoveClock  uint8
 	FullMoveClock  uint16
 	Ply            uint16
@@ -18,14 +28,28 @@ type Position struct {
 	Headers        []string
 	CapturedPieces []Piece
 	Hash           uint64
+	// undos is the stack of the made moves to unmake, it is not copied with the position
+	undos []undoState
 }
 
 func (p *Position) PrintBoard() string {
 	return p.Board.Draw(nil)
 }
 
+// LoadFromFEN loads the position, the crazyhouse and three-check variants are recognized by the fen
 func (p *Position) LoadFromFEN(fen string) error {
-	meta, err := validateFEN(fen, validateFenOps{})
+	return p.loadFromFEN(fen, validateFenOps{})
+}
+
+// LoadFromFENVariant loads the position by the variant rules (e.g. the horde without the king)
+func (p *Position) LoadFromFENVariant(fen string, variant Variant) error {
+	return p.loadFromFEN(fen, validateFenOps{variant: variant})
+}
+
+func (p *Position) loadFromFEN(fen string, opts validateFenOps) error {
+	InitPrecalculatedTables()
+
+	meta, err := validateFEN(fen, opts)
 	if err != nil {
 		return fmt.Errorf("failed to load position from fen: %w", err)
 	}
@@ -40,14 +64,38 @@ func (p *Position) LoadFromFEN(fen string) error {
 
 	board.calcSideOccupancies()
 
+	// nobody can be in check in racing kings
+	if opts.variant == VariantRacingKings && (board.IsInCheck(White) || board.IsInCheck(Black)) {
+		return fmt.Errorf("failed to load position from fen: invalid FEN: king is in check in racing kings")
+	}
+
 	p.Board = board
 	p.Turn = meta.turnColor
 	p.EpSquare = meta.enpSquare
 	p.CastleRights = meta.castleRights
+	p.CastleRooks = meta.castleRooks
+	p.Chess960 = meta.chess960
+	p.Variant = VariantStandard
+	p.Pockets = meta.pockets
+	p.Promoted = meta.promoted
+	p.Checks = meta.checks
 	p.HalfMoveClock = meta.halfMoveClock
 	p.FullMoveClock = meta.fullMoveClock
 	p.Ply = 2*(p.FullMoveClock-1) + uint16(p.Turn)
-	p.Check = p.Board.IsInCheck(p.Turn)
+
+	if meta.crazyhouse {
+		p.Variant = VariantCrazyhouse
+	}
+
+	if meta.threeCheck {
+		p.Variant = VariantThreeCheck
+	}
+
+	if opts.variant != VariantStandard {
+		p.Variant = opts.variant
+	}
+
+	p.Check = p.isInCheck(p.Turn)
 
 	p.InitHash()
 
@@ -57,8 +105,24 @@ func (p *Position) LoadFromFEN(fen string) error {
 // FenMetaPart returns the fen meta part without the position and it includes the empty string ` ` at start
 // e.g. fen: "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1" -> fenMetaPart: " w KQkq - 0 1"
 func (p *Position) FenMetaPart() string {
-	castleToken := p.CastleRights.ToFEN()
+	return p.fenMetaPart(p.castleRightsXFEN())
+}
+
+// ShredderFen returns the full fen string with the castle rights as the rook files (e.g. HAha)
+func (p *Position) ShredderFen() string {
+	return p.fenPositionPart() + p.fenMetaPart(p.castleRightsShredderFEN())
+}
+
+// fenPositionPart adds the promoted marks and the pockets to the board in crazyhouse e.g. "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[Qp]"
+func (p *Position) fenPositionPart() string {
+	if p.Variant != VariantCrazyhouse {
+		return p.Board.FenPositionPart()
+	}
 
+	return p.Board.fenPositionPart(p.Promoted) + fenPocketOpen + p.Pockets.String() + fenPocketClose
+}
+
+func (p *Position) fenMetaPart(castleToken string) string {
 	enpSqToken := fenNoneSymbol
 	if p.EpSquare != SquareNone {
 		enpSqToken = p.EpSquare.Coordinate()
@@ -66,12 +130,16 @@ func (p *Position) FenMetaPart() string {
 
 	fenMetaPart := fmt.Sprintf(" %s %s %s %d %d", p.Turn, castleToken, enpSqToken, p.HalfMoveClock, p.FullMoveClock)
 
+	if p.Variant == VariantThreeCheck {
+		fenMetaPart += p.fenChecksPart()
+	}
+
 	return fenMetaPart
 }
 
 // Fen returns the full fen string
 func (p *Position) Fen() string {
-	return p.Board.FenPositionPart() + p.FenMetaPart()
+	return p.fenPositionPart() + p.FenMetaPart()
 }
 
 func (p *Position) whiteHasKingSideCastleRights() bool {
@@ -141,6 +209,45 @@ func (p *Position) SwitchTurn() {
 // InsufficentMaterial checks if there is insufficient material on the board which leads to a draw
 // theoretically possible checkmates are not counted as a draw because they can be achieved with the help of self mate
 func (p *Position) IsInsufficientMaterial() bool {
+	// the lone king can still walk to the hill or race to the 8th rank, and the horde is won by capturing all of it
+	if p.Variant == VariantKingOfTheHill || p.Variant == VariantRacingKings || p.Variant == VariantHorde {
+		return false
+	}
+
+	// any piece can give the checks
+	if p.Variant == VariantThreeCheck {
+		return p.Board.IsOnlyKingLeft()
+	}
+
+	// the captured pieces come back as drops so only the lone minor piece without the promoted pieces can't mate
+	if p.Variant == VariantCrazyhouse {
+		if p.Promoted != 0 {
+			return false
+		}
+
+		pocketed := 0
+		for _, color := range colors {
+			for _, pk := range pieceKinds {
+				pocketed += p.Pockets.Count(color, pk)
+			}
+		}
+
+		b := p.Board
+		majorsOrPawns := b.pieceOccupancies[White][Pawn] | b.pieceOccupancies[Black][Pawn] | b.pieceOccupancies[White][Rook] |
+			b.pieceOccupancies[Black][Rook] | b.pieceOccupancies[White][Queen] | b.pieceOccupancies[Black][Queen]
+
+		return majorsOrPawns == 0 && int(b.sideOccupancies[Both].populationCount())+pocketed <= 3
+	}
+
+	// any two pieces can explode next to the king in atomic chess so only the lone minor piece can't win
+	if p.Variant == VariantAtomic {
+		b := p.Board
+		majorsOrPawns := b.pieceOccupancies[White][Pawn] | b.pieceOccupancies[Black][Pawn] | b.pieceOccupancies[White][Rook] |
+			b.pieceOccupancies[Black][Rook] | b.pieceOccupancies[White][Queen] | b.pieceOccupancies[Black][Queen]
+
+		return majorsOrPawns == 0 && b.sideOccupancies[Both].populationCount() <= 3
+	}
+
 	if p.Board.IsOnlyKingLeft() {
 		return true
 	}
@@ -177,315 +284,34 @@ func (p *Position) IsInsufficientMaterial() bool {
 	return false
 }
 
-func (p *Position) generatePseudoLegalQueenMoves() []Move {
-	var (
-		src, dest          Square
-		occupancy, attacks bitboard
-		quiets, captures   bitboard
-	)
-
-	enemies := p.Board.sideOccupancies[p.Turn.Opposite()]
-
-	piece := NewPiece(Queen, p.Turn)
-	occupancy = p.Board.pieceOccupancies[p.Turn][piece.Kind()]
-
-	moves := make([]Move, 0)
-
-	for occupancy > 0 {
-		src = Square(occupancy.PopLS1B())
-		attacks = getQueenAttacks(src, p.Board.sideOccupancies[Both]) & ^p.Board.sideOccupancies[p.Turn]
-		captures = attacks & enemies
-		quiets = attacks & ^enemies
-
-		for quiets > 0 {
-			dest = Square(quiets.PopLS1B())
-			moves = append(moves, newQuietMove(src, dest, piece))
-		}
-
-		for captures > 0 {
-			dest = Square(captures.PopLS1B())
-			moves = append(moves, newCaptureMove(src, dest, piece))
-		}
+// isInCheck checks if the side is in check by the variant rules
+func (p *Position) isInCheck(side Color) bool {
+	if p.Variant == VariantAtomic {
+		return p.isAtomicCheck(side)
 	}
 
-	return moves
+	return p.Board.IsInCheck(side)
 }
 
-func (p *Position) generatePseudoLegalRookMoves() []Move {
-	var (
-		src, dest          Square
-		occupancy, attacks bitboard
-		quiets, captures   bitboard
-	)
-
-	enemies := p.Board.sideOccupancies[p.Turn.Opposite()]
-
-	piece := NewPiece(Rook, p.Turn)
-	occupancy = p.Board.pieceOccupancies[p.Turn][piece.Kind()]
-
-	moves := make([]Move, 0)
-
-	for occupancy > 0 {
-		src = Square(occupancy.PopLS1B())
-		attacks = getRookAttacks(src, p.Board.sideOccupancies[Both]) & ^p.Board.sideOccupancies[p.Turn]
-		captures = attacks & enemies
-		quiets = attacks & ^enemies
-
-		for quiets > 0 {
-			dest = Square(quiets.PopLS1B())
-			moves = append(moves, newQuietMove(src, dest, piece))
-		}
-
-		for captures > 0 {
-			dest = Square(captures.PopLS1B())
-			moves = append(moves, newCaptureMove(src, dest, piece))
-		}
-	}
-
-	return moves
-}
-
-func (p *Position) generatePseudoLegalBishopMoves() []Move {
-	var (
-		src, dest          Square
-		occupancy, attacks bitboard
-		quiets, captures   bitboard
-	)
-
-	enemies := p.Board.sideOccupancies[p.Turn.Opposite()]
-
-	piece := NewPiece(Bishop, p.Turn)
-	occupancy = p.Board.pieceOccupancies[p.Turn][piece.Kind()]
-
-	moves := make([]Move, 0)
-
-	for occupancy > 0 {
-		src = Square(occupancy.PopLS1B())
-		attacks = getBishopAttacks(src, p.Board.sideOccupancies[Both]) & ^p.Board.sideOccupancies[p.Turn]
-		captures = attacks & enemies
-		quiets = attacks & ^enemies
-
-		for quiets > 0 {
-			dest = Square(quiets.PopLS1B())
-			moves = append(moves, newQuietMove(src, dest, piece))
-		}
-
-		for captures > 0 {
-			dest = Square(captures.PopLS1B())
-			moves = append(moves, newCaptureMove(src, dest, piece))
-		}
-	}
-
-	return moves
-}
-
-func (p *Position) generatePseudoLegalKnightMoves() []Move {
-	var (
-		src, dest          Square
-		occupancy, attacks bitboard
-		quiets, captures   bitboard
-	)
-
-	enemies := p.Board.sideOccupancies[p.Turn.Opposite()]
-
-	piece := NewPiece(Knight, p.Turn)
-	occupancy = p.Board.pieceOccupancies[p.Turn][piece.Kind()]
-
-	moves := make([]Move, 0)
-
-	for occupancy > 0 {
-		src = Square(occupancy.PopLS1B())
-		attacks = knightsAttacksMask[src] & ^p.Board.sideOccupancies[p.Turn]
-		captures = attacks & enemies
-		quiets = attacks & ^enemies
-
-		for quiets > 0 {
-			dest = Square(quiets.PopLS1B())
-			moves = append(moves, newQuietMove(src, dest, piece))
+// isMoveLegal checks if the side that just moved didn't leave its king in check, exploding the enemy king wins even when in check in atomic chess
+// and the check can't be given at all in racing kings
+func (p *Position) isMoveLegal() bool {
+	if p.Variant == VariantAtomic {
+		if p.isKingExploded(p.Turn.Opposite()) {
+			return false
 		}
 
-		for captures > 0 {
-			dest = Square(captures.PopLS1B())
-			moves = append(moves, newCaptureMove(src, dest, piece))
+		if p.isKingExploded(p.Turn) {
+			return true
 		}
 	}
 
-	return moves
-}
-
-func (p *Position) generatePseudoLegalKingMoves() []Move {
-	var (
-		src, dest          Square
-		occupancy, attacks bitboard
-		quiets, captures   bitboard
-	)
-
-	enemies := p.Board.sideOccupancies[p.Turn.Opposite()]
-
-	piece := NewPiece(King, p.Turn)
-	occupancy = p.Board.pieceOccupancies[p.Turn][piece.Kind()]
-
-	moves := make([]Move, 0)
-
-	src = Square(occupancy.PopLS1B())
-	attacks = kingAttacksMask[src] & ^p.Board.sideOccupancies[p.Turn]
-	captures = attacks & enemies
-	quiets = attacks & ^enemies
-
-	for quiets > 0 {
-		dest = Square(quiets.PopLS1B())
-		moves = append(moves, newQuietMove(src, dest, piece))
-	}
-
-	for captures > 0 {
-		dest = Square(captures.PopLS1B())
-		moves = append(moves, newCaptureMove(src, dest, piece))
-	}
-
-	if !p.Board.IsInCheck(p.Turn) {
-		if p.Turn.IsWhite() {
-			attackedSquares := p.Board.GetAttackedSquares(p.Turn.Opposite(), F1G1|B1D1|C1D1, p.Board.sideOccupancies[Both] & ^occupancy)
-
-			if p.whiteHasKingSideCastleRights() && (p.Board.sideOccupancies[Both]|attackedSquares)&F1G1 == 0 {
-				moves = append(moves, newCastleMove(E1, G1, piece))
-			}
-
-			if p.whiteHasQueenSideCastleRights() && p.Board.sideOccupancies[Both]&(B1D1|C1D1) == 0 && attackedSquares&C1D1 == 0 {
-				moves = append(moves, newCastleMove(E1, C1, piece))
-			}
-		}
-
-		if p.Turn.IsBlack() {
-			attackedSquares := p.Board.GetAttackedSquares(p.Turn.Opposite(), F8G8|B8D8|C8D8, p.Board.sideOccupancies[Both] & ^occupancy)
-
-			if p.blackHasKingSideCastleRights() && (p.Board.sideOccupancies[Both]|attackedSquares)&F8G8 == 0 {
-				moves = append(moves, newCastleMove(E8, G8, piece))
-			}
-
-			if p.blackHasQueenSideCastleRights() && p.Board.sideOccupancies[Both]&(B8D8|C8D8) == 0 && attackedSquares&C8D8 == 0 {
-				moves = append(moves, newCastleMove(E8, C8, piece))
-			}
-		}
-	}
-
-	return moves
-}
-
-func (p *Position) generatePseudoLegalPawnMoves() []Move {
-	var (
-		src, dest          Square
-		occupancy, attacks bitboard
-	)
-
-	piece := NewPiece(Pawn, p.Turn)
-	occupancy = p.Board.pieceOccupancies[p.Turn][piece.Kind()]
-
-	moves := make([]Move, 0)
-
-	if p.Turn.IsWhite() {
-		for occupancy > 0 {
-			src = Square(occupancy.PopLS1B())
-			attacks = pawnAttacksMask[White][src] & p.Board.sideOccupancies[Black]
-
-			for attacks > 0 {
-				dest = Square(attacks.PopLS1B())
-
-				if src.Rank() == Rank7 {
-					moves = append(moves, newPossiblePromotionCaptureMoves(src, dest, piece)...)
-				} else {
-					moves = append(moves, newCaptureMove(src, dest, piece))
-				}
-			}
-
-			dest = src + 8
-			if dest <= 63 && p.Board.sideOccupancies[Both]&dest.occupancyMask() == 0 && dest.occupancyMask() != 0 {
-				if src.Rank() == Rank7 {
-					moves = append(moves, newPossiblePromotionMoves(src, dest, piece)...)
-				} else {
-					moves = append(moves, newQuietMove(src, dest, piece))
-				}
-			}
-
-			dest = src + 16
-			if src.Rank() == Rank2 && p.Board.sideOccupancies[Both]&(dest.occupancyMask()|Square(src+8).occupancyMask()) == 0 && dest.occupancyMask() != 0 {
-				moves = append(moves, newDoublePawnMove(src, dest, piece))
-			}
-
-			if p.EpSquare != SquareNone && pawnAttacksMask[White][src]&p.EpSquare.occupancyMask() != 0 {
-				moves = append(moves, newEnpCaptureMove(src, p.EpSquare, piece))
-			}
-		}
-	}
-
-	if p.Turn.IsBlack() {
-		for occupancy > 0 {
-			src = Square(occupancy.PopLS1B())
-			attacks = pawnAttacksMask[Black][src] & p.Board.sideOccupancies[White]
-
-			for attacks > 0 {
-				dest = Square(attacks.PopLS1B())
-
-				if src.Rank() == Rank2 {
-					moves = append(moves, newPossiblePromotionCaptureMoves(src, dest, piece)...)
-				} else {
-					moves = append(moves, newCaptureMove(src, dest, piece))
-				}
-			}
-
-			dest = src - 8
-			if dest >= 0 && p.Board.sideOccupancies[Both]&dest.occupancyMask() == 0 && dest.occupancyMask() != 0 {
-				if src.Rank() == Rank2 {
-					moves = append(moves, newPossiblePromotionMoves(src, dest, piece)...)
-				} else {
-					moves = append(moves, newQuietMove(src, dest, piece))
-				}
-			}
-
-			dest = src - 16
-			if src.Rank() == Rank7 && p.Board.sideOccupancies[Both]&(dest.occupancyMask()|Square(src-8).occupancyMask()) == 0 && dest.occupancyMask() != 0 {
-				moves = append(moves, newDoublePawnMove(src, dest, piece))
-			}
-
-			if p.EpSquare != SquareNone && pawnAttacksMask[Black][src]&p.EpSquare.occupancyMask() != 0 {
-				moves = append(moves, newEnpCaptureMove(src, p.EpSquare, piece))
-			}
-		}
-	}
-
-	return moves
-}
-
-func (p *Position) generateAllLegalMoves(pseudoMoves []Move) []Move {
-	legalMoves := make([]Move, 0)
-
-	for _, m := range pseudoMoves {
-		unmakeMove := p.MakeMove(m)
-
-		if !p.Board.IsInCheck(p.Turn.Opposite()) {
-			legalMoves = append(legalMoves, m)
-		}
-
-		unmakeMove()
+	// giving check is not allowed in racing kings
+	if p.Variant == VariantRacingKings && p.isInCheck(p.Turn) {
+		return false
 	}
 
-	return legalMoves
-}
-
-func (p *Position) generateAllPseudoLegalMoves() []Move {
-	kingMoves := p.generatePseudoLegalKingMoves()
-	queenMoves := p.generatePseudoLegalQueenMoves()
-	rookMoves := p.generatePseudoLegalRookMoves()
-	bishopMoves := p.generatePseudoLegalBishopMoves()
-	knightMoves := p.generatePseudoLegalKnightMoves()
-	pawnMoves := p.generatePseudoLegalPawnMoves()
-
-	allPseudo := append(kingMoves, queenMoves...)
-	allPseudo = append(allPseudo, rookMoves...)
-	allPseudo = append(allPseudo, bishopMoves...)
-	allPseudo = append(allPseudo, knightMoves...)
-	allPseudo = append(allPseudo, pawnMoves...)
-
-	return allPseudo
+	return !p.isInCheck(p.Turn.Opposite())
 }
 
 func (p *Position) Copy() *Position {
@@ -496,6 +322,12 @@ func (p *Position) Copy() *Position {
 		Turn:           p.Turn,
 		EpSquare:       p.EpSquare,
 		CastleRights:   p.CastleRights,
+		CastleRooks:    p.CastleRooks,
+		Chess960:       p.Chess960,
+		Variant:        p.Variant,
+		Pockets:        p.Pockets,
+		Promoted:       p.Promoted,
+		Checks:         p.Checks,
 		HalfMoveClock:  p.HalfMoveClock,
 		FullMoveClock:  p.FullMoveClock,
 		Ply:            p.Ply,
@@ -509,31 +341,62 @@ func (p *Position) Copy() *Position {
 	return &positionCopy
 }
 
-// UnmakeMove undos the previous move and is returned from MakeMove func
-func (p *Position) UnmakeMove() func() {
-	pcopy := p.Copy()
-
-	bcopy := pcopy.Board.Copy()
+// undoState is the part of the position that the move can't restore by itself, MakeMove pushes it and UnmakeMove pops it
+type undoState struct {
+	board          Board
+	epSquare       Square
+	castleRights   CastleRights
+	pockets        Pockets
+	promoted       bitboard
+	checks         [2]uint8
+	halfMoveClock  uint8
+	fullMoveClock  uint16
+	check          bool
+	hash           uint64
+	capturedPieces int
+}
+
+func (p *Position) pushUndo() {
+	p.undos = append(p.undos, undoState{
+		board:          *p.Board,
+		epSquare:       p.EpSquare,
+		castleRights:   p.CastleRights,
+		pockets:        p.Pockets,
+		promoted:       p.Promoted,
+		checks:         p.Checks,
+		halfMoveClock:  p.HalfMoveClock,
+		fullMoveClock:  p.FullMoveClock,
+		check:          p.Check,
+		hash:           p.Hash,
+		capturedPieces: len(p.CapturedPieces),
+	})
+}
+
+// UnmakeMove undos the last move made by MakeMove or MakeNullMove
+func (p *Position) UnmakeMove() {
+	last := len(p.undos) - 1
+	u := &p.undos[last]
+
+	*p.Board = u.board
+	p.EpSquare = u.epSquare
+	p.CastleRights = u.castleRights
+	p.Pockets = u.pockets
+	p.Promoted = u.promoted
+	p.Checks = u.checks
+	p.HalfMoveClock = u.halfMoveClock
+	p.FullMoveClock = u.fullMoveClock
+	p.Check = u.check
+	p.Hash = u.hash
+	p.CapturedPieces = p.CapturedPieces[:u.capturedPieces]
+	p.Ply--
+	p.SwitchTurn()
 
-	return func() {
-		p.Board = &bcopy
-		p.Turn = pcopy.Turn
-		p.EpSquare = pcopy.EpSquare
-		p.CastleRights = pcopy.CastleRights
-		p.HalfMoveClock = pcopy.HalfMoveClock
-		p.FullMoveClock = pcopy.FullMoveClock
-		p.Ply = pcopy.Ply
-		p.Check = pcopy.Check
-		p.Comments = pcopy.Comments
-		p.Headers = pcopy.Headers
-		p.CapturedPieces = pcopy.CapturedPieces
-		p.Hash = pcopy.Hash
-	}
+	p.undos = p.undos[:last]
 }
 
-// MakeMove makes the move and returns UnmakeMove func that undos the move
-func (p *Position) MakeMove(m Move) func() {
-	unmakeMove := p.UnmakeMove()
+// MakeMove makes the move, it is undone by UnmakeMove
+func (p *Position) MakeMove(m Move) {
+	p.pushUndo()
 
 	p.Ply++
 
@@ -547,7 +410,10 @@ func (p *Position) MakeMove(m Move) func() {
 		p.ZobristEnpSquare(p.EpSquare)
 	}
 
-	if m.IsEnPassant() {
+	if m.IsDrop() {
+		p.EpSquare = SquareNone
+		p.dropPiece(m)
+	} else if m.IsEnPassant() {
 		p.ZobristEnpCapture(m)
 		p.EpSquare = SquareNone
 
@@ -580,8 +446,16 @@ func (p *Position) MakeMove(m Move) func() {
 	piecOcc.clearBit(m.Src())
 	piecOcc.setBit(m.Dest())
 
+	if p.Variant == VariantCrazyhouse {
+		p.movePromoted(m)
+	}
+
 	p.Promote(m)
 
+	if p.Variant == VariantAtomic && m.IsCapture() {
+		p.explode(m.Dest())
+	}
+
 	p.Board.calcSideOccupancies()
 
 	if p.Turn.IsBlack() {
@@ -592,54 +466,32 @@ func (p *Position) MakeMove(m Move) func() {
 
 	p.ZobristTurn()
 	p.SwitchTurn()
-	p.Check = p.Board.IsInCheck(p.Turn)
-
-	return unmakeMove
-}
+	p.Check = p.isInCheck(p.Turn)
 
-func (p *Position) MakeNullMove() func() {
-	type unmakeNullMove struct {
-		enp Square
+	if p.Variant == VariantThreeCheck && p.Check {
+		p.addCheck(p.Turn.Opposite())
 	}
+}
 
-	unmakeNull := unmakeNullMove{enp: p.EpSquare}
+// MakeNullMove passes the turn to the opponent without moving, it is undone by UnmakeMove
+func (p *Position) MakeNullMove() {
+	p.pushUndo()
 
+	p.ZobristEnpSquare(p.EpSquare)
 	p.EpSquare = SquareNone
 	p.HalfMoveClock++
 	p.Ply++
-	p.ZobristEnpSquare(p.EpSquare)
 	p.ZobristTurn()
 	p.SwitchTurn()
-
-	return func() {
-		p.HalfMoveClock--
-		p.Ply--
-		p.EpSquare = unmakeNull.enp
-		p.ZobristTurn()
-		p.SwitchTurn()
-	}
+	p.Check = p.isInCheck(p.Turn)
 }
 
 func (p *Position) RemoveCapturedPiece(sq Square) {
 	capturedPiece := p.Board.pieceAt(sq)
 	p.CapturedPieces = append(p.CapturedPieces, capturedPiece)
 
-	if capturedPiece.IsRook() {
-		if sq == A1 {
-			p.CastleRights.disableWhiteQueenSideCastleRight()
-		}
-
-		if sq == H1 {
-			p.CastleRights.disableWhiteKingSideCastleRight()
-		}
-
-		if sq == A8 {
-			p.CastleRights.disableBlackQueenSideCastleRight()
-		}
-
-		if sq == H8 {
-			p.CastleRights.disableBlackKingSideCastleRight()
-		}
+	if p.Variant == VariantCrazyhouse {
+		p.pocketCapturedPiece(sq, capturedPiece)
 	}
 
 	p.Board.sideOccupancies[p.Turn.Opposite()].clearBit(sq)
@@ -650,27 +502,18 @@ func (p *Position) RemoveCapturedPiece(sq Square) {
 }
 
 func (p *Position) CompleteCastling(m Move) {
-	var rookMove Move
-
-	if m.Src() == E1 && m.Dest() == G1 {
-		rookMove = newCastleMove(H1, F1, WhiteRook)
-	}
-
-	if m.Src() == E1 && m.Dest() == C1 {
-		rookMove = newCastleMove(A1, D1, WhiteRook)
-	}
-
-	if m.Src() == E8 && m.Dest() == G8 {
-		rookMove = newCastleMove(H8, F8, WhiteRook)
+	side := KingSide
+	if m.IsQueenSideCastle() {
+		side = QueenSide
 	}
 
-	if m.Src() == E8 && m.Dest() == C8 {
-		rookMove = newCastleMove(A8, D8, WhiteRook)
-	}
+	rookSrc := p.CastleRooks[p.Turn][side]
+	_, rookDest := castleDestinations(p.Turn, side)
+	rookMove := newCastleMove(rookSrc, rookDest, NewPiece(Rook, p.Turn))
 
 	p.ZobristMove(rookMove)
-	p.Board.pieceOccupancies[p.Turn][Rook].setBit(rookMove.Dest())
 	p.Board.pieceOccupancies[p.Turn][Rook].clearBit(rookMove.Src())
+	p.Board.pieceOccupancies[p.Turn][Rook].setBit(rookMove.Dest())
 }
 
 // Promote promotes (replaces) a pawn on the 8th/1st rank with the promoted piece
@@ -685,59 +528,25 @@ func (p *Position) Promote(m Move) {
 	p.ZobristPromotion(m)
 }
 
+// updateCastlingRights removes the castle rights when the king moves or the castling rook moves or gets captured
 func (p *Position) updateCastlingRights(m Move) {
-	if p.CastleRights == 0 {
+	if p.CastleRights == CastleRightsNone {
 		return
 	}
 
-	if m.Piece().IsKing() {
-		if p.Turn.IsWhite() {
-			if p.whiteHasKingSideCastleRights() {
-				p.ZobristCastleRights(WhiteKingSideCastle)
+	for _, color := range colors {
+		for _, side := range [2]CastleSide{KingSide, QueenSide} {
+			right := castleRight(color, side)
+			if p.CastleRights&right == 0 {
+				continue
 			}
 
-			if p.whiteHasQueenSideCastleRights() {
-				p.ZobristCastleRights(WhiteQueenSideCastle)
-			}
-
-			p.CastleRights.disableWhiteCastleRights()
-		}
-
-		if p.Turn.IsBlack() {
-			if p.blackHasKingSideCastleRights() {
-				p.ZobristCastleRights(BlackKingSideCastle)
-			}
-
-			if p.blackHasQueenSideCastleRights() {
-				p.ZobristCastleRights(BlackQueenSideCastle)
-			}
-
-			p.CastleRights.disableBlackCastleRights()
-		}
-	}
-
-	if m.Piece().IsRook() {
-		if p.Turn.IsWhite() {
-			if m.Src() == H1 {
-				p.CastleRights.disableWhiteKingSideCastleRight()
-				p.ZobristCastleRights(WhiteKingSideCastle)
-			}
-
-			if m.Src() == A1 {
-				p.CastleRights.disableWhiteQueenSideCastleRight()
-				p.ZobristCastleRights(WhiteQueenSideCastle)
-			}
-		}
-
-		if p.Turn.IsBlack() {
-			if m.Src() == H8 {
-				p.CastleRights.disableBlackKingSideCastleRight()
-				p.ZobristCastleRights(BlackKingSideCastle)
-			}
+			rookSq := p.CastleRooks[color][side]
+			kingMoved := color == p.Turn && m.Piece().IsKing()
 
-			if m.Src() == A8 {
-				p.CastleRights.disableBlackQueenSideCastleRight()
-				p.ZobristCastleRights(BlackQueenSideCastle)
+			if kingMoved || m.Src() == rookSq || m.Dest() == rookSq {
+				p.CastleRights &^= right
+				p.ZobristCastleRights(right)
 			}
 		}
 	}
@@ -767,6 +576,16 @@ func (p *Position) InitHash() {
 		p.Hash ^= defaultZobrist.enpKeys[p.EpSquare]
 	}
 
+	for color := range p.Pockets {
+		for pk, count := range p.Pockets[color] {
+			p.Hash ^= zobristPocketKey(Color(color), PieceKind(pk), count)
+		}
+	}
+
+	for color, count := range p.Checks {
+		p.Hash ^= zobristCheckKey(Color(color), count)
+	}
+
 	if p.Turn.IsBlack() {
 		p.Hash ^= defaultZobrist.turnKey
 	}
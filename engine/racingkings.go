@@ -0,0 +1,58 @@
+package engine
+
+// isRaceFinished checks if the king reached the 8th rank in racing kings
+// black gets one more move to equalize when white reached it first, the game goes on while black can also reach it
+func (p *Position) isRaceFinished() bool {
+	if p.Variant != VariantRacingKings {
+		return false
+	}
+
+	white, black := p.racingKingsOnGoal()
+	if !white && !black {
+		return false
+	}
+
+	if white && !black && p.Turn.IsBlack() {
+		return !p.canReachRaceGoal()
+	}
+
+	return true
+}
+
+// racingKingsOnGoal reports which kings are on the 8th rank
+func (p *Position) racingKingsOnGoal() (bool, bool) {
+	goal := bitboardUniverseRanksMask[Rank8]
+
+	return p.Board.pieceOccupancies[White][King]&goal != 0, p.Board.pieceOccupancies[Black][King]&goal != 0
+}
+
+// canReachRaceGoal checks if the side to move has the legal king move to the 8th rank
+func (p *Position) canReachRaceGoal() bool {
+	kings := p.Board.pieceOccupancies[p.Turn][King]
+	if kings == 0 {
+		return false
+	}
+
+	src := Square(kings.LS1B())
+	piece := NewPiece(King, p.Turn)
+	dests := kingAttacksMask[src] & ^p.Board.sideOccupancies[p.Turn] & bitboardUniverseRanksMask[Rank8]
+
+	for dests > 0 {
+		dest := Square(dests.PopLS1B())
+
+		m := newQuietMove(src, dest, piece)
+		if p.Board.sideOccupancies[p.Turn.Opposite()].bitIsSet(dest) {
+			m = newCaptureMove(src, dest, piece)
+		}
+
+		p.MakeMove(m)
+		legal := p.isMoveLegal()
+		p.UnmakeMove()
+
+		if legal {
+			return true
+		}
+	}
+
+	return false
+}
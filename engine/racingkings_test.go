@@ -0,0 +1,103 @@
+package engine
+
+import (
+	"testing"
+)
+
+func TestRacingKingsPerft(t *testing.T) {
+	testCases := map[string]struct {
+		fen   string
+		nodes []int64
+	}{
+		"starting position":          {fen: FENStartingPositionRacingKings, nodes: []int64{21, 421, 11264, 296242}},
+		"kings near the goal":        {fen: "4brn1/2K2k2/8/8/8/8/8/8 w - - 0 1", nodes: []int64{6, 33, 178, 3151}},
+		"rooks can't give check":     {fen: "6r1/2K5/5k2/8/3R4/8/8/8 w - - 0 1", nodes: []int64{17, 322, 5493, 86041}},
+		"bare kings":                 {fen: "8/5k2/8/8/8/8/8/1K6 w - - 0 1", nodes: []int64{5, 40, 145, 1160, 6201}},
+		"black can equalize":         {fen: "1K6/7k/8/8/8/8/8/8 b - - 0 1", nodes: []int64{5, 0}},
+		"black can't reach the goal": {fen: "1K6/5n1k/8/8/8/8/8/8 b - - 0 1", nodes: []int64{11, 0}},
+		"white reached the goal":     {fen: "1K6/8/7k/8/8/8/8/8 b - - 0 1", nodes: []int64{0}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for i, want := range tc.nodes {
+				if got := PerftVariant(tc.fen, VariantRacingKings, i+1); got != want {
+					t.Fatalf("invalid perft nodes at depth %d: want %d, got %d", i+1, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRacingKingsFen(t *testing.T) {
+	testCases := map[string]struct {
+		fen     string
+		wantErr bool
+	}{
+		"starting position":    {fen: FENStartingPositionRacingKings},
+		"fails white in check": {fen: "8/8/8/8/8/k7/8/r5K1 w - - 0 1", wantErr: true},
+		"fails black in check": {fen: "8/8/8/8/8/k7/8/R5K1 w - - 0 1", wantErr: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := &Position{}
+
+			err := p.LoadFromFENVariant(tc.fen, VariantRacingKings)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("invalid error: want %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRacingKingsStatus(t *testing.T) {
+	testCases := map[string]struct {
+		fen         string
+		moves       []string
+		wantStatus  Status
+		wantOutcome Outcome
+	}{
+		"black reaches the goal":    {fen: "8/1k6/8/8/8/8/8/6K1 b - - 0 1", moves: []string{"b7b8"}, wantStatus: StatusRaceFinished, wantOutcome: OutcomeBlackWon},
+		"black gets the last move":  {fen: "8/1K5k/8/8/8/8/8/8 w - - 0 1", moves: []string{"b7b8"}, wantStatus: StatusUnknown},
+		"white wins the race":       {fen: "8/1K5k/8/8/8/8/8/8 w - - 0 1", moves: []string{"b7b8", "h7h6"}, wantStatus: StatusRaceFinished, wantOutcome: OutcomeWhiteWon},
+		"both kings reach the goal": {fen: "8/1K5k/8/8/8/8/8/8 w - - 0 1", moves: []string{"b7b8", "h7h8"}, wantStatus: StatusRaceFinished, wantOutcome: OutcomeDraw},
+		"black is too far":          {fen: "8/1K6/7k/8/8/8/8/8 w - - 0 1", moves: []string{"b7b8"}, wantStatus: StatusRaceFinished, wantOutcome: OutcomeWhiteWon},
+		"bare kings are not a draw": {fen: "8/5k2/8/8/8/8/8/1K6 w - - 0 1", moves: []string{"b1b2"}, wantStatus: StatusUnknown},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewChessVariant(tc.fen, VariantRacingKings)
+			if err != nil {
+				t.Fatalf("invalid fen: %v", err)
+			}
+
+			for _, uci := range tc.moves {
+				if _, err := c.MakeMoveUCI(uci); err != nil {
+					t.Fatalf("failed to make move %s: %v", uci, err)
+				}
+			}
+
+			if got := c.Status(); got != tc.wantStatus {
+				t.Fatalf("invalid status: want %d, got %d", tc.wantStatus, got)
+			}
+
+			if got := c.VariantOutcome(); got != tc.wantOutcome {
+				t.Fatalf("invalid outcome: want %d, got %d", tc.wantOutcome, got)
+			}
+		})
+	}
+}
+
+func TestRacingKingsNoCheck(t *testing.T) {
+	c, err := NewChessVariant("8/8/8/8/8/k7/8/1R4K1 w - - 0 1", VariantRacingKings)
+	if err != nil {
+		t.Fatalf("invalid fen: %v", err)
+	}
+
+	if _, err := c.MakeMoveUCI("b1a1"); err == nil {
+		t.Fatalf("invalid move: want the check to be illegal, got no error")
+	}
+
+	if _, err := c.MakeMoveUCI("b1b2"); err != nil {
+		t.Fatalf("failed to make move b1b2: %v", err)
+	}
+}
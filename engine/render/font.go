@@ -0,0 +1,53 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+const (
+	glyphWidth  = 5
+	glyphHeight = 7
+)
+
+// glyphs is the bitmap font of the board coordinates for the png images
+var glyphs = map[byte][glyphHeight]string{
+	'a': {".....", ".....", ".###.", "....#", ".####", "#...#", ".####"},
+	'b': {"#....", "#....", "####.", "#...#", "#...#", "#...#", "####."},
+	'c': {".....", ".....", ".###.", "#....", "#....", "#....", ".###."},
+	'd': {"....#", "....#", ".####", "#...#", "#...#", "#...#", ".####"},
+	'e': {".....", ".....", ".###.", "#...#", "#####", "#....", ".###."},
+	'f': {"..##.", ".#...", "####.", ".#...", ".#...", ".#...", ".#..."},
+	'g': {".....", ".####", "#...#", "#...#", ".####", "....#", ".###."},
+	'h': {"#....", "#....", "####.", "#...#", "#...#", "#...#", "#...#"},
+	'1': {"..#..", ".##..", "..#..", "..#..", "..#..", "..#..", ".###."},
+	'2': {".###.", "#...#", "....#", "...#.", "..#..", ".#...", "#####"},
+	'3': {".###.", "#...#", "....#", "..##.", "....#", "#...#", ".###."},
+	'4': {"...#.", "..##.", ".#.#.", "#..#.", "#####", "...#.", "...#."},
+	'5': {"#####", "#....", "####.", "....#", "....#", "#...#", ".###."},
+	'6': {".###.", "#....", "#....", "####.", "#...#", "#...#", ".###."},
+	'7': {"#####", "....#", "...#.", "..#..", ".#...", ".#...", ".#..."},
+	'8': {".###.", "#...#", "#...#", ".###.", "#...#", "#...#", ".###."},
+}
+
+// drawGlyph draws the character with the top left corner at the point, every font pixel is the scale wide square
+func drawGlyph(dst draw.Image, ch byte, at image.Point, scale int, c color.Color) {
+	glyph, ok := glyphs[ch]
+	if !ok {
+		return
+	}
+
+	src := image.NewUniform(c)
+
+	for y, row := range glyph {
+		for x := range glyphWidth {
+			if row[x] != '#' {
+				continue
+			}
+
+			r := image.Rect(at.X+x*scale, at.Y+y*scale, at.X+(x+1)*scale, at.Y+(y+1)*scale)
+			draw.Draw(dst, r, src, image.Point{}, draw.Over)
+		}
+	}
+}
@@ -0,0 +1,283 @@
+package render
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"io"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+var ErrNoFrames = errors.New("animation has no frames")
+
+// Image draws the diagram to the rgba image
+func Image(d Diagram, opts *Options) (*image.RGBA, error) {
+	o := opts.normalized()
+
+	ps, err := loadPieceSet(o.PieceSet)
+	if err != nil {
+		return nil, err
+	}
+
+	size := o.squareSize()
+	img := image.NewRGBA(image.Rect(0, 0, o.Size, o.Size))
+
+	squareRect := func(sq engine.Square) image.Rectangle {
+		col, row := o.squareOrigin(sq)
+		return image.Rect(col*size, row*size, (col+1)*size, (row+1)*size)
+	}
+
+	for sq := engine.A1; sq <= engine.H8; sq++ {
+		c := o.Theme.Dark
+		if sq.IsLight() {
+			c = o.Theme.Light
+		}
+
+		draw.Draw(img, squareRect(sq), image.NewUniform(c), image.Point{}, draw.Src)
+	}
+
+	for _, sq := range d.LastMove {
+		draw.Draw(img, squareRect(sq), image.NewUniform(o.Theme.LastMove), image.Point{}, draw.Over)
+	}
+
+	for _, sq := range d.Check {
+		drawCheck(img, squareRect(sq), o.Theme.Check)
+	}
+
+	if o.Coordinates {
+		drawCoordinates(img, o)
+	}
+
+	for sq := engine.A1; sq <= engine.H8; sq++ {
+		if piece := d.Board.PieceAt(sq); piece != engine.PieceNone {
+			r := squareRect(sq)
+			draw.Draw(img, r, ps.rasterize(piece, size), image.Point{}, draw.Over)
+		}
+	}
+
+	for _, a := range d.Arrows {
+		if poly := o.arrowPolygon(a); poly != nil {
+			fillPolygons(img, [][]point{poly}, o.arrowColor(a))
+		}
+	}
+
+	return img, nil
+}
+
+// PNG writes the diagram as the png image
+func PNG(w io.Writer, d Diagram, opts *Options) error {
+	img, err := Image(d, opts)
+	if err != nil {
+		return err
+	}
+
+	return png.Encode(w, img)
+}
+
+// GIF writes the frames as the looping animated gif, every frame is shown for the delay and the last one
+// three times longer before the animation starts again
+func GIF(w io.Writer, frames []Diagram, delay time.Duration, opts *Options) error {
+	if len(frames) == 0 {
+		return ErrNoFrames
+	}
+
+	o := opts.normalized()
+
+	ps, err := loadPieceSet(o.PieceSet)
+	if err != nil {
+		return err
+	}
+
+	pal := o.palette(ps)
+	bounds := image.Rect(0, 0, o.Size, o.Size)
+
+	anim := &gif.GIF{
+		Config: image.Config{ColorModel: pal, Width: o.Size, Height: o.Size},
+	}
+
+	var prev *image.Paletted
+
+	for i, frame := range frames {
+		img, err := Image(frame, &o)
+		if err != nil {
+			return err
+		}
+
+		paletted := image.NewPaletted(bounds, pal)
+		draw.Draw(paletted, bounds, img, image.Point{}, draw.Src)
+
+		// only the changed part of the board is stored, the rest is kept from the previous frame
+		rect := bounds
+		if prev != nil {
+			rect = changedBounds(prev, paletted)
+		}
+
+		centiseconds := int(delay / (10 * time.Millisecond))
+		if i == len(frames)-1 {
+			centiseconds *= 3
+		}
+
+		anim.Image = append(anim.Image, paletted.SubImage(rect).(*image.Paletted))
+		anim.Delay = append(anim.Delay, centiseconds)
+		anim.Disposal = append(anim.Disposal, gif.DisposalNone)
+
+		prev = paletted
+	}
+
+	return gif.EncodeAll(w, anim)
+}
+
+// drawCheck draws the glow of the check color fading out from the square center
+func drawCheck(img *image.RGBA, r image.Rectangle, c color.NRGBA) {
+	cx, cy := float64(r.Min.X+r.Max.X)/2, float64(r.Min.Y+r.Max.Y)/2
+	radius := float64(r.Dx()) / 2 * math.Sqrt2
+
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			offset := math.Hypot(float64(x)+0.5-cx, float64(y)+0.5-cy) / radius
+
+			glow := c
+			glow.A = uint8(float64(c.A) * checkAlpha(offset))
+			img.Set(x, y, blend(glow, img.RGBAAt(x, y)))
+		}
+	}
+}
+
+// drawCoordinates draws the file letters in the bottom right corners of the bottom squares and the rank numbers
+// in the top left corners of the left squares with the color of the other squares
+func drawCoordinates(img *image.RGBA, o Options) {
+	size := o.squareSize()
+	scale := max(1, size/30)
+	margin := max(1, size/20)
+
+	for i := range 8 {
+		file, rank := engine.Square(i), engine.Square(i*8)
+		if o.Flip {
+			file, rank = engine.Square(7-i+56), engine.Square((7-i)*8+7)
+		}
+
+		c := o.Theme.Light
+		if file.IsLight() {
+			c = o.Theme.Dark
+		}
+
+		at := image.Pt((i+1)*size-margin-glyphWidth*scale, o.Size-margin-glyphHeight*scale)
+		drawGlyph(img, "abcdefgh"[file.File()], at, scale, c)
+
+		c = o.Theme.Light
+		if rank.IsLight() {
+			c = o.Theme.Dark
+		}
+
+		at = image.Pt(margin, (7-i)*size+margin)
+		drawGlyph(img, "12345678"[rank.Rank()], at, scale, c)
+	}
+}
+
+// palette has the theme and the piece colors with their blends for the antialiased edges
+func (o Options) palette(ps *pieceSet) color.Palette {
+	light, dark := o.Theme.Light, o.Theme.Dark
+
+	base := []color.Color{
+		light,
+		dark,
+		blend(o.Theme.LastMove, light),
+		blend(o.Theme.LastMove, dark),
+		blend(o.Theme.Arrow, light),
+		blend(o.Theme.Arrow, dark),
+		opaque(o.Theme.Check),
+	}
+
+	for _, piece := range allPieces {
+		for _, s := range ps.pieces[piece] {
+			for _, c := range []color.Color{s.fill, s.stroke} {
+				if c != nil {
+					base = append(base, opaque(color.NRGBAModel.Convert(c).(color.NRGBA)))
+				}
+			}
+		}
+	}
+
+	base = uniqueColors(base)
+	pal := slices.Clone(base)
+
+	for i, a := range base {
+		for _, b := range base[i+1:] {
+			for _, t := range []float64{0.25, 0.5, 0.75} {
+				pal = append(pal, mix(a, b, t))
+			}
+		}
+	}
+
+	pal = uniqueColors(pal)
+
+	return color.Palette(pal[:min(len(pal), 256)])
+}
+
+// changedBounds returns the bounds of the pixels that differ between the frames, at least one pixel is kept
+// because the gif frames can't be empty
+func changedBounds(prev, next *image.Paletted) image.Rectangle {
+	bounds := next.Bounds()
+	changed := image.Rectangle{}
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		row := (y - bounds.Min.Y) * next.Stride
+		for x := range bounds.Dx() {
+			if prev.Pix[row+x] != next.Pix[row+x] {
+				changed = changed.Union(image.Rect(bounds.Min.X+x, y, bounds.Min.X+x+1, y+1))
+			}
+		}
+	}
+
+	if changed.Empty() {
+		return image.Rect(bounds.Min.X, bounds.Min.Y, bounds.Min.X+1, bounds.Min.Y+1)
+	}
+
+	return changed
+}
+
+// blend draws the translucent color over the opaque one
+func blend(over color.NRGBA, under color.Color) color.RGBA {
+	r, g, b, _ := under.RGBA()
+	a := float64(over.A) / 0xff
+
+	return color.RGBA{
+		R: uint8(float64(over.R)*a + float64(r>>8)*(1-a) + 0.5),
+		G: uint8(float64(over.G)*a + float64(g>>8)*(1-a) + 0.5),
+		B: uint8(float64(over.B)*a + float64(b>>8)*(1-a) + 0.5),
+		A: 0xff,
+	}
+}
+
+func mix(a, b color.Color, t float64) color.RGBA {
+	bn := color.NRGBAModel.Convert(b).(color.NRGBA)
+	bn.A = uint8(t*0xff + 0.5)
+
+	return blend(bn, a)
+}
+
+func opaque(c color.NRGBA) color.RGBA {
+	return color.RGBA{R: c.R, G: c.G, B: c.B, A: 0xff}
+}
+
+func uniqueColors(colors []color.Color) []color.Color {
+	seen := map[color.RGBA]bool{}
+	out := colors[:0]
+
+	for _, c := range colors {
+		rgba := color.RGBAModel.Convert(c).(color.RGBA)
+		if !seen[rgba] {
+			seen[rgba] = true
+			out = append(out, rgba)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,504 @@
+package render
+
+import (
+	"embed"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"io/fs"
+	"math"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+// DefaultPieceSet is the piece set used when the options don't choose one
+const DefaultPieceSet = "juicer"
+
+// pieceViewBox is the width and the height of the piece svg coordinate system
+const pieceViewBox = 45
+
+var ErrPieceSetNotFound = errors.New("piece set not found")
+
+// piecesFS has the piece sets as the directories with the `wK.svg`, `bQ.svg`... files,
+// only the path and the circle elements with the fill and stroke attributes are drawn
+//
+//go:embed pieces
+var piecesFS embed.FS
+
+// allPieces are the pieces every piece set has
+var allPieces = func() []engine.Piece {
+	var pieces []engine.Piece
+	for _, color := range []engine.Color{engine.White, engine.Black} {
+		for _, kind := range []engine.PieceKind{engine.King, engine.Queen, engine.Rook, engine.Bishop, engine.Knight, engine.Pawn} {
+			pieces = append(pieces, engine.NewPiece(kind, color))
+		}
+	}
+
+	return pieces
+}()
+
+var (
+	pieceSetsMu sync.Mutex
+	pieceSets   = map[string]*pieceSet{}
+)
+
+// PieceSets returns the names of the embedded piece sets
+func PieceSets() []string {
+	entries, err := fs.ReadDir(piecesFS, "pieces")
+	if err != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return names
+}
+
+// pieceSet is the parsed piece set, the pieces are indexed by the engine piece
+type pieceSet struct {
+	name   string
+	pieces map[engine.Piece][]shape
+
+	// the rasterized pieces are cached by the square size
+	mu     sync.Mutex
+	images map[pieceImageKey]*image.RGBA
+}
+
+type pieceImageKey struct {
+	piece engine.Piece
+	size  int
+}
+
+// loadPieceSet parses the embedded piece set once, the parsed sets are shared by all the renders
+func loadPieceSet(name string) (*pieceSet, error) {
+	if name == "" {
+		name = DefaultPieceSet
+	}
+
+	pieceSetsMu.Lock()
+	defer pieceSetsMu.Unlock()
+
+	if ps, ok := pieceSets[name]; ok {
+		return ps, nil
+	}
+
+	if !slices.Contains(PieceSets(), name) {
+		return nil, fmt.Errorf("%w: %s", ErrPieceSetNotFound, name)
+	}
+
+	ps := &pieceSet{name: name, pieces: map[engine.Piece][]shape{}, images: map[pieceImageKey]*image.RGBA{}}
+
+	for _, piece := range allPieces {
+		f, err := piecesFS.Open(path.Join("pieces", name, pieceFileName(piece)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open piece %s: %w", piece, err)
+		}
+
+		shapes, err := parsePieceSVG(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse piece %s: %w", piece, err)
+		}
+
+		ps.pieces[piece] = shapes
+	}
+
+	pieceSets[name] = ps
+
+	return ps, nil
+}
+
+// pieceFileName is the color prefix with the uppercase piece letter e.g. `wK.svg` or `bN.svg`
+func pieceFileName(piece engine.Piece) string {
+	prefix := "w"
+	if piece.Color() == engine.Black {
+		prefix = "b"
+	}
+
+	return prefix + strings.ToUpper(piece.String()) + ".svg"
+}
+
+// shape is the drawn element of the piece, the subpaths are flattened to the polygons in the piece coordinates
+type shape struct {
+	element     string
+	attrs       []xml.Attr
+	subpaths    [][]point
+	closed      []bool
+	fill        color.Color
+	stroke      color.Color
+	strokeWidth float64
+}
+
+// style is the inherited presentation attributes of the element
+type style struct {
+	fill        color.Color
+	stroke      color.Color
+	strokeWidth float64
+}
+
+// parsePieceSVG reads the path and the circle elements, the fill and the stroke attributes are inherited from the groups
+func parsePieceSVG(r io.Reader) ([]shape, error) {
+	dec := xml.NewDecoder(r)
+
+	styles := []style{{fill: color.Black, strokeWidth: 1}}
+
+	var shapes []shape
+
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			st, err := applyStyle(styles[len(styles)-1], t.Attr)
+			if err != nil {
+				return nil, err
+			}
+
+			styles = append(styles, st)
+
+			var s *shape
+
+			switch t.Name.Local {
+			case "path":
+				s, err = newPathShape(t.Attr)
+			case "circle":
+				s, err = newCircleShape(t.Attr)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			if s != nil {
+				s.fill, s.stroke, s.strokeWidth = st.fill, st.stroke, st.strokeWidth
+				shapes = append(shapes, *s)
+			}
+		case xml.EndElement:
+			styles = styles[:len(styles)-1]
+		}
+	}
+
+	return shapes, nil
+}
+
+func applyStyle(st style, attrs []xml.Attr) (style, error) {
+	for _, attr := range attrs {
+		var err error
+
+		switch attr.Name.Local {
+		case "fill":
+			st.fill, err = parseColor(attr.Value)
+		case "stroke":
+			st.stroke, err = parseColor(attr.Value)
+		case "stroke-width":
+			st.strokeWidth, err = strconv.ParseFloat(attr.Value, 64)
+		}
+
+		if err != nil {
+			return st, fmt.Errorf("invalid %s attribute %q: %w", attr.Name.Local, attr.Value, err)
+		}
+	}
+
+	return st, nil
+}
+
+// geometryAttrs are the attributes written back to the svg symbols, the presentation attributes are written from the style
+var geometryAttrs = []string{"d", "cx", "cy", "r"}
+
+func newPathShape(attrs []xml.Attr) (*shape, error) {
+	d := attrValue(attrs, "d")
+
+	subpaths, closed, err := parsePathData(d)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %q: %w", d, err)
+	}
+
+	return &shape{element: "path", attrs: filterAttrs(attrs), subpaths: subpaths, closed: closed}, nil
+}
+
+func newCircleShape(attrs []xml.Attr) (*shape, error) {
+	var c [3]float64
+
+	for i, name := range []string{"cx", "cy", "r"} {
+		v, err := strconv.ParseFloat(attrValue(attrs, name), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid circle %s: %w", name, err)
+		}
+
+		c[i] = v
+	}
+
+	return &shape{element: "circle", attrs: filterAttrs(attrs), subpaths: [][]point{circlePolygon(point{c[0], c[1]}, c[2], 32)}, closed: []bool{true}}, nil
+}
+
+func attrValue(attrs []xml.Attr, name string) string {
+	for _, attr := range attrs {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
+func filterAttrs(attrs []xml.Attr) []xml.Attr {
+	return slices.DeleteFunc(slices.Clone(attrs), func(attr xml.Attr) bool {
+		return !slices.Contains(geometryAttrs, attr.Name.Local)
+	})
+}
+
+// parseColor reads the `#rgb`, `#rrggbb`, `none`, `white` and `black` colors, none is the nil color
+func parseColor(s string) (color.Color, error) {
+	switch s = strings.TrimSpace(strings.ToLower(s)); s {
+	case "none":
+		return nil, nil
+	case "white":
+		return color.White, nil
+	case "black":
+		return color.Black, nil
+	}
+
+	hex, ok := strings.CutPrefix(s, "#")
+	if !ok || (len(hex) != 3 && len(hex) != 6) {
+		return nil, fmt.Errorf("unsupported color %q", s)
+	}
+
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported color %q", s)
+	}
+
+	return color.NRGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xff}, nil
+}
+
+// curveSegments is the number of the lines every bezier curve is flattened to
+const curveSegments = 16
+
+// parsePathData flattens the M, L, H, V, Q, C and Z commands (and their relative versions) to the polygons
+func parsePathData(d string) ([][]point, []bool, error) {
+	tokens, err := tokenizePathData(d)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		subpaths [][]point
+		closed   []bool
+		current  []point
+		pos      point
+		start    point
+		cmd      byte
+	)
+
+	flush := func(close bool) {
+		if len(current) > 1 {
+			subpaths = append(subpaths, current)
+			closed = append(closed, close)
+		}
+
+		current = nil
+	}
+
+	nums := func(n int) ([]float64, error) {
+		if len(tokens) < n {
+			return nil, fmt.Errorf("missing %c command arguments", cmd)
+		}
+
+		vals := make([]float64, n)
+		for i := range n {
+			v, err := strconv.ParseFloat(tokens[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %c command argument %q", cmd, tokens[i])
+			}
+
+			vals[i] = v
+		}
+
+		tokens = tokens[n:]
+
+		return vals, nil
+	}
+
+	for len(tokens) > 0 {
+		if c := tokens[0][0]; isPathCommand(c) {
+			cmd = c
+			tokens = tokens[1:]
+		} else if cmd == 0 {
+			return nil, nil, fmt.Errorf("path data must start with the command")
+		}
+
+		rel := cmd >= 'a'
+		abs := func(x, y float64) point {
+			if rel {
+				return point{pos.x + x, pos.y + y}
+			}
+
+			return point{x, y}
+		}
+
+		switch cmd | 0x20 {
+		case 'm':
+			v, err := nums(2)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			flush(false)
+
+			pos = abs(v[0], v[1])
+			start = pos
+			current = []point{pos}
+
+			// the next coordinate pairs without the command are the implicit lines
+			cmd = 'L' | (cmd & 0x20)
+		case 'l':
+			v, err := nums(2)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			pos = abs(v[0], v[1])
+			current = append(current, pos)
+		case 'h':
+			v, err := nums(1)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if rel {
+				pos.x += v[0]
+			} else {
+				pos.x = v[0]
+			}
+
+			current = append(current, pos)
+		case 'v':
+			v, err := nums(1)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if rel {
+				pos.y += v[0]
+			} else {
+				pos.y = v[0]
+			}
+
+			current = append(current, pos)
+		case 'q':
+			v, err := nums(4)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			p0, p1, p2 := pos, abs(v[0], v[1]), abs(v[2], v[3])
+			for i := 1; i <= curveSegments; i++ {
+				t := float64(i) / curveSegments
+				u := 1 - t
+				current = append(current, point{
+					u*u*p0.x + 2*u*t*p1.x + t*t*p2.x,
+					u*u*p0.y + 2*u*t*p1.y + t*t*p2.y,
+				})
+			}
+
+			pos = p2
+		case 'c':
+			v, err := nums(6)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			p0, p1, p2, p3 := pos, abs(v[0], v[1]), abs(v[2], v[3]), abs(v[4], v[5])
+			for i := 1; i <= curveSegments; i++ {
+				t := float64(i) / curveSegments
+				u := 1 - t
+				current = append(current, point{
+					u*u*u*p0.x + 3*u*u*t*p1.x + 3*u*t*t*p2.x + t*t*t*p3.x,
+					u*u*u*p0.y + 3*u*u*t*p1.y + 3*u*t*t*p2.y + t*t*t*p3.y,
+				})
+			}
+
+			pos = p3
+		case 'z':
+			flush(true)
+
+			pos = start
+			current = []point{pos}
+		}
+	}
+
+	flush(false)
+
+	return subpaths, closed, nil
+}
+
+func isPathCommand(c byte) bool {
+	return strings.IndexByte("MmLlHhVvQqCcZz", c) >= 0
+}
+
+// tokenizePathData splits the path data to the commands and the numbers, the numbers can be separated
+// by the spaces, the commas or only by the sign
+func tokenizePathData(d string) ([]string, error) {
+	var tokens []string
+
+	for i := 0; i < len(d); {
+		c := d[i]
+
+		switch {
+		case c == ' ' || c == ',' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case isPathCommand(c):
+			tokens = append(tokens, d[i:i+1])
+			i++
+		case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+			j := i + 1
+			dot := c == '.'
+
+			for j < len(d) && ((d[j] >= '0' && d[j] <= '9') || (d[j] == '.' && !dot)) {
+				dot = dot || d[j] == '.'
+				j++
+			}
+
+			tokens = append(tokens, d[i:j])
+			i = j
+		default:
+			return nil, fmt.Errorf("unsupported path data character %q", c)
+		}
+	}
+
+	return tokens, nil
+}
+
+func circlePolygon(c point, r float64, segments int) []point {
+	poly := make([]point, segments)
+	for i := range poly {
+		a := 2 * math.Pi * float64(i) / float64(segments)
+		poly[i] = point{c.x + r*math.Cos(a), c.y + r*math.Sin(a)}
+	}
+
+	return poly
+}
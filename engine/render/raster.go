@@ -0,0 +1,214 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"slices"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+type point struct {
+	x, y float64
+}
+
+// subScanlines is the number of the samples per pixel row, the horizontal coverage is calculated exactly
+const subScanlines = 5
+
+type crossing struct {
+	x       float64
+	winding int
+}
+
+// fillMask rasterizes the polygons with the nonzero fill rule to the antialiased coverage mask
+func fillMask(bounds image.Rectangle, polygons [][]point) *image.Alpha {
+	mask := image.NewAlpha(bounds)
+	acc := make([]float64, bounds.Dx())
+
+	var crossings []crossing
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		clear(acc)
+
+		for s := range subScanlines {
+			sy := float64(y) + (float64(s)+0.5)/subScanlines
+			crossings = crossings[:0]
+
+			for _, poly := range polygons {
+				for i := range poly {
+					a, b := poly[i], poly[(i+1)%len(poly)]
+					if a.y == b.y || sy < min(a.y, b.y) || sy >= max(a.y, b.y) {
+						continue
+					}
+
+					winding := 1
+					if a.y > b.y {
+						winding = -1
+					}
+
+					crossings = append(crossings, crossing{x: a.x + (sy-a.y)*(b.x-a.x)/(b.y-a.y), winding: winding})
+				}
+			}
+
+			slices.SortFunc(crossings, func(a, b crossing) int {
+				switch {
+				case a.x < b.x:
+					return -1
+				case a.x > b.x:
+					return 1
+				}
+
+				return 0
+			})
+
+			winding := 0
+			for i, c := range crossings {
+				winding += c.winding
+				if winding != 0 && i+1 < len(crossings) {
+					addSpan(acc, bounds.Min.X, c.x, crossings[i+1].x)
+				}
+			}
+		}
+
+		for i, v := range acc {
+			mask.Pix[(y-bounds.Min.Y)*mask.Stride+i] = uint8(min(v/subScanlines, 1) * 0xff)
+		}
+	}
+
+	return mask
+}
+
+// addSpan adds the covered part of every pixel between x0 and x1 to the row coverage
+func addSpan(acc []float64, minX int, x0, x1 float64) {
+	x0, x1 = max(x0-float64(minX), 0), min(x1-float64(minX), float64(len(acc)))
+
+	for px := int(x0); px < len(acc) && float64(px) < x1; px++ {
+		acc[px] += min(x1, float64(px+1)) - max(x0, float64(px))
+	}
+}
+
+// strokePolygons converts the polyline to the polygons of its segments and the round joins, all of them
+// have the same orientation so they are merged by the nonzero fill rule
+func strokePolygons(line []point, closed bool, width float64) [][]point {
+	if width <= 0 || len(line) == 0 {
+		return nil
+	}
+
+	r := width / 2
+	polygons := make([][]point, 0, 2*len(line))
+
+	n := len(line) - 1
+	if closed {
+		n = len(line)
+	}
+
+	for i := range n {
+		a, b := line[i], line[(i+1)%len(line)]
+
+		dx, dy := b.x-a.x, b.y-a.y
+
+		length := math.Hypot(dx, dy)
+		if length == 0 {
+			continue
+		}
+
+		nx, ny := -dy/length*r, dx/length*r
+		polygons = append(polygons, orient([]point{{a.x + nx, a.y + ny}, {b.x + nx, b.y + ny}, {b.x - nx, b.y - ny}, {a.x - nx, a.y - ny}}))
+	}
+
+	for _, p := range line {
+		polygons = append(polygons, orient(circlePolygon(p, r, 12)))
+	}
+
+	return polygons
+}
+
+// orient makes the polygon clockwise in the image coordinates
+func orient(poly []point) []point {
+	area := 0.0
+	for i := range poly {
+		a, b := poly[i], poly[(i+1)%len(poly)]
+		area += a.x*b.y - b.x*a.y
+	}
+
+	if area < 0 {
+		slices.Reverse(poly)
+	}
+
+	return poly
+}
+
+// transform scales and moves the polygons from the piece coordinates to the image
+func transform(polygons [][]point, scale float64, offset point) [][]point {
+	out := make([][]point, len(polygons))
+	for i, poly := range polygons {
+		out[i] = make([]point, len(poly))
+		for j, p := range poly {
+			out[i][j] = point{offset.x + p.x*scale, offset.y + p.y*scale}
+		}
+	}
+
+	return out
+}
+
+// fillPolygons paints the polygons with the color over the image
+func fillPolygons(dst draw.Image, polygons [][]point, c color.Color) {
+	bounds := polygonBounds(polygons).Intersect(dst.Bounds())
+	if bounds.Empty() {
+		return
+	}
+
+	draw.DrawMask(dst, bounds, image.NewUniform(c), image.Point{}, fillMask(bounds, polygons), bounds.Min, draw.Over)
+}
+
+func polygonBounds(polygons [][]point) image.Rectangle {
+	minX, minY, maxX, maxY := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+
+	for _, poly := range polygons {
+		for _, p := range poly {
+			minX, minY, maxX, maxY = min(minX, p.x), min(minY, p.y), max(maxX, p.x), max(maxY, p.y)
+		}
+	}
+
+	if minX > maxX {
+		return image.Rectangle{}
+	}
+
+	return image.Rect(int(math.Floor(minX)), int(math.Floor(minY)), int(math.Ceil(maxX)), int(math.Ceil(maxY)))
+}
+
+// rasterize draws the piece shapes of the square size in the order of the svg elements
+func (ps *pieceSet) rasterize(piece engine.Piece, size int) *image.RGBA {
+	key := pieceImageKey{piece: piece, size: size}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	if img, ok := ps.images[key]; ok {
+		return img
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	scale := float64(size) / pieceViewBox
+
+	for _, s := range ps.pieces[piece] {
+		if s.fill != nil {
+			fillPolygons(img, transform(s.subpaths, scale, point{}), s.fill)
+		}
+
+		if s.stroke != nil {
+			var polygons [][]point
+			for i, subpath := range s.subpaths {
+				polygons = append(polygons, strokePolygons(subpath, s.closed[i], s.strokeWidth)...)
+			}
+
+			fillPolygons(img, transform(polygons, scale, point{}), s.stroke)
+		}
+	}
+
+	ps.images[key] = img
+
+	return img
+}
@@ -0,0 +1,211 @@
+// Package render draws the chess board diagrams to the svg, png and animated gif images
+// with the embedded piece sets and without any external dependencies
+package render
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+// DefaultSize is the board width and height when the options don't set the size
+const DefaultSize = 360
+
+// Diagram is the board with the marks drawn over it
+type Diagram struct {
+	Board engine.Board
+	// LastMove are the highlighted squares of the last move
+	LastMove []engine.Square
+	// Check are the squares of the kings in check
+	Check  []engine.Square
+	Arrows []Arrow
+}
+
+// Arrow is the arrow drawn from the center of the square to the center of the square
+type Arrow struct {
+	From  engine.Square
+	To    engine.Square
+	Color color.Color
+}
+
+// NewDiagram returns the diagram of the position with the last move in the uci notation (empty for no move),
+// the king of the side to move is highlighted when it is in check
+func NewDiagram(p *engine.Position, lastMoveUCI string) Diagram {
+	d := Diagram{Board: p.Board.Copy(), LastMove: ParseLastMove(lastMoveUCI)}
+
+	if p.Check {
+		king := engine.NewPiece(engine.King, p.Turn)
+
+		for sq := engine.A1; sq <= engine.H8; sq++ {
+			if p.Board.PieceAt(sq) == king {
+				d.Check = append(d.Check, sq)
+			}
+		}
+	}
+
+	return d
+}
+
+// ParseLastMove returns the source and the destination squares of the uci move, the drops (e.g. `N@f3`)
+// have only the destination and the invalid move has none
+func ParseLastMove(uci string) []engine.Square {
+	if len(uci) < 4 {
+		return nil
+	}
+
+	if uci[1] == '@' {
+		dest, err := engine.NewSquareFromCoord(uci[2:4])
+		if err != nil {
+			return nil
+		}
+
+		return []engine.Square{dest}
+	}
+
+	src, err1 := engine.NewSquareFromCoord(uci[0:2])
+
+	dest, err2 := engine.NewSquareFromCoord(uci[2:4])
+	if err1 != nil || err2 != nil {
+		return nil
+	}
+
+	return []engine.Square{src, dest}
+}
+
+// Theme are the colors of the board and the marks, the translucent colors are drawn over the squares
+type Theme struct {
+	Light    color.NRGBA
+	Dark     color.NRGBA
+	LastMove color.NRGBA
+	Check    color.NRGBA
+	Arrow    color.NRGBA
+}
+
+var DefaultTheme = Theme{
+	Light:    color.NRGBA{R: 0xf0, G: 0xd9, B: 0xb5, A: 0xff},
+	Dark:     color.NRGBA{R: 0xb5, G: 0x88, B: 0x63, A: 0xff},
+	LastMove: color.NRGBA{R: 0x9b, G: 0xc7, B: 0x00, A: 0x69},
+	Check:    color.NRGBA{R: 0xff, G: 0x00, B: 0x00, A: 0xff},
+	Arrow:    color.NRGBA{R: 0x15, G: 0x78, B: 0x1b, A: 0xcc},
+}
+
+// Options are the drawing options, the nil options draw the board of the default size from the white side
+type Options struct {
+	// Size is the board width and height in pixels, it is rounded down to the multiple of 8
+	Size int
+	// Flip draws the board from the black side
+	Flip bool
+	// Coordinates draws the files on the bottom and the ranks on the left edge squares
+	Coordinates bool
+	// PieceSet is the name of the embedded piece set
+	PieceSet string
+	Theme    *Theme
+}
+
+func (o *Options) normalized() Options {
+	opts := Options{}
+	if o != nil {
+		opts = *o
+	}
+
+	if opts.Size < 8 {
+		opts.Size = DefaultSize
+	}
+
+	opts.Size -= opts.Size % 8
+
+	if opts.Theme == nil {
+		opts.Theme = &DefaultTheme
+	}
+
+	if opts.PieceSet == "" {
+		opts.PieceSet = DefaultPieceSet
+	}
+
+	return opts
+}
+
+func (o Options) squareSize() int {
+	return o.Size / 8
+}
+
+// squareOrigin returns the top left corner of the square in the squares
+func (o Options) squareOrigin(sq engine.Square) (int, int) {
+	col, row := int(sq.File()), 7-int(sq.Rank())
+	if o.Flip {
+		col, row = 7-col, 7-row
+	}
+
+	return col, row
+}
+
+// squareCenter returns the center of the square in pixels
+func (o Options) squareCenter(sq engine.Square) point {
+	col, row := o.squareOrigin(sq)
+	size := float64(o.squareSize())
+
+	return point{(float64(col) + 0.5) * size, (float64(row) + 0.5) * size}
+}
+
+// arrowPolygon is the shaft with the head ending in the center of the destination square
+func (o Options) arrowPolygon(a Arrow) []point {
+	from, to := o.squareCenter(a.From), o.squareCenter(a.To)
+	size := float64(o.squareSize())
+
+	dx, dy := to.x-from.x, to.y-from.y
+
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return nil
+	}
+
+	ux, uy := dx/length, dy/length
+	nx, ny := -uy, ux
+
+	shaft, head, headLength := size*0.08, size*0.22, min(size*0.38, length)
+	base := point{to.x - ux*headLength, to.y - uy*headLength}
+
+	return []point{
+		{from.x + nx*shaft, from.y + ny*shaft},
+		{base.x + nx*shaft, base.y + ny*shaft},
+		{base.x + nx*head, base.y + ny*head},
+		to,
+		{base.x - nx*head, base.y - ny*head},
+		{base.x - nx*shaft, base.y - ny*shaft},
+		{from.x - nx*shaft, from.y - ny*shaft},
+	}
+}
+
+// arrowColor returns the arrow color or the theme color for the arrow without the color
+func (o Options) arrowColor(a Arrow) color.NRGBA {
+	if a.Color == nil {
+		return o.Theme.Arrow
+	}
+
+	return color.NRGBAModel.Convert(a.Color).(color.NRGBA)
+}
+
+// checkStops are the offsets from the square center to the farthest corner with the alpha of the check color,
+// the check is the red glow fading out to the square edges
+var checkStops = [...]struct {
+	offset float64
+	alpha  float64
+}{
+	{offset: 0, alpha: 1},
+	{offset: 0.25, alpha: 0.9},
+	{offset: 0.89, alpha: 0},
+}
+
+// checkAlpha returns the alpha of the check glow at the distance from the square center (1 is the square corner)
+func checkAlpha(offset float64) float64 {
+	for i := 1; i < len(checkStops); i++ {
+		prev, next := checkStops[i-1], checkStops[i]
+		if offset <= next.offset {
+			t := (offset - prev.offset) / (next.offset - prev.offset)
+			return prev.alpha + t*(next.alpha-prev.alpha)
+		}
+	}
+
+	return 0
+}
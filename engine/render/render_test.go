@@ -0,0 +1,265 @@
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+func newDiagram(t *testing.T, fen, lastMove string) Diagram {
+	t.Helper()
+
+	p := &engine.Position{}
+	if err := p.LoadFromFEN(fen); err != nil {
+		t.Fatalf("failed to load fen: %v", err)
+	}
+
+	return NewDiagram(p, lastMove)
+}
+
+func TestParseLastMove(t *testing.T) {
+	testCases := map[string]struct {
+		uci  string
+		want []engine.Square
+	}{
+		"quiet move":     {uci: "e2e4", want: []engine.Square{engine.E2, engine.E4}},
+		"promotion":      {uci: "a7a8q", want: []engine.Square{engine.A7, engine.A8}},
+		"drop":           {uci: "N@f3", want: []engine.Square{engine.F3}},
+		"no move":        {uci: "", want: nil},
+		"invalid square": {uci: "e9e4", want: nil},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := ParseLastMove(tc.uci); !slices.Equal(got, tc.want) {
+				t.Fatalf("invalid last move squares: want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNewDiagramCheck(t *testing.T) {
+	d := newDiagram(t, "rnb1kbnr/pppp1ppp/8/4p3/6Pq/5P2/PPPPP2P/RNBQKBNR w KQkq - 1 3", "d8h4")
+
+	if want := []engine.Square{engine.E1}; !slices.Equal(d.Check, want) {
+		t.Fatalf("invalid check squares: want %v, got %v", want, d.Check)
+	}
+
+	if want := []engine.Square{engine.D8, engine.H4}; !slices.Equal(d.LastMove, want) {
+		t.Fatalf("invalid last move squares: want %v, got %v", want, d.LastMove)
+	}
+}
+
+func TestSVG(t *testing.T) {
+	d := newDiagram(t, engine.FENStartingPosition, "")
+	d.Arrows = []Arrow{{From: engine.E2, To: engine.E4}}
+
+	testCases := map[string]struct {
+		opts    *Options
+		want    []string
+		notWant []string
+	}{
+		"default options": {
+			opts:    nil,
+			want:    []string{`width="360" height="360"`, `<use href="#wK" x="180" y="315" width="45" height="45"/>`, `<polygon points=`},
+			notWant: []string{`<text`, `url(#check)`},
+		},
+		"flipped with coordinates": {
+			opts: &Options{Size: 480, Flip: true, Coordinates: true},
+			want: []string{`width="480" height="480"`, `<use href="#wK" x="180" y="0" width="60" height="60"/>`, `>a</text>`, `>8</text>`},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := SVG(&buf, d, tc.opts); err != nil {
+				t.Fatalf("failed to render svg: %v", err)
+			}
+
+			out := buf.String()
+
+			dec := xml.NewDecoder(strings.NewReader(out))
+			for {
+				_, err := dec.Token()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+
+				if err != nil {
+					t.Fatalf("invalid svg xml: %v", err)
+				}
+			}
+
+			if got := strings.Count(out, "<use "); got != 32 {
+				t.Fatalf("invalid pieces count: want 32, got %d", got)
+			}
+
+			if got := strings.Count(out, "<symbol "); got != 12 {
+				t.Fatalf("invalid symbols count: want 12, got %d", got)
+			}
+
+			for _, s := range tc.want {
+				if !strings.Contains(out, s) {
+					t.Fatalf("invalid svg: want %q in\n%s", s, out)
+				}
+			}
+
+			for _, s := range tc.notWant {
+				if strings.Contains(out, s) {
+					t.Fatalf("invalid svg: don't want %q in\n%s", s, out)
+				}
+			}
+		})
+	}
+}
+
+func TestPNG(t *testing.T) {
+	d := newDiagram(t, "rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq - 0 1", "e2e4")
+
+	var buf bytes.Buffer
+	if err := PNG(&buf, d, &Options{Size: 100}); err != nil {
+		t.Fatalf("failed to render png: %v", err)
+	}
+
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("failed to decode png: %v", err)
+	}
+
+	if got := img.Bounds().Dx(); got != 96 {
+		t.Fatalf("invalid size: want 96, got %d", got)
+	}
+
+	rgba := func(x, y int) color.RGBA {
+		return color.RGBAModel.Convert(img.At(x, y)).(color.RGBA)
+	}
+
+	// the centers of the empty d4 (dark) and d5 (light) squares, the corner of the highlighted e2 and e4 squares
+	testCases := map[string]struct {
+		x, y int
+		want color.RGBA
+	}{
+		"dark square":            {x: 3*12 + 6, y: 4*12 + 6, want: opaque(DefaultTheme.Dark)},
+		"light square":           {x: 3*12 + 6, y: 3*12 + 6, want: opaque(DefaultTheme.Light)},
+		"highlighted from light": {x: 4 * 12, y: 6 * 12, want: blend(DefaultTheme.LastMove, DefaultTheme.Light)},
+		"highlighted to light":   {x: 4 * 12, y: 4 * 12, want: blend(DefaultTheme.LastMove, DefaultTheme.Light)},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := rgba(tc.x, tc.y); got != tc.want {
+				t.Fatalf("invalid pixel color: want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGIF(t *testing.T) {
+	chess, err := engine.NewChess(engine.FENStartingPosition)
+	if err != nil {
+		t.Fatalf("failed to create chess: %v", err)
+	}
+
+	frames := []Diagram{NewDiagram(chess.Position, "")}
+	for _, uci := range []string{"e2e4", "e7e5", "g1f3"} {
+		if _, err := chess.MakeMoveUCI(uci); err != nil {
+			t.Fatalf("failed to make move %s: %v", uci, err)
+		}
+
+		frames = append(frames, NewDiagram(chess.Position, uci))
+	}
+
+	var buf bytes.Buffer
+	if err := GIF(&buf, frames, 500*time.Millisecond, &Options{Size: 160}); err != nil {
+		t.Fatalf("failed to render gif: %v", err)
+	}
+
+	anim, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatalf("failed to decode gif: %v", err)
+	}
+
+	if len(anim.Image) != len(frames) {
+		t.Fatalf("invalid frames count: want %d, got %d", len(frames), len(anim.Image))
+	}
+
+	if want := []int{50, 50, 50, 150}; !slices.Equal(anim.Delay, want) {
+		t.Fatalf("invalid delays: want %v, got %v", want, anim.Delay)
+	}
+
+	if anim.Config.Width != 160 || anim.Config.Height != 160 {
+		t.Fatalf("invalid gif size: want 160x160, got %dx%d", anim.Config.Width, anim.Config.Height)
+	}
+
+	// only the changed squares are stored after the first frame
+	if got := anim.Image[1].Bounds(); got.Dx() >= 160 || got.Dy() >= 160 {
+		t.Fatalf("invalid frame bounds: want the changed part, got %v", got)
+	}
+
+	if err := GIF(io.Discard, nil, time.Second, nil); !errors.Is(err, ErrNoFrames) {
+		t.Fatalf("invalid gif error: want %v, got %v", ErrNoFrames, err)
+	}
+}
+
+func TestPieceSets(t *testing.T) {
+	if !slices.Contains(PieceSets(), DefaultPieceSet) {
+		t.Fatalf("invalid piece sets: want %s in %v", DefaultPieceSet, PieceSets())
+	}
+
+	d := newDiagram(t, engine.FENStartingPosition, "")
+	if err := SVG(io.Discard, d, &Options{PieceSet: "missing"}); !errors.Is(err, ErrPieceSetNotFound) {
+		t.Fatalf("invalid piece set error: want %v, got %v", ErrPieceSetNotFound, err)
+	}
+}
+
+func TestParsePathData(t *testing.T) {
+	testCases := map[string]struct {
+		d      string
+		want   [][]point
+		closed []bool
+	}{
+		"absolute lines": {
+			d:      "M 1 1 L 4 1 L 4 5 Z",
+			want:   [][]point{{{1, 1}, {4, 1}, {4, 5}}},
+			closed: []bool{true},
+		},
+		"relative lines with implicit commands": {
+			d:      "m1,1 3,0 0,4 h-3 v-2",
+			want:   [][]point{{{1, 1}, {4, 1}, {4, 5}, {1, 5}, {1, 3}}},
+			closed: []bool{false},
+		},
+		"two subpaths": {
+			d:      "M0 0L2 0M5-1l0 2",
+			want:   [][]point{{{0, 0}, {2, 0}}, {{5, -1}, {5, 1}}},
+			closed: []bool{false, false},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			subpaths, closed, err := parsePathData(tc.d)
+			if err != nil {
+				t.Fatalf("failed to parse path data: %v", err)
+			}
+
+			if !slices.EqualFunc(subpaths, tc.want, slices.Equal) || !slices.Equal(closed, tc.closed) {
+				t.Fatalf("invalid subpaths: want %v %v, got %v %v", tc.want, tc.closed, subpaths, closed)
+			}
+		})
+	}
+
+	if _, _, err := parsePathData("M 0 0 A 1 1 0 0 1 2 2"); err == nil {
+		t.Fatalf("invalid path data error: want the unsupported arc error, got nil")
+	}
+}
@@ -0,0 +1,184 @@
+package render
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+// SVG writes the diagram as the svg image, the used pieces are defined once as the symbols
+func SVG(w io.Writer, d Diagram, opts *Options) error {
+	o := opts.normalized()
+
+	ps, err := loadPieceSet(o.PieceSet)
+	if err != nil {
+		return err
+	}
+
+	size := o.squareSize()
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, o.Size, o.Size, o.Size, o.Size)
+	bw.WriteString("\n<defs>\n")
+
+	if len(d.Check) > 0 {
+		bw.WriteString(`<radialGradient id="check" r="0.71">`)
+		for _, stop := range checkStops {
+			fmt.Fprintf(bw, `<stop offset="%s" stop-color="%s" stop-opacity="%s"/>`, formatFloat(stop.offset), hexColor(o.Theme.Check), formatFloat(stop.alpha*float64(o.Theme.Check.A)/0xff))
+		}
+		bw.WriteString("</radialGradient>\n")
+	}
+
+	defined := map[engine.Piece]bool{}
+	for sq := engine.A1; sq <= engine.H8; sq++ {
+		piece := d.Board.PieceAt(sq)
+		if piece == engine.PieceNone || defined[piece] {
+			continue
+		}
+
+		defined[piece] = true
+
+		fmt.Fprintf(bw, `<symbol id="%s" viewBox="0 0 %d %d">`, pieceID(piece), pieceViewBox, pieceViewBox)
+		for _, s := range ps.pieces[piece] {
+			writeShape(bw, s)
+		}
+		bw.WriteString("</symbol>\n")
+	}
+
+	bw.WriteString("</defs>\n")
+
+	squareAttrs := func(sq engine.Square) string {
+		col, row := o.squareOrigin(sq)
+		return fmt.Sprintf(`x="%d" y="%d" width="%d" height="%d"`, col*size, row*size, size, size)
+	}
+
+	fmt.Fprintf(bw, `<rect width="%d" height="%d" fill="%s"/>`+"\n", o.Size, o.Size, hexColor(o.Theme.Light))
+
+	for sq := engine.A1; sq <= engine.H8; sq++ {
+		if sq.IsDark() {
+			fmt.Fprintf(bw, `<rect %s fill="%s"/>`+"\n", squareAttrs(sq), hexColor(o.Theme.Dark))
+		}
+	}
+
+	for _, sq := range d.LastMove {
+		fmt.Fprintf(bw, `<rect %s %s/>`+"\n", squareAttrs(sq), fillAttrs(o.Theme.LastMove))
+	}
+
+	for _, sq := range d.Check {
+		fmt.Fprintf(bw, `<rect %s fill="url(#check)"/>`+"\n", squareAttrs(sq))
+	}
+
+	if o.Coordinates {
+		writeCoordinates(bw, o)
+	}
+
+	for sq := engine.A1; sq <= engine.H8; sq++ {
+		if piece := d.Board.PieceAt(sq); piece != engine.PieceNone {
+			fmt.Fprintf(bw, `<use href="#%s" %s/>`+"\n", pieceID(piece), squareAttrs(sq))
+		}
+	}
+
+	for _, a := range d.Arrows {
+		poly := o.arrowPolygon(a)
+		if poly == nil {
+			continue
+		}
+
+		points := make([]string, len(poly))
+		for i, p := range poly {
+			points[i] = formatFloat(p.x) + "," + formatFloat(p.y)
+		}
+
+		fmt.Fprintf(bw, `<polygon points="%s" %s/>`+"\n", strings.Join(points, " "), fillAttrs(o.arrowColor(a)))
+	}
+
+	bw.WriteString("</svg>\n")
+
+	return bw.Flush()
+}
+
+// writeCoordinates writes the file letters and the rank numbers at the same places as the png coordinates
+func writeCoordinates(w io.Writer, o Options) {
+	size := o.squareSize()
+	fontSize := formatFloat(float64(size) * 0.2)
+	margin := float64(size) * 0.06
+
+	for i := range 8 {
+		file, rank := engine.Square(i), engine.Square(i*8)
+		if o.Flip {
+			file, rank = engine.Square(7-i+56), engine.Square((7-i)*8+7)
+		}
+
+		c := o.Theme.Light
+		if file.IsLight() {
+			c = o.Theme.Dark
+		}
+
+		fmt.Fprintf(w, `<text x="%s" y="%s" font-family="sans-serif" font-size="%s" font-weight="bold" text-anchor="end" fill="%s">%c</text>`+"\n",
+			formatFloat(float64((i+1)*size)-margin), formatFloat(float64(o.Size)-margin), fontSize, hexColor(c), "abcdefgh"[file.File()])
+
+		c = o.Theme.Light
+		if rank.IsLight() {
+			c = o.Theme.Dark
+		}
+
+		fmt.Fprintf(w, `<text x="%s" y="%s" font-family="sans-serif" font-size="%s" font-weight="bold" dominant-baseline="hanging" fill="%s">%c</text>`+"\n",
+			formatFloat(margin), formatFloat(float64((7-i)*size)+margin), fontSize, hexColor(c), "12345678"[rank.Rank()])
+	}
+}
+
+// writeShape writes the piece element with the resolved style so the symbol doesn't depend on the groups
+func writeShape(w io.Writer, s shape) {
+	fmt.Fprintf(w, "<%s", s.element)
+
+	for _, attr := range s.attrs {
+		fmt.Fprintf(w, ` %s="`, attr.Name.Local)
+		_ = xml.EscapeText(w, []byte(attr.Value))
+		io.WriteString(w, `"`)
+	}
+
+	fill, stroke := "none", "none"
+	if s.fill != nil {
+		fill = hexColor(color.NRGBAModel.Convert(s.fill).(color.NRGBA))
+	}
+
+	if s.stroke != nil {
+		stroke = hexColor(color.NRGBAModel.Convert(s.stroke).(color.NRGBA))
+	}
+
+	fmt.Fprintf(w, ` fill="%s" stroke="%s"`, fill, stroke)
+
+	if s.stroke != nil {
+		fmt.Fprintf(w, ` stroke-width="%s" stroke-linecap="round" stroke-linejoin="round"`, formatFloat(s.strokeWidth))
+	}
+
+	io.WriteString(w, "/>")
+}
+
+// pieceID is the symbol id of the piece e.g. `wK` or `bN`
+func pieceID(piece engine.Piece) string {
+	return strings.TrimSuffix(pieceFileName(piece), ".svg")
+}
+
+func fillAttrs(c color.NRGBA) string {
+	if c.A == 0xff {
+		return fmt.Sprintf(`fill="%s"`, hexColor(c))
+	}
+
+	return fmt.Sprintf(`fill="%s" fill-opacity="%s"`, hexColor(c), formatFloat(float64(c.A)/0xff))
+}
+
+func hexColor(c color.NRGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(math.Round(f*1000)/1000, 'f', -1, 64)
+}
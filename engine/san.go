@@ -0,0 +1,246 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrSANInvalid   = errors.New("invalid san move")
+	ErrSANIllegal   = errors.New("illegal san move")
+	ErrSANAmbiguous = errors.New("ambiguous san move")
+)
+
+const (
+	sanPieceSymbols     = "KQRBN"
+	sanPromotionSymbols = "QRBN"
+)
+
+// sanToken is the parsed SAN move before it is matched against the legal moves
+type sanToken struct {
+	piece           PieceKind
+	srcFile         File
+	srcRank         Rank
+	dest            Square
+	promotion       Promotion
+	capture         bool
+	drop            bool
+	kingSideCastle  bool
+	queenSideCastle bool
+}
+
+// trimSANSuffix removes the check, checkmate and annotation glyphs from the end of the san move
+// e.g. "Nf3+!?" -> "Nf3", "e8=Q#" -> "e8=Q", "exd6 e.p." -> "exd6"
+func trimSANSuffix(san string) string {
+	san = strings.TrimSuffix(strings.TrimSpace(san), "e.p.")
+
+	return strings.TrimRight(san, "+#!? \t")
+}
+
+// parseSANToken parses the san move string without knowing the position
+//
+// <SAN move descriptor piece moves>   ::= <Piece symbol>[<from file>|<from rank>|<from square>]['x']<to square>
+// <SAN move descriptor pawn captures> ::= <from file>[<from rank>] 'x' <to square>[<promoted to>]
+// <SAN move descriptor pawn push>     ::= <to square>[<promoted to>]
+func parseSANToken(san string) (sanToken, error) {
+	tkn := sanToken{piece: Pawn, srcFile: File(-1), srcRank: Rank(-1), dest: SquareNone}
+
+	s := trimSANSuffix(san)
+	if s == "" {
+		return tkn, fmt.Errorf("%w: empty move %q", ErrSANInvalid, san)
+	}
+
+	switch s {
+	case "O-O", "0-0":
+		tkn.piece = King
+		tkn.kingSideCastle = true
+
+		return tkn, nil
+	case "O-O-O", "0-0-0":
+		tkn.piece = King
+		tkn.queenSideCastle = true
+
+		return tkn, nil
+	}
+
+	// crazyhouse drop is written as N@f3, the pawn drop as P@e4 or just @e4
+	if piece, square, ok := strings.Cut(s, "@"); ok {
+		return parseSANDrop(san, piece, square)
+	}
+
+	if strings.ContainsRune(sanPieceSymbols, rune(s[0])) {
+		tkn.piece = pieceKindFromSANSymbol(s[0])
+		s = s[1:]
+	}
+
+	// promotion is written as `=Q` or just `Q` at the end of a pawn move
+	if n := len(s); n > 0 && strings.ContainsRune(sanPromotionSymbols, rune(s[n-1])) {
+		if tkn.piece != Pawn {
+			return tkn, fmt.Errorf("%w: only pawns can promote %q", ErrSANInvalid, san)
+		}
+
+		tkn.promotion = promotionFromSANSymbol(s[n-1])
+		s = strings.TrimSuffix(s[:n-1], "=")
+	}
+
+	if len(s) < 2 {
+		return tkn, fmt.Errorf("%w: missing destination square %q", ErrSANInvalid, san)
+	}
+
+	dest, err := NewSquareFromCoord(s[len(s)-2:])
+	if err != nil {
+		return tkn, fmt.Errorf("%w: invalid destination square %q", ErrSANInvalid, san)
+	}
+
+	tkn.dest = dest
+	s = s[:len(s)-2]
+
+	if strings.HasSuffix(s, "x") || strings.HasSuffix(s, ":") {
+		tkn.capture = true
+		s = s[:len(s)-1]
+	}
+
+	// the rest (if any) is the disambiguation part which is a file, rank or a full square
+	for _, ch := range s {
+		switch {
+		case ch >= 'a' && ch <= 'h' && tkn.srcFile == File(-1):
+			tkn.srcFile = File(ch - 'a')
+		case ch >= '1' && ch <= '8' && tkn.srcRank == Rank(-1):
+			tkn.srcRank = Rank(ch - '1')
+		default:
+			return tkn, fmt.Errorf("%w: invalid disambiguation %q", ErrSANInvalid, san)
+		}
+	}
+
+	if tkn.piece == Pawn && tkn.capture && tkn.srcFile == File(-1) {
+		return tkn, fmt.Errorf("%w: pawn capture is missing the source file %q", ErrSANInvalid, san)
+	}
+
+	if tkn.piece == Pawn && !tkn.promotion.IsPromotion() && (dest.Rank() == Rank1 || dest.Rank() == Rank8) {
+		return tkn, fmt.Errorf("%w: pawn move to the last rank is missing a promotion %q", ErrSANInvalid, san)
+	}
+
+	return tkn, nil
+}
+
+func parseSANDrop(san, piece, square string) (sanToken, error) {
+	tkn := sanToken{piece: Pawn, srcFile: File(-1), srcRank: Rank(-1), drop: true}
+
+	switch {
+	case piece == "" || piece == "P":
+	case len(piece) == 1 && strings.ContainsRune(sanPromotionSymbols, rune(piece[0])):
+		tkn.piece = pieceKindFromSANSymbol(piece[0])
+	default:
+		return tkn, fmt.Errorf("%w: invalid drop piece %q", ErrSANInvalid, san)
+	}
+
+	dest, err := NewSquareFromCoord(square)
+	if err != nil {
+		return tkn, fmt.Errorf("%w: invalid drop square %q", ErrSANInvalid, san)
+	}
+
+	tkn.dest = dest
+
+	return tkn, nil
+}
+
+// matches checks whether the legal move satisfies the parsed san token
+func (tkn sanToken) matches(m Move) bool {
+	if tkn.drop || m.IsDrop() {
+		return tkn.drop && m.IsDrop() && m.Piece().Kind() == tkn.piece && m.Dest() == tkn.dest
+	}
+
+	if tkn.kingSideCastle {
+		return m.IsKingSideCastle()
+	}
+
+	if tkn.queenSideCastle {
+		return m.IsQueenSideCastle()
+	}
+
+	if m.IsCastle() || m.Piece().Kind() != tkn.piece || m.Dest() != tkn.dest || m.Promotion() != tkn.promotion {
+		return false
+	}
+
+	// the pawn capture is told apart from the push only by the capture marker, so it must be there
+	if tkn.piece == Pawn && tkn.capture != m.IsCapture() {
+		return false
+	}
+
+	if tkn.srcFile != File(-1) && m.Src().File() != tkn.srcFile {
+		return false
+	}
+
+	if tkn.srcRank != Rank(-1) && m.Src().Rank() != tkn.srcRank {
+		return false
+	}
+
+	return true
+}
+
+// ParseSAN finds the legal move in the list of legal moves described by the san move string
+// it accepts check/checkmate suffixes, annotation glyphs (e.g. `!?`) and zero castling (`0-0`)
+func ParseSAN(san string, legalMoves []Move) (Move, error) {
+	tkn, err := parseSANToken(san)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		found      Move
+		candidates []string
+	)
+
+	for _, m := range legalMoves {
+		if tkn.matches(m) {
+			found = m
+			candidates = append(candidates, m.ToUCI())
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return 0, fmt.Errorf("%w: %q", ErrSANIllegal, san)
+	case 1:
+		if tkn.capture && !found.IsCapture() {
+			return 0, fmt.Errorf("%w: %q is not a capture", ErrSANIllegal, san)
+		}
+
+		return found, nil
+	default:
+		return 0, fmt.Errorf("%w: %q matches %s", ErrSANAmbiguous, san, strings.Join(candidates, ", "))
+	}
+}
+
+func pieceKindFromSANSymbol(symbol byte) PieceKind {
+	switch symbol {
+	case 'K':
+		return King
+	case 'Q':
+		return Queen
+	case 'R':
+		return Rook
+	case 'B':
+		return Bishop
+	case 'N':
+		return Knight
+	}
+
+	return PieceKindNone
+}
+
+func promotionFromSANSymbol(symbol byte) Promotion {
+	switch symbol {
+	case 'Q':
+		return PromotionQueen
+	case 'R':
+		return PromotionRook
+	case 'B':
+		return PromotionBishop
+	case 'N':
+		return PromotionKnight
+	}
+
+	return PromotionNone
+}
@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseSAN(t *testing.T) {
+	testCases := map[string]struct {
+		fen     string
+		san     string
+		uci     string
+		wantErr error
+	}{
+		"pawn push":                  {fen: FENStartingPosition, san: "e4", uci: "e2e4"},
+		"pawn single push":           {fen: FENStartingPosition, san: "d3", uci: "d2d3"},
+		"knight move":                {fen: FENStartingPosition, san: "Nf3", uci: "g1f3"},
+		"knight move check suffix":   {fen: FENStartingPosition, san: "Nc3+", uci: "b1c3"},
+		"annotation glyphs":          {fen: FENStartingPosition, san: "e4!?", uci: "e2e4"},
+		"annotation glyphs blunder":  {fen: FENStartingPosition, san: "f3??", uci: "f2f3"},
+		"pawn capture":               {fen: "rnbqkbnr/ppp1pppp/8/3p4/4P3/8/PPPP1PPP/RNBQKBNR w KQkq - 0 2", san: "exd5", uci: "e4d5"},
+		"en passant":                 {fen: "rnbqkbnr/ppp1p1pp/8/3pPp2/8/8/PPPP1PPP/RNBQKBNR w KQkq f6 0 3", san: "exf6", uci: "e5f6"},
+		"en passant suffix":          {fen: "rnbqkbnr/ppp1p1pp/8/3pPp2/8/8/PPPP1PPP/RNBQKBNR w KQkq f6 0 3", san: "exf6e.p.", uci: "e5f6"},
+		"en passant spaced suffix":   {fen: "4k3/8/8/3pP3/8/8/8/4K3 w - d6 0 1", san: "exd6 e.p.", uci: "e5d6"},
+		"white castle king side":     {fen: "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", san: "O-O", uci: "e1g1"},
+		"white castle queen side":    {fen: "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", san: "O-O-O", uci: "e1c1"},
+		"black castle zeros":         {fen: "r3k2r/8/8/8/8/8/8/R3K2R b KQkq - 0 1", san: "0-0", uci: "e8g8"},
+		"black castle zeros long":    {fen: "r3k2r/8/8/8/8/8/8/R3K2R b KQkq - 0 1", san: "0-0-0+", uci: "e8c8"},
+		"promotion":                  {fen: "8/4P3/8/8/8/8/k7/4K3 w - - 0 1", san: "e8=Q", uci: "e7e8q"},
+		"promotion without equals":   {fen: "8/4P3/8/8/8/8/k7/4K3 w - - 0 1", san: "e8N", uci: "e7e8n"},
+		"promotion capture mate":     {fen: "3r3k/4P1pp/8/8/8/8/8/4K2R w - - 0 1", san: "exd8=Q#", uci: "e7d8q"},
+		"disambiguation file":        {fen: "4k3/8/8/8/8/8/3N4/4K1N1 w - - 0 1", san: "Ngf3", uci: "g1f3"},
+		"disambiguation rank":        {fen: "4k3/8/8/6N1/8/8/8/4K1N1 w - - 0 1", san: "N1f3", uci: "g1f3"},
+		"disambiguation square":      {fen: "7k/8/8/8/2Q1Q3/8/2Q1Q3/K7 w - - 0 1", san: "Qc2d3", uci: "c2d3"},
+		"capture marker colon":       {fen: "rnbqkbnr/ppp1pppp/8/3p4/4P3/8/PPPP1PPP/RNBQKBNR w KQkq - 0 2", san: "e:d5", uci: "e4d5"},
+		"pinned piece not ambiguous": {fen: "4k3/8/8/8/1N6/8/8/K3N2r w - - 0 1", san: "Nd3", uci: "b4d3"},
+		"ambiguous knights":          {fen: "4k3/8/8/8/8/8/3N4/4K1N1 w - - 0 1", san: "Nf3", wantErr: ErrSANAmbiguous},
+		"ambiguous queens file":      {fen: "7k/8/8/8/2Q1Q3/8/2Q1Q3/K7 w - - 0 1", san: "Qcd3", wantErr: ErrSANAmbiguous},
+		"illegal pawn push":          {fen: FENStartingPosition, san: "e5", wantErr: ErrSANIllegal},
+		"illegal castle":             {fen: FENStartingPosition, san: "O-O", wantErr: ErrSANIllegal},
+		"illegal not a capture":      {fen: FENStartingPosition, san: "Nxf3", wantErr: ErrSANIllegal},
+		"illegal pawn capture push":  {fen: "rnbqkbnr/ppp1pppp/8/3p4/4P3/8/PPPP1PPP/RNBQKBNR w KQkq - 0 2", san: "d5", wantErr: ErrSANIllegal},
+		"invalid empty":              {fen: FENStartingPosition, san: "", wantErr: ErrSANInvalid},
+		"invalid square":             {fen: FENStartingPosition, san: "Nz9", wantErr: ErrSANInvalid},
+		"invalid piece promotion":    {fen: FENStartingPosition, san: "Nf3=Q", wantErr: ErrSANInvalid},
+		"invalid missing promotion":  {fen: "8/4P3/8/8/8/8/k7/4K3 w - - 0 1", san: "e8", wantErr: ErrSANInvalid},
+		"invalid pawn capture":       {fen: "rnbqkbnr/ppp1pppp/8/3p4/4P3/8/PPPP1PPP/RNBQKBNR w KQkq - 0 2", san: "xd5", wantErr: ErrSANInvalid},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewChess(tc.fen)
+			if err != nil {
+				t.Fatalf("failed to load fen: %v", err)
+			}
+
+			m, err := c.MakeMoveSAN(tc.san)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("invalid san error: want %v, got %v", tc.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("failed to make san move: %v", err)
+			}
+
+			if m.ToUCI() != tc.uci {
+				t.Fatalf("invalid san move: want %s, got %s", tc.uci, m.ToUCI())
+			}
+		})
+	}
+}
+
+func TestParseSANRoundTrip(t *testing.T) {
+	fens := []string{
+		FENStartingPosition,
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1",
+		"r2q1rk1/pP1p2pp/Q4n2/bbp1p3/Np6/1B3NBn/pPPP1PPP/R3K2R b KQ - 0 1",
+		"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 1",
+	}
+
+	for _, fen := range fens {
+		c, err := NewChess(fen)
+		if err != nil {
+			t.Fatalf("failed to load fen: %v", err)
+		}
+
+		for _, m := range c.LegalMoves {
+			san := m.ToSAN(c.Position, false, false, c.LegalMoves)
+
+			got, err := ParseSAN(san, c.LegalMoves)
+			if err != nil {
+				t.Fatalf("%s: failed to parse san %s: %v", fen, san, err)
+			}
+
+			if got != m {
+				t.Fatalf("%s: invalid san round trip %s: want %s, got %s", fen, san, m, got)
+			}
+		}
+	}
+}
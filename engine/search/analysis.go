@@ -0,0 +1,148 @@
+package search
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+// Line is the ranked principal variation of the analysis
+type Line struct {
+	// Rank is the rank of the line starting from 1
+	Rank     int
+	Depth    int
+	SelDepth int
+	// Score is in centipawns from the side to move point of view
+	Score int
+	// Mate is the number of moves to mate, negative when the side to move is getting mated and 0 for no mate
+	Mate int
+	PV   []engine.Move
+	// SAN is the pv in the san notation
+	SAN []string
+}
+
+// ScoreString returns the score in pawns (e.g. `+0.35`) or the mate (e.g. `#3` or `#-2`)
+func (l Line) ScoreString() string {
+	if l.Mate != 0 {
+		return fmt.Sprintf("#%d", l.Mate)
+	}
+
+	return fmt.Sprintf("%+.2f", float64(l.Score)/100)
+}
+
+// Analysis is the result of the analysis, the lines are ranked from the best one
+type Analysis struct {
+	Depth int
+	Nodes int64
+	Time  time.Duration
+	Lines []Line
+}
+
+// BestLine returns the first ranked line
+func (a Analysis) BestLine() (Line, bool) {
+	if len(a.Lines) == 0 {
+		return Line{}, false
+	}
+
+	return a.Lines[0], true
+}
+
+// Analyze searches the best lines of the position (as many as the MultiPV limit) until one of the limits is reached
+// or the context is done. onProgress (if not nil) is called with all the lines after every completed depth, the result
+// keeps the lines of the last completed depth. the repetitions are counted only from the analysed position
+func (s *Searcher) Analyze(ctx context.Context, p *engine.Position, limits Limits, onProgress func(Analysis)) Analysis {
+	multiPV := min(max(limits.MultiPV, 1), len(p.LegalMoves()))
+
+	var (
+		analysis Analysis
+		current  []Line
+	)
+
+	res := s.search(ctx, p, []uint64{p.Hash}, limits, true, func(info Info) {
+		if len(current) > 0 && current[0].Depth != info.Depth {
+			current = current[:0]
+		}
+
+		current = append(current, Line{
+			Depth:    info.Depth,
+			SelDepth: info.SelDepth,
+			Score:    info.Score,
+			Mate:     info.Mate,
+			PV:       info.PV,
+			SAN:      pvToSAN(p, info.PV),
+		})
+
+		if len(current) < multiPV {
+			return
+		}
+
+		analysis = Analysis{Depth: info.Depth, Nodes: info.Nodes, Time: info.Time, Lines: rankLines(current)}
+
+		if onProgress != nil {
+			onProgress(analysis)
+		}
+	})
+
+	// the first iteration interrupted before all the lines were searched is better than nothing
+	if len(analysis.Lines) == 0 && len(current) > 0 {
+		analysis = Analysis{Depth: current[0].Depth, Lines: rankLines(current)}
+	}
+
+	analysis.Nodes = res.Nodes
+	analysis.Time = time.Since(s.start)
+
+	return analysis
+}
+
+// FindMate searches for the forced mate of the side to move in at most the number of moves, the search can also
+// be limited by the nodes, the time or the context. the line is returned only when the mate was found
+func (s *Searcher) FindMate(ctx context.Context, p *engine.Position, moves int, limits Limits) (Line, bool) {
+	if moves <= 0 {
+		return Line{}, false
+	}
+
+	limits.Mate = moves
+	limits.MultiPV = 1
+
+	line, ok := s.Analyze(ctx, p, limits, nil).BestLine()
+	if !ok || line.Mate <= 0 || line.Mate > moves {
+		return Line{}, false
+	}
+
+	return line, true
+}
+
+// rankLines copies the lines ordered from the best score and sets their ranks
+func rankLines(lines []Line) []Line {
+	ranked := slices.Clone(lines)
+
+	slices.SortStableFunc(ranked, func(a, b Line) int { return cmp.Compare(b.Score, a.Score) })
+
+	for i := range ranked {
+		ranked[i].Rank = i + 1
+	}
+
+	return ranked
+}
+
+// pvToSAN plays the pv moves from the position and converts them to the san notation
+func pvToSAN(p *engine.Position, pv []engine.Move) []string {
+	pos := p.Copy()
+	sans := make([]string, 0, len(pv))
+
+	for _, m := range pv {
+		legalMoves := pos.LegalMoves()
+		before := pos.Copy()
+
+		pos.MakeMove(m)
+
+		isCheckmate := pos.Check && len(pos.LegalMoves()) == 0
+		sans = append(sans, m.ToSAN(before, pos.Check, isCheckmate, legalMoves))
+	}
+
+	return sans
+}
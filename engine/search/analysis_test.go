@@ -0,0 +1,129 @@
+package search
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+func TestAnalyze(t *testing.T) {
+	c, err := engine.NewChess("6k1/5ppp/8/8/8/8/5PPP/R5K1 w - - 0 1")
+	if err != nil {
+		t.Fatalf("failed to load fen: %v", err)
+	}
+
+	var progress []Analysis
+
+	a := NewSearcher(1).Analyze(context.Background(), c.Position, Limits{Depth: 3, MultiPV: 3}, func(a Analysis) { progress = append(progress, a) })
+
+	if len(progress) != 3 || a.Depth != 3 {
+		t.Fatalf("invalid progress: want %d depths, got %d (depth %d)", 3, len(progress), a.Depth)
+	}
+
+	if len(a.Lines) != 3 {
+		t.Fatalf("invalid number of lines: want %d, got %d", 3, len(a.Lines))
+	}
+
+	for i, line := range a.Lines {
+		if line.Rank != i+1 || line.Depth != 3 || len(line.SAN) != len(line.PV) {
+			t.Fatalf("invalid line %d: got rank %d, depth %d, pv %v, san %v", i, line.Rank, line.Depth, line.PV, line.SAN)
+		}
+
+		if i > 0 && line.Score > a.Lines[i-1].Score {
+			t.Fatalf("invalid lines order: got scores %d after %d", line.Score, a.Lines[i-1].Score)
+		}
+	}
+
+	best, _ := a.BestLine()
+	if best.Mate != 1 || !slices.Equal(best.SAN, []string{"Ra8#"}) || best.ScoreString() != "#1" {
+		t.Fatalf("invalid best line: want Ra8# mate in 1, got %v (%s)", best.SAN, best.ScoreString())
+	}
+}
+
+func TestAnalyzeCancel(t *testing.T) {
+	c, err := engine.NewChess(engine.FENStartingPosition)
+	if err != nil {
+		t.Fatalf("failed to load fen: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	a := NewSearcher(1).Analyze(ctx, c.Position, Limits{Infinite: true, MultiPV: 2}, nil)
+	if elapsed := time.Since(start); elapsed > time.Second || len(a.Lines) != 2 {
+		t.Fatalf("invalid cancellation: took %s with %d lines", elapsed, len(a.Lines))
+	}
+}
+
+func TestAnalyzeSingleLegalMove(t *testing.T) {
+	c, err := engine.NewChess("b7/Q1R5/1p6/8/5n2/k7/8/6Kr w - - 0 1")
+	if err != nil {
+		t.Fatalf("failed to load fen: %v", err)
+	}
+
+	a := NewSearcher(1).Analyze(context.Background(), c.Position, Limits{Nodes: 100_000}, nil)
+
+	best, _ := a.BestLine()
+	if best.Mate != 3 {
+		t.Fatalf("invalid analysis of the only legal move: want mate in 3, got %v (%s at depth %d)", best.SAN, best.ScoreString(), a.Depth)
+	}
+}
+
+func TestFindMate(t *testing.T) {
+	testCases := map[string]struct {
+		fen   string
+		moves int
+		want  []string
+		found bool
+	}{
+		"mate in one":            {fen: "k7/8/1K6/8/8/8/7Q/8 w - - 0 1", moves: 1, want: []string{"Qh8#"}, found: true},
+		"mate in two":            {fen: "r2qkb1r/pp2nppp/3p4/2pNN1B1/2BnP3/3P4/PPP2PPP/R2bK2R w KQkq - 0 1", moves: 2, want: []string{"Nf6+", "gxf6", "Bxf7#"}, found: true},
+		"mate in two not in one": {fen: "r2qkb1r/pp2nppp/3p4/2pNN1B1/2BnP3/3P4/PPP2PPP/R2bK2R w KQkq - 0 1", moves: 1, found: false},
+		"no mate":                {fen: engine.FENStartingPosition, moves: 2, found: false},
+		"single legal move":      {fen: "b7/Q1R5/1p6/8/5n2/k7/8/6Kr w - - 0 1", moves: 3, want: []string{"Kf2+", "Kb3", "Qxb6+", "Ka2", "Ra7#"}, found: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := engine.NewChess(tc.fen)
+			if err != nil {
+				t.Fatalf("failed to load fen: %v", err)
+			}
+
+			line, ok := NewSearcher(1).FindMate(context.Background(), c.Position, tc.moves, Limits{})
+			if ok != tc.found {
+				t.Fatalf("invalid found: want %v, got %v (%v)", tc.found, ok, line.SAN)
+			}
+
+			if ok && (line.Mate > tc.moves || !slices.Equal(line.SAN, tc.want)) {
+				t.Fatalf("invalid mate line: want %v, got %v (mate %d)", tc.want, line.SAN, line.Mate)
+			}
+		})
+	}
+}
+
+func TestLineScoreString(t *testing.T) {
+	testCases := map[string]struct {
+		line Line
+		want string
+	}{
+		"positive": {line: Line{Score: 35}, want: "+0.35"},
+		"negative": {line: Line{Score: -120}, want: "-1.20"},
+		"even":     {line: Line{Score: 0}, want: "+0.00"},
+		"mate":     {line: Line{Score: MateScore - 5, Mate: 3}, want: "#3"},
+		"mated":    {line: Line{Score: -MateScore + 4, Mate: -2}, want: "#-2"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.line.ScoreString(); got != tc.want {
+				t.Fatalf("invalid score string: want %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package search
+
+import "github.com/dankobg/juicer/engine"
+
+const (
+	ttMoveScore    = 1_000_000
+	captureScore   = 100_000
+	promotionScore = 95_000
+	firstKiller    = 90_000
+	secondKiller   = 80_000
+	// badCaptureScore puts the captures losing material by the static exchange evaluation after the quiet moves
+	badCaptureScore = -100_000
+)
+
+// mvvLvaValues are the piece values used only for ordering the captures (most valuable victim, least valuable attacker)
+var mvvLvaValues = [6]int{
+	engine.King:   1000,
+	engine.Queen:  900,
+	engine.Rook:   500,
+	engine.Bishop: 330,
+	engine.Knight: 320,
+	engine.Pawn:   100,
+}
+
+func capturedPieceKind(p *engine.Position, m engine.Move) engine.PieceKind {
+	if m.IsEnPassant() {
+		return engine.Pawn
+	}
+
+	return p.Board.PieceAt(m.Dest()).Kind()
+}
+
+func mvvLva(p *engine.Position, m engine.Move) int {
+	return 10*mvvLvaValues[capturedPieceKind(p, m)] - mvvLvaValues[m.Piece().Kind()]
+}
+
+// generateMoves generates the legal moves into the move list of the ply
+func (s *Searcher) generateMoves(p *engine.Position, ply int) []engine.Move {
+	p.GenerateLegalMoves(&s.moveLists[ply])
+
+	return s.moveLists[ply].Moves()
+}
+
+// generateCaptures generates only the captures and promotions into the move list of the ply
+func (s *Searcher) generateCaptures(p *engine.Position, ply int) []engine.Move {
+	p.GenerateCaptures(&s.moveLists[ply])
+
+	return s.moveLists[ply].Moves()
+}
+
+// scoreMoves scores the moves for ordering: tt move, winning and equal captures by mvv-lva, promotions, killers, the history heuristic
+// and then the losing captures
+func (s *Searcher) scoreMoves(p *engine.Position, moves []engine.Move, ttMove engine.Move, ply int) []int {
+	scores := s.moveScores[ply][:len(moves)]
+
+	for i, m := range moves {
+		switch {
+		case m == ttMove:
+			scores[i] = ttMoveScore
+		case m.IsCapture() && p.SEE(m) < 0:
+			scores[i] = badCaptureScore + mvvLva(p, m)
+		case m.IsCapture():
+			scores[i] = captureScore + mvvLva(p, m)
+		case m.Promotion().IsPromotion():
+			scores[i] = promotionScore + mvvLvaValues[m.Promotion().PieceKind()]
+		case m == s.killers[ply][0]:
+			scores[i] = firstKiller
+		case m == s.killers[ply][1]:
+			scores[i] = secondKiller
+		default:
+			scores[i] = s.history[p.Turn][m.Src()][m.Dest()]
+		}
+	}
+
+	return scores
+}
+
+// pickMove swaps the best scored move from the rest of the list into the current index
+func pickMove(moves []engine.Move, scores []int, index int) {
+	best := index
+
+	for i := index + 1; i < len(moves); i++ {
+		if scores[i] > scores[best] {
+			best = i
+		}
+	}
+
+	moves[index], moves[best] = moves[best], moves[index]
+	scores[index], scores[best] = scores[best], scores[index]
+}
+
+func (s *Searcher) storeKiller(ply int, m engine.Move) {
+	if s.killers[ply][0] != m {
+		s.killers[ply][1] = s.killers[ply][0]
+		s.killers[ply][0] = m
+	}
+}
+
+func (s *Searcher) updateHistory(turn engine.Color, m engine.Move, depth int) {
+	s.history[turn][m.Src()][m.Dest()] += depth * depth
+
+	// keep the history below the killers so they are always tried first
+	if s.history[turn][m.Src()][m.Dest()] >= secondKiller {
+		for c := range s.history {
+			for src := range s.history[c] {
+				for dest := range s.history[c][src] {
+					s.history[c][src][dest] /= 2
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,596 @@
+package search
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+const (
+	MaxPly        = 128
+	Infinity      = 50_000
+	MateScore     = 49_000
+	MateThreshold = MateScore - MaxPly
+)
+
+const (
+	defaultMovesToGo = 30
+	// moveOverhead is kept from the remaining clock time for the communication lag
+	moveOverhead = 50 * time.Millisecond
+	// stopCheckInterval is how often (in nodes) the context and the deadline are checked
+	stopCheckInterval = 1024
+)
+
+// Limits controls when the search stops, zero values mean there is no such limit
+type Limits struct {
+	Depth     int
+	Nodes     int64
+	MoveTime  time.Duration
+	WTime     time.Duration
+	BTime     time.Duration
+	WInc      time.Duration
+	BInc      time.Duration
+	MovesToGo int
+	Infinite  bool
+	// MultiPV is the number of the best lines to search, 0 and 1 both mean only the best line
+	MultiPV int
+	// Mate searches for the mate in the number of moves, the search stops as soon as it is found and
+	// it is not deeper than the mate needs unless the depth is set
+	Mate int
+}
+
+// Info is reported after every completed iteration
+type Info struct {
+	Depth    int
+	SelDepth int
+	// MultiPV is the rank of the line starting from 1
+	MultiPV int
+	// Score is in centipawns from the side to move point of view
+	Score int
+	// Mate is the number of moves to mate, negative when the side to move is getting mated and 0 for no mate
+	Mate  int
+	Nodes int64
+	Time  time.Duration
+	PV    []engine.Move
+}
+
+type Result struct {
+	BestMove   engine.Move
+	PonderMove engine.Move
+	Score      int
+	Mate       int
+	Depth      int
+	Nodes      int64
+	PV         []engine.Move
+}
+
+// Evaluator returns the static evaluation in centipawns from the side to move point of view
+type Evaluator func(*engine.Position) int
+
+// Searcher is the iterative deepening alpha-beta search, it is not safe for concurrent use
+type Searcher struct {
+	tt       *transpositionTable
+	eval     Evaluator
+	killers  [MaxPly][2]engine.Move
+	history  [2][64][64]int
+	pvTable  [MaxPly][MaxPly]engine.Move
+	pvLength [MaxPly]int
+	// moveLists and moveScores are the per ply buffers so the search doesn't allocate the moves
+	moveLists    [MaxPly]engine.MoveList
+	moveScores   [MaxPly][engine.MaxMoves]int
+	hashes       []uint64
+	nodes        int64
+	selDepth     int
+	limits       Limits
+	start        time.Time
+	softDeadline time.Time
+	hardDeadline time.Time
+	ctx          context.Context
+	stopped      bool
+	// excluded are the root moves skipped when searching the next best lines
+	excluded []engine.Move
+}
+
+func NewSearcher(hashMB int) *Searcher {
+	return &Searcher{
+		tt:   newTranspositionTable(hashMB),
+		eval: engine.Evaluate,
+	}
+}
+
+// SetEvaluator replaces the static evaluation used in the leaf nodes
+func (s *Searcher) SetEvaluator(eval Evaluator) {
+	s.eval = eval
+}
+
+// ResizeHash recreates the transposition table with the new size in megabytes
+func (s *Searcher) ResizeHash(mb int) {
+	s.tt = newTranspositionTable(mb)
+}
+
+// Clear forgets everything learned from the previous searches (e.g. for a new game)
+func (s *Searcher) Clear() {
+	s.tt.clear()
+	s.killers = [MaxPly][2]engine.Move{}
+	s.history = [2][64][64]int{}
+}
+
+// Search searches the current chess position until one of the limits is reached or the context is done
+// onInfo (if not nil) is called after every completed iteration
+func (s *Searcher) Search(ctx context.Context, c *engine.Chess, limits Limits, onInfo func(Info)) Result {
+	return s.search(ctx, c.Position, c.HistoryHashes, limits, false, onInfo)
+}
+
+// search searches the position, the hashes are the positions played before it for the repetition detection
+// the analysis searches the only legal move as deep as the limits allow because its score matters, not only the move
+func (s *Searcher) search(ctx context.Context, p *engine.Position, hashes []uint64, limits Limits, analysis bool, onInfo func(Info)) Result {
+	pos := p.Copy()
+
+	s.ctx = ctx
+	s.limits = limits
+	s.stopped = false
+	s.nodes = 0
+	s.selDepth = 0
+	s.hashes = slices.Clone(hashes)
+	s.killers = [MaxPly][2]engine.Move{}
+	s.start = time.Now()
+	s.allocateTime(pos.Turn)
+
+	legalMoves := pos.LegalMoves()
+	if len(legalMoves) == 0 {
+		return Result{}
+	}
+
+	result := Result{BestMove: legalMoves[0], PV: []engine.Move{legalMoves[0]}}
+
+	maxDepth := limits.Depth
+	if maxDepth <= 0 && limits.Mate > 0 {
+		maxDepth = 2*limits.Mate - 1
+	}
+
+	if maxDepth <= 0 || maxDepth >= MaxPly {
+		maxDepth = MaxPly - 1
+	}
+
+	multiPV := min(max(limits.MultiPV, 1), len(legalMoves))
+
+	for depth := 1; depth <= maxDepth; depth++ {
+		s.excluded = s.excluded[:0]
+
+		for pvIndex := range multiPV {
+			score := s.negamax(pos, depth, 0, -Infinity, Infinity, false)
+
+			// the interrupted iteration is not reliable, except for the first one which is better than nothing
+			if s.stopped && (depth > 1 || pvIndex > 0 || s.pvLength[0] == 0) {
+				break
+			}
+
+			pv := slices.Clone(s.pvTable[0][:s.pvLength[0]])
+			s.excluded = append(s.excluded, pv[0])
+
+			if pvIndex == 0 {
+				result = Result{
+					BestMove: pv[0],
+					Score:    score,
+					Mate:     mateIn(score),
+					Depth:    depth,
+					Nodes:    s.nodes,
+					PV:       pv,
+				}
+
+				if len(pv) > 1 {
+					result.PonderMove = pv[1]
+				}
+			}
+
+			if onInfo != nil {
+				onInfo(Info{
+					Depth:    depth,
+					SelDepth: s.selDepth,
+					MultiPV:  pvIndex + 1,
+					Score:    score,
+					Mate:     mateIn(score),
+					Nodes:    s.nodes,
+					Time:     time.Since(s.start),
+					PV:       pv,
+				})
+			}
+
+			if s.stopped {
+				break
+			}
+		}
+
+		if s.stopped {
+			break
+		}
+
+		// the only legal move is played at once, the mate search still has to find the mate after it
+		if len(legalMoves) == 1 && !analysis && !limits.Infinite && limits.Depth == 0 && limits.Mate == 0 {
+			break
+		}
+
+		if limits.Mate > 0 && result.Mate > 0 && result.Mate <= limits.Mate {
+			break
+		}
+
+		// the found mate can't get any shorter with the deeper search, but the other lines still can improve
+		if result.Mate != 0 && multiPV == 1 && !limits.Infinite && abs(result.Mate)*2 <= depth {
+			break
+		}
+
+		if !s.softDeadline.IsZero() && time.Now().After(s.softDeadline) {
+			break
+		}
+	}
+
+	result.Nodes = s.nodes
+
+	return result
+}
+
+// allocateTime sets the deadlines from the move time or the clock, the next iteration is not started after the soft deadline
+func (s *Searcher) allocateTime(turn engine.Color) {
+	s.softDeadline, s.hardDeadline = time.Time{}, time.Time{}
+
+	if s.limits.Infinite {
+		return
+	}
+
+	if s.limits.MoveTime > 0 {
+		s.hardDeadline = s.start.Add(s.limits.MoveTime)
+		return
+	}
+
+	remaining, inc := s.limits.WTime, s.limits.WInc
+	if turn.IsBlack() {
+		remaining, inc = s.limits.BTime, s.limits.BInc
+	}
+
+	if remaining <= 0 {
+		return
+	}
+
+	movesToGo := s.limits.MovesToGo
+	if movesToGo <= 0 {
+		movesToGo = defaultMovesToGo
+	}
+
+	budget := remaining/time.Duration(movesToGo) + inc*3/4
+	budget = min(budget, max(remaining-moveOverhead, remaining/2))
+
+	s.softDeadline = s.start.Add(budget / 2)
+	s.hardDeadline = s.start.Add(budget)
+}
+
+func (s *Searcher) shouldStop() bool {
+	if s.stopped {
+		return true
+	}
+
+	if s.limits.Nodes > 0 && s.nodes >= s.limits.Nodes {
+		s.stopped = true
+		return true
+	}
+
+	if s.nodes%stopCheckInterval == 0 {
+		select {
+		case <-s.ctx.Done():
+			s.stopped = true
+		default:
+			if !s.hardDeadline.IsZero() && time.Now().After(s.hardDeadline) {
+				s.stopped = true
+			}
+		}
+	}
+
+	return s.stopped
+}
+
+func (s *Searcher) negamax(p *engine.Position, depth, ply, alpha, beta int, allowNull bool) int {
+	s.pvLength[ply] = ply
+
+	if s.shouldStop() {
+		return 0
+	}
+
+	if ply > 0 {
+		if p.HalfMoveClock >= 100 || s.isRepetition(p) {
+			return 0
+		}
+
+		if score, ok := variantScore(p, ply); ok {
+			return score
+		}
+
+		// mate distance pruning, a shorter mate was already found
+		alpha = max(alpha, -MateScore+ply)
+		beta = min(beta, MateScore-ply-1)
+
+		if alpha >= beta {
+			return alpha
+		}
+	}
+
+	inCheck := p.Check
+	if inCheck {
+		depth++
+	}
+
+	if depth <= 0 {
+		return s.quiescence(p, ply, alpha, beta)
+	}
+
+	if ply >= MaxPly-1 {
+		return s.eval(p)
+	}
+
+	s.nodes++
+
+	pvNode := beta-alpha > 1
+
+	var ttMove engine.Move
+
+	if e, ok := s.tt.probe(p.Hash); ok {
+		ttMove = e.move
+
+		if !pvNode && ply > 0 && int(e.depth) >= depth {
+			score := scoreFromTT(int(e.score), ply)
+
+			switch {
+			case e.bound == boundExact:
+				return score
+			case e.bound == boundLower && score >= beta:
+				return score
+			case e.bound == boundUpper && score <= alpha:
+				return score
+			}
+		}
+	}
+
+	// null move pruning, if passing the turn still fails high then the real move will too
+	// it is skipped without the pieces because of the zugzwang in the pawn endgames
+	if allowNull && !pvNode && !inCheck && depth >= 3 && p.Board.HasNonPawnMaterial(p.Turn) && s.eval(p) >= beta {
+		reduction := 2 + depth/6
+
+		p.MakeNullMove()
+		s.hashes = append(s.hashes, p.Hash)
+		score := -s.negamax(p, depth-1-reduction, ply+1, -beta, -beta+1, false)
+		s.hashes = s.hashes[:len(s.hashes)-1]
+		p.UnmakeMove()
+
+		if s.stopped {
+			return 0
+		}
+
+		if score >= beta {
+			if score >= MateThreshold {
+				score = beta
+			}
+
+			return score
+		}
+	}
+
+	moves := s.generateMoves(p, ply)
+	if len(moves) == 0 {
+		if inCheck {
+			return -MateScore + ply
+		}
+
+		return 0
+	}
+
+	if ply == 0 && len(s.excluded) > 0 {
+		moves = slices.DeleteFunc(moves, func(m engine.Move) bool { return slices.Contains(s.excluded, m) })
+	}
+
+	scores := s.scoreMoves(p, moves, ttMove, ply)
+
+	var bestMove engine.Move
+
+	bestScore := -Infinity
+	originalAlpha := alpha
+
+	for i := range moves {
+		pickMove(moves, scores, i)
+		m := moves[i]
+
+		p.MakeMove(m)
+		s.hashes = append(s.hashes, p.Hash)
+		score := -s.negamax(p, depth-1, ply+1, -beta, -alpha, true)
+		s.hashes = s.hashes[:len(s.hashes)-1]
+		p.UnmakeMove()
+
+		if s.stopped {
+			return 0
+		}
+
+		if score <= bestScore {
+			continue
+		}
+
+		bestScore = score
+		bestMove = m
+
+		if score <= alpha {
+			continue
+		}
+
+		alpha = score
+		s.updatePV(ply, m)
+
+		if score >= beta {
+			if !m.IsCapture() && !m.Promotion().IsPromotion() {
+				s.storeKiller(ply, m)
+				s.updateHistory(p.Turn, m, depth)
+			}
+
+			break
+		}
+	}
+
+	b := boundExact
+
+	switch {
+	case bestScore <= originalAlpha:
+		b = boundUpper
+	case bestScore >= beta:
+		b = boundLower
+	}
+
+	// the root score without the excluded moves is not the real score of the position
+	if ply > 0 || len(s.excluded) == 0 {
+		s.tt.store(p.Hash, bestMove, scoreToTT(bestScore, ply), depth, b)
+	}
+
+	return bestScore
+}
+
+// variantScore scores the game ended by the variant rule (e.g. the king on the hill) as the mate, there are no legal
+// moves then so it would be mistaken for the stalemate. the race reached by both kings is drawn
+func variantScore(p *engine.Position, ply int) (int, bool) {
+	switch p.VariantOutcome() {
+	case engine.OutcomeUnknown:
+		return 0, false
+	case engine.OutcomeDraw:
+		return 0, true
+	case engine.OutcomeWhiteWon:
+		if p.Turn.IsWhite() {
+			return MateScore - ply, true
+		}
+	case engine.OutcomeBlackWon:
+		if p.Turn.IsBlack() {
+			return MateScore - ply, true
+		}
+	}
+
+	return -MateScore + ply, true
+}
+
+// quiescence searches only the captures and promotions until the position is quiet to avoid the horizon effect
+// all the evasions are searched when in check
+func (s *Searcher) quiescence(p *engine.Position, ply, alpha, beta int) int {
+	s.pvLength[ply] = ply
+
+	if s.shouldStop() {
+		return 0
+	}
+
+	s.nodes++
+	s.selDepth = max(s.selDepth, ply)
+
+	if score, ok := variantScore(p, ply); ok {
+		return score
+	}
+
+	if ply >= MaxPly-1 {
+		return s.eval(p)
+	}
+
+	inCheck := p.Check
+	bestScore := -Infinity
+
+	if !inCheck {
+		standPat := s.eval(p)
+		if standPat >= beta {
+			return standPat
+		}
+
+		alpha = max(alpha, standPat)
+		bestScore = standPat
+	}
+
+	var moves []engine.Move
+
+	if inCheck {
+		moves = s.generateMoves(p, ply)
+		if len(moves) == 0 {
+			return -MateScore + ply
+		}
+	} else {
+		moves = s.generateCaptures(p, ply)
+	}
+
+	scores := s.scoreMoves(p, moves, 0, ply)
+
+	for i := range moves {
+		pickMove(moves, scores, i)
+		m := moves[i]
+
+		// the losing captures are skipped as they rarely beat the stand pat, the atomic captures explode so the exchange doesn't apply
+		if !inCheck && scores[i] < 0 && m.IsCapture() && p.Variant != engine.VariantAtomic {
+			continue
+		}
+
+		p.MakeMove(m)
+		score := -s.quiescence(p, ply+1, -beta, -alpha)
+		p.UnmakeMove()
+
+		if s.stopped {
+			return 0
+		}
+
+		if score <= bestScore {
+			continue
+		}
+
+		bestScore = score
+
+		if score > alpha {
+			alpha = score
+			s.updatePV(ply, m)
+		}
+
+		if score >= beta {
+			break
+		}
+	}
+
+	return bestScore
+}
+
+// updatePV sets the move as the best move at the ply followed by the best line of the next ply
+func (s *Searcher) updatePV(ply int, m engine.Move) {
+	s.pvTable[ply][ply] = m
+
+	copy(s.pvTable[ply][ply+1:], s.pvTable[ply+1][ply+1:s.pvLength[ply+1]])
+	s.pvLength[ply] = s.pvLength[ply+1]
+}
+
+// isRepetition checks if the position already occurred since the last irreversible move
+// a single repetition inside the search tree is enough to score it as a draw
+func (s *Searcher) isRepetition(p *engine.Position) bool {
+	last := len(s.hashes) - 1
+	oldest := max(0, last-int(p.HalfMoveClock))
+
+	for i := last - 2; i >= oldest; i -= 2 {
+		if s.hashes[i] == p.Hash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mateIn converts the mate score into the number of moves to mate
+func mateIn(score int) int {
+	switch {
+	case score >= MateThreshold:
+		return (MateScore - score + 1) / 2
+	case score <= -MateThreshold:
+		return -(MateScore + score) / 2
+	default:
+		return 0
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
@@ -0,0 +1,199 @@
+package search
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+func TestSearchBestMove(t *testing.T) {
+	testCases := map[string]struct {
+		fen      string
+		depth    int
+		bestMove string
+		mate     int
+	}{
+		"mate in one back rank": {fen: "6k1/5ppp/8/8/8/8/5PPP/R5K1 w - - 0 1", depth: 3, bestMove: "a1a8", mate: 1},
+		"mate in one queen":     {fen: "k7/8/1K6/8/8/8/7Q/8 w - - 0 1", depth: 3, bestMove: "h2h8", mate: 1},
+		"mate in two":           {fen: "r2qkb1r/pp2nppp/3p4/2pNN1B1/2BnP3/3P4/PPP2PPP/R2bK2R w KQkq - 0 1", depth: 4, bestMove: "d5f6", mate: 2},
+		"black mated in one":    {fen: "rnbqkbnr/pppp1ppp/8/4p3/6P1/5P2/PPPPP2P/RNBQKBNR b KQkq - 0 2", depth: 2, bestMove: "d8h4", mate: 1},
+		"win the hanging queen": {fen: "4k3/8/8/3q4/8/8/8/3RK3 w - - 0 1", depth: 3, bestMove: "d1d5"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := engine.NewChess(tc.fen)
+			if err != nil {
+				t.Fatalf("failed to load fen: %v", err)
+			}
+
+			s := NewSearcher(1)
+			res := s.Search(context.Background(), c, Limits{Depth: tc.depth}, nil)
+
+			if tc.mate != 0 && res.Mate != tc.mate {
+				t.Fatalf("invalid mate: want %d, got %d", tc.mate, res.Mate)
+			}
+
+			if res.BestMove.ToUCI() != tc.bestMove {
+				t.Fatalf("invalid best move: want %s, got %s (pv %v)", tc.bestMove, res.BestMove, res.PV)
+			}
+		})
+	}
+}
+
+func TestSearchLimits(t *testing.T) {
+	c, err := engine.NewChess(engine.FENStartingPosition)
+	if err != nil {
+		t.Fatalf("failed to load fen: %v", err)
+	}
+
+	s := NewSearcher(1)
+
+	var infos []Info
+
+	res := s.Search(context.Background(), c, Limits{Depth: 3}, func(info Info) { infos = append(infos, info) })
+	if len(infos) != 3 || res.Depth != 3 {
+		t.Fatalf("invalid depth limit: want %d iterations, got %d (depth %d)", 3, len(infos), res.Depth)
+	}
+
+	res = s.Search(context.Background(), c, Limits{Nodes: 500}, nil)
+	if res.BestMove == 0 || res.Nodes > 501 {
+		t.Fatalf("invalid nodes limit: want <= %d nodes, got %d (best move %s)", 500, res.Nodes, res.BestMove)
+	}
+
+	start := time.Now()
+
+	res = s.Search(context.Background(), c, Limits{MoveTime: 100 * time.Millisecond}, nil)
+	if elapsed := time.Since(start); elapsed > time.Second || res.BestMove == 0 {
+		t.Fatalf("invalid move time limit: took %s, best move %s", elapsed, res.BestMove)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start = time.Now()
+
+	res = s.Search(ctx, c, Limits{Infinite: true}, nil)
+	if elapsed := time.Since(start); elapsed > time.Second || res.BestMove == 0 {
+		t.Fatalf("invalid context cancellation: took %s, best move %s", elapsed, res.BestMove)
+	}
+}
+
+func TestSearchWithoutLegalMoves(t *testing.T) {
+	testCases := map[string]struct {
+		fen string
+	}{
+		"stalemate": {fen: "k7/8/1QK5/8/8/8/8/8 b - - 0 1"},
+		"checkmate": {fen: "k7/1Q6/1K6/8/8/8/8/8 b - - 0 1"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := engine.NewChess(tc.fen)
+			if err != nil {
+				t.Fatalf("failed to load fen: %v", err)
+			}
+
+			res := NewSearcher(1).Search(context.Background(), c, Limits{Depth: 2}, nil)
+			if res.BestMove != 0 {
+				t.Fatalf("invalid best move without legal moves: want none, got %s", res.BestMove)
+			}
+		})
+	}
+}
+
+func TestSearchVariantEnd(t *testing.T) {
+	testCases := map[string]struct {
+		fen      string
+		variant  engine.Variant
+		bestMove string
+		mate     int
+	}{
+		"king of the hill":       {fen: "4k3/8/8/8/8/2K5/8/8 w - - 0 1", variant: engine.VariantKingOfTheHill, bestMove: "c3d4", mate: 1},
+		"third check":            {fen: "4k3/8/8/8/8/8/8/R3K3 w - - 0 1 +2+0", variant: engine.VariantThreeCheck, bestMove: "a1a8", mate: 1},
+		"exploded king":          {fen: "4k3/4p3/8/8/8/8/8/4RK2 w - - 0 1", variant: engine.VariantAtomic, bestMove: "e1e7", mate: 1},
+		"horde captured":         {fen: "4k3/8/8/8/8/8/3q4/4P3 b - - 0 1", variant: engine.VariantHorde, bestMove: "d2e1", mate: 1},
+		"race finished":          {fen: "8/1K6/8/8/8/8/8/7k w - - 0 1", variant: engine.VariantRacingKings, mate: 1},
+		"race drawn by equaling": {fen: "1K6/6k1/8/8/8/8/8/8 b - - 0 1", variant: engine.VariantRacingKings},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := engine.NewChessVariant(tc.fen, tc.variant)
+			if err != nil {
+				t.Fatalf("failed to load fen: %v", err)
+			}
+
+			res := NewSearcher(1).Search(context.Background(), c, Limits{Depth: 3}, nil)
+
+			if res.Mate != tc.mate {
+				t.Fatalf("invalid mate: want %d, got %d (score %d, pv %v)", tc.mate, res.Mate, res.Score, res.PV)
+			}
+
+			if tc.mate == 0 && res.Score != 0 {
+				t.Fatalf("invalid drawn score: want 0, got %d (pv %v)", res.Score, res.PV)
+			}
+
+			if tc.bestMove != "" && res.BestMove.ToUCI() != tc.bestMove {
+				t.Fatalf("invalid best move: want %s, got %s (pv %v)", tc.bestMove, res.BestMove, res.PV)
+			}
+		})
+	}
+}
+
+func TestMateIn(t *testing.T) {
+	testCases := map[string]struct {
+		score int
+		want  int
+	}{
+		"mate in one":   {score: MateScore - 1, want: 1},
+		"mate in two":   {score: MateScore - 3, want: 2},
+		"mated now":     {score: -MateScore, want: 0},
+		"mated in one":  {score: -MateScore + 2, want: -1},
+		"centipawns":    {score: 150, want: 0},
+		"negative cp":   {score: -150, want: 0},
+		"mated in two":  {score: -MateScore + 4, want: -2},
+		"mate in three": {score: MateScore - 5, want: 3},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := mateIn(tc.score); got != tc.want {
+				t.Fatalf("invalid mate in: want %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSearchMultiPV(t *testing.T) {
+	c, err := engine.NewChess("6k1/5ppp/8/8/8/8/5PPP/R5K1 w - - 0 1")
+	if err != nil {
+		t.Fatalf("failed to load fen: %v", err)
+	}
+
+	lines := map[int]Info{}
+
+	res := NewSearcher(1).Search(context.Background(), c, Limits{Depth: 3, MultiPV: 3}, func(info Info) {
+		if info.Depth == 3 {
+			lines[info.MultiPV] = info
+		}
+	})
+
+	if len(lines) != 3 {
+		t.Fatalf("invalid number of lines: want %d, got %d", 3, len(lines))
+	}
+
+	if res.BestMove != lines[1].PV[0] || lines[1].Mate != 1 {
+		t.Fatalf("invalid first line: want mate with %s, got %v (mate %d)", res.BestMove, lines[1].PV, lines[1].Mate)
+	}
+
+	if lines[2].PV[0] == lines[1].PV[0] || lines[3].PV[0] == lines[2].PV[0] || lines[3].PV[0] == lines[1].PV[0] {
+		t.Fatalf("invalid lines: want distinct first moves, got %v %v %v", lines[1].PV, lines[2].PV, lines[3].PV)
+	}
+
+	if lines[2].Score > lines[1].Score || lines[3].Score > lines[2].Score {
+		t.Fatalf("invalid lines order: got scores %d %d %d", lines[1].Score, lines[2].Score, lines[3].Score)
+	}
+}
@@ -0,0 +1,102 @@
+package search
+
+import (
+	"unsafe"
+
+	"github.com/dankobg/juicer/engine"
+)
+
+type bound uint8
+
+const (
+	boundNone bound = iota
+	boundExact
+	boundLower
+	boundUpper
+)
+
+const DefaultHashMB = 16
+
+type ttEntry struct {
+	key   uint64
+	move  engine.Move
+	score int32
+	depth int8
+	bound bound
+}
+
+// transpositionTable is the always-replace-when-deeper hash table keyed on the position zobrist hash
+type transpositionTable struct {
+	entries []ttEntry
+	mask    uint64
+}
+
+func newTranspositionTable(mb int) *transpositionTable {
+	if mb <= 0 {
+		mb = DefaultHashMB
+	}
+
+	count := uint64(mb) * 1024 * 1024 / uint64(unsafe.Sizeof(ttEntry{}))
+
+	// round down to the power of two so the index is just a mask
+	size := uint64(1)
+	for size*2 <= count {
+		size *= 2
+	}
+
+	return &transpositionTable{
+		entries: make([]ttEntry, size),
+		mask:    size - 1,
+	}
+}
+
+func (tt *transpositionTable) probe(key uint64) (ttEntry, bool) {
+	e := tt.entries[key&tt.mask]
+	if e.bound == boundNone || e.key != key {
+		return ttEntry{}, false
+	}
+
+	return e, true
+}
+
+func (tt *transpositionTable) store(key uint64, move engine.Move, score, depth int, b bound) {
+	e := &tt.entries[key&tt.mask]
+
+	if e.key == key && int(e.depth) > depth && b != boundExact {
+		return
+	}
+
+	// keep the previous best move when the new search failed low and has no move
+	if move == 0 && e.key == key {
+		move = e.move
+	}
+
+	*e = ttEntry{key: key, move: move, score: int32(score), depth: int8(depth), bound: b}
+}
+
+func (tt *transpositionTable) clear() {
+	clear(tt.entries)
+}
+
+// scoreToTT stores the mate scores relative to the node instead of the root
+func scoreToTT(score, ply int) int {
+	switch {
+	case score >= MateThreshold:
+		return score + ply
+	case score <= -MateThreshold:
+		return score - ply
+	default:
+		return score
+	}
+}
+
+func scoreFromTT(score, ply int) int {
+	switch {
+	case score >= MateThreshold:
+		return score - ply
+	case score <= -MateThreshold:
+		return score + ply
+	default:
+		return score
+	}
+}
@@ -0,0 +1,718 @@
+package engine
+
+import (
+	"bufio"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrTablebaseUnsupported = errors.New("position is not supported by the tablebase")
+	ErrTablebaseInvalidFile = errors.New("invalid tablebase file")
+)
+
+// TablebaseMaxPieces is the most pieces including the kings that the tablebase has the tables for
+const TablebaseMaxPieces = 4
+
+// tablebaseMagic is the header of the cached table file, it is followed by the big endian number of the values and the zlib compressed values
+const tablebaseMagic = "JTB1"
+
+// tablebasePieceOrder is the order of the pieces in the material signature, it is the same as the piece kinds order
+const tablebasePieceOrder = "KQRBNP"
+
+// WDL is the win, draw or loss for the side to move
+type WDL int8
+
+const (
+	WDLLoss WDL = iota - 1
+	WDLDraw
+	WDLWin
+)
+
+func (w WDL) String() string {
+	switch w {
+	case WDLLoss:
+		return "loss"
+	case WDLWin:
+		return "win"
+	}
+
+	return "draw"
+}
+
+// TablebaseResult is the result for the side to move, the DTM is the distance to mate in plies and 0 for the draw
+type TablebaseResult struct {
+	WDL WDL
+	DTM int
+}
+
+// tbValue is the stored value for the side to move: 0 is the draw, the odd values are the wins in as many plies
+// and the even values are the losses in 2 plies less, so that the checkmated position is 2
+type tbValue uint8
+
+const (
+	tbDraw tbValue = 0
+	// tbInvalid and tbUnknown mark the positions only while the table is generated
+	tbInvalid tbValue = 254
+	tbUnknown tbValue = 255
+	// tbMaxPlies is the longest distance to mate the value can hold
+	tbMaxPlies = 251
+)
+
+func tbWin(plies int) tbValue {
+	return tbValue(plies)
+}
+
+func tbLoss(plies int) tbValue {
+	return tbValue(plies + 2)
+}
+
+func (v tbValue) isWin() bool {
+	return v&1 == 1
+}
+
+func (v tbValue) isLoss() bool {
+	return v != tbDraw && v&1 == 0
+}
+
+func (v tbValue) plies() int {
+	switch {
+	case v.isWin():
+		return int(v)
+	case v.isLoss():
+		return int(v) - 2
+	}
+
+	return 0
+}
+
+// parent gets the value of the move to the position for the side that made it
+func (v tbValue) parent() tbValue {
+	switch {
+	case v.isWin():
+		return tbLoss(v.plies() + 1)
+	case v.isLoss():
+		return tbWin(v.plies() + 1)
+	}
+
+	return tbDraw
+}
+
+// score orders the values, the faster win and the slower loss are better
+func (v tbValue) score() int {
+	switch {
+	case v.isWin():
+		return 1000 - v.plies()
+	case v.isLoss():
+		return -1000 + v.plies()
+	}
+
+	return 0
+}
+
+func (v tbValue) result() TablebaseResult {
+	switch {
+	case v.isWin():
+		return TablebaseResult{WDL: WDLWin, DTM: v.plies()}
+	case v.isLoss():
+		return TablebaseResult{WDL: WDLLoss, DTM: v.plies()}
+	}
+
+	return TablebaseResult{WDL: WDLDraw}
+}
+
+// Tablebase generates the endgame tables by the retrograde analysis on demand and caches them in the directory,
+// the tables know nothing about the castle rights and the fifty move rule
+type Tablebase struct {
+	dir    string
+	mu     sync.Mutex
+	tables map[string]*tbTable
+}
+
+// NewTablebase creates the tablebase which caches the generated tables in the directory, they are only kept in memory when it is empty
+func NewTablebase(dir string) *Tablebase {
+	InitPrecalculatedTables()
+
+	return &Tablebase{dir: dir, tables: make(map[string]*tbTable)}
+}
+
+// Generate loads or generates the table of the material signature (e.g. KQvK) and the tables it depends on
+func (tb *Tablebase) Generate(signature string) error {
+	material, err := parseTablebaseSignature(signature)
+	if err != nil {
+		return err
+	}
+
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+
+	sig, _ := material.signature()
+	_, err = tb.table(sig)
+
+	return err
+}
+
+// ProbeTablebase probes the standard position with up to 4 pieces and without the castle rights, the missing tables are generated first
+func (p *Position) ProbeTablebase(tb *Tablebase) (TablebaseResult, error) {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+
+	v, err := tb.probe(p)
+	if err != nil {
+		return TablebaseResult{}, err
+	}
+
+	return v.result(), nil
+}
+
+// BestMove gets the move that wins the fastest, keeps the draw or loses the slowest
+func (tb *Tablebase) BestMove(p *Position) (Move, TablebaseResult, error) {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+
+	if err := tablebaseSupports(p); err != nil {
+		return 0, TablebaseResult{}, err
+	}
+
+	var (
+		ml       MoveList
+		bestMove Move
+		best     tbValue
+	)
+
+	cp := p.Copy()
+	cp.GenerateLegalMoves(&ml)
+
+	if ml.Len() == 0 {
+		return 0, TablebaseResult{}, fmt.Errorf("%w: no legal moves", ErrTablebaseUnsupported)
+	}
+
+	for i, m := range ml.Moves() {
+		cp.MakeMove(m)
+		v, err := tb.probe(cp)
+		cp.UnmakeMove()
+
+		if err != nil {
+			return 0, TablebaseResult{}, err
+		}
+
+		if v = v.parent(); i == 0 || v.score() > best.score() {
+			bestMove, best = m, v
+		}
+	}
+
+	return bestMove, best.result(), nil
+}
+
+func tablebaseSupports(p *Position) error {
+	switch {
+	case p.Variant != VariantStandard:
+		return fmt.Errorf("%w: %s variant", ErrTablebaseUnsupported, p.Variant)
+	case p.CastleRights != CastleRightsNone:
+		return fmt.Errorf("%w: castle rights", ErrTablebaseUnsupported)
+	case p.Board.AlivePieces() > TablebaseMaxPieces:
+		return fmt.Errorf("%w: more than %d pieces", ErrTablebaseUnsupported, TablebaseMaxPieces)
+	case p.Board.IsInCheck(p.Turn.Opposite()):
+		return fmt.Errorf("%w: side not to move is in check", ErrTablebaseUnsupported)
+	}
+
+	return nil
+}
+
+func (tb *Tablebase) probe(p *Position) (tbValue, error) {
+	if err := tablebaseSupports(p); err != nil {
+		return tbDraw, err
+	}
+
+	if p.EpSquare != SquareNone {
+		return tb.search(p)
+	}
+
+	return tb.lookup(p)
+}
+
+// search probes the moves of the position with the en-passant square which is not a part of the tables
+func (tb *Tablebase) search(p *Position) (tbValue, error) {
+	var ml MoveList
+	p.GenerateLegalMoves(&ml)
+
+	if ml.Len() == 0 {
+		if p.Board.IsInCheck(p.Turn) {
+			return tbLoss(0), nil
+		}
+
+		return tbDraw, nil
+	}
+
+	var best tbValue
+
+	for i, m := range ml.Moves() {
+		p.MakeMove(m)
+		v, err := tb.probe(p)
+		p.UnmakeMove()
+
+		if err != nil {
+			return tbDraw, err
+		}
+
+		if v = v.parent(); i == 0 || v.score() > best.score() {
+			best = v
+		}
+	}
+
+	return best, nil
+}
+
+// lookup gets the value of the position without the en-passant square from its table
+func (tb *Tablebase) lookup(p *Position) (tbValue, error) {
+	material := tablebaseMaterialFromBoard(p.Board)
+
+	sig, flip := material.signature()
+	if material.pieces() == 2 {
+		return tbDraw, nil
+	}
+
+	t, err := tb.table(sig)
+	if err != nil {
+		return tbDraw, err
+	}
+
+	return t.values[t.index(t.placement(p.Board, p.Turn, flip))], nil
+}
+
+// table gets the table by the canonical signature, it is loaded from the cache or generated when it is missing
+func (tb *Tablebase) table(signature string) (*tbTable, error) {
+	if t, ok := tb.tables[signature]; ok {
+		return t, nil
+	}
+
+	t, err := newTablebaseTable(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	loaded, err := tb.load(t)
+	if err != nil {
+		return nil, err
+	}
+
+	if !loaded {
+		if err := tb.generate(t); err != nil {
+			return nil, fmt.Errorf("failed to generate %s table: %w", signature, err)
+		}
+
+		if err := tb.save(t); err != nil {
+			return nil, err
+		}
+	}
+
+	tb.tables[signature] = t
+
+	return t, nil
+}
+
+func (tb *Tablebase) path(t *tbTable) string {
+	return filepath.Join(tb.dir, t.signature+".jtb")
+}
+
+// load reads the cached table, it is false when there is no cache
+func (tb *Tablebase) load(t *tbTable) (bool, error) {
+	if tb.dir == "" {
+		return false, nil
+	}
+
+	f, err := os.Open(tb.path(t))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to open %s table: %w", t.signature, err)
+	}
+	defer f.Close()
+
+	var header [8]byte
+
+	br := bufio.NewReader(f)
+
+	if _, err := io.ReadFull(br, header[:]); err != nil {
+		return false, fmt.Errorf("%w: %s: %w", ErrTablebaseInvalidFile, t.signature, err)
+	}
+
+	if string(header[:4]) != tablebaseMagic || binary.BigEndian.Uint32(header[4:]) != uint32(t.size()) {
+		return false, fmt.Errorf("%w: %s: invalid header", ErrTablebaseInvalidFile, t.signature)
+	}
+
+	zr, err := zlib.NewReader(br)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s: %w", ErrTablebaseInvalidFile, t.signature, err)
+	}
+	defer zr.Close()
+
+	values := make([]byte, t.size())
+	if _, err := io.ReadFull(zr, values); err != nil {
+		return false, fmt.Errorf("%w: %s: %w", ErrTablebaseInvalidFile, t.signature, err)
+	}
+
+	t.values = make([]tbValue, len(values))
+	for i, v := range values {
+		t.values[i] = tbValue(v)
+	}
+
+	return true, nil
+}
+
+// save writes the table to the temporary file which is renamed, so the cache never has the partially written table
+func (tb *Tablebase) save(t *tbTable) error {
+	if tb.dir == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(tb.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create tablebase directory: %w", err)
+	}
+
+	f, err := os.CreateTemp(tb.dir, t.signature+"-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create %s table: %w", t.signature, err)
+	}
+	defer os.Remove(f.Name())
+
+	if err := f.Chmod(0o644); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to create %s table: %w", t.signature, err)
+	}
+
+	if err := t.write(f); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %s table: %w", t.signature, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write %s table: %w", t.signature, err)
+	}
+
+	if err := os.Rename(f.Name(), tb.path(t)); err != nil {
+		return fmt.Errorf("failed to write %s table: %w", t.signature, err)
+	}
+
+	return nil
+}
+
+func (t *tbTable) write(w io.Writer) error {
+	var header [8]byte
+	copy(header[:4], tablebaseMagic)
+	binary.BigEndian.PutUint32(header[4:], uint32(len(t.values)))
+
+	bw := bufio.NewWriter(w)
+
+	if _, err := bw.Write(header[:]); err != nil {
+		return err
+	}
+
+	zw := zlib.NewWriter(bw)
+
+	values := make([]byte, len(t.values))
+	for i, v := range t.values {
+		values[i] = byte(v)
+	}
+
+	if _, err := zw.Write(values); err != nil {
+		return err
+	}
+
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+// tbMaterial is the number of the pieces by the color and the kind
+type tbMaterial [2][6]int
+
+func tablebaseMaterialFromBoard(b *Board) tbMaterial {
+	var m tbMaterial
+
+	for _, color := range colors {
+		for _, kind := range pieceKinds {
+			m[color][kind] = int(b.pieceOccupancies[color][kind].populationCount())
+		}
+	}
+
+	return m
+}
+
+// parseTablebaseSignature parses the signature like KRvKN, the pieces of each side may be in any order
+func parseTablebaseSignature(signature string) (tbMaterial, error) {
+	var m tbMaterial
+
+	white, black, ok := strings.Cut(strings.ToUpper(signature), "V")
+	if !ok {
+		return m, fmt.Errorf("%w: invalid signature %s", ErrTablebaseUnsupported, signature)
+	}
+
+	for color, side := range [2]string{white, black} {
+		for _, r := range side {
+			kind := strings.IndexRune(tablebasePieceOrder, r)
+			if kind < 0 {
+				return m, fmt.Errorf("%w: invalid signature %s", ErrTablebaseUnsupported, signature)
+			}
+
+			m[color][kind]++
+		}
+
+		if m[color][King] != 1 {
+			return m, fmt.Errorf("%w: invalid signature %s", ErrTablebaseUnsupported, signature)
+		}
+	}
+
+	if n := m.pieces(); n > TablebaseMaxPieces || n < 3 {
+		return m, fmt.Errorf("%w: %s has %d pieces", ErrTablebaseUnsupported, signature, n)
+	}
+
+	return m, nil
+}
+
+func (m tbMaterial) pieces() int {
+	var n int
+
+	for _, counts := range m {
+		for _, count := range counts {
+			n += count
+		}
+	}
+
+	return n
+}
+
+func (m tbMaterial) side(color Color) string {
+	var sb strings.Builder
+
+	for _, kind := range pieceKinds {
+		sb.WriteString(strings.Repeat(tablebasePieceOrder[kind:kind+1], m[color][kind]))
+	}
+
+	return sb.String()
+}
+
+// signature gets the canonical signature with the stronger side first, flip is true when the stronger side is black
+func (m tbMaterial) signature() (string, bool) {
+	white, black := m.side(White), m.side(Black)
+
+	flip := len(black) > len(white)
+	if len(black) == len(white) {
+		for i := range white {
+			if white[i] != black[i] {
+				flip = strings.IndexByte(tablebasePieceOrder, black[i]) < strings.IndexByte(tablebasePieceOrder, white[i])
+				break
+			}
+		}
+	}
+
+	if flip {
+		return black + "v" + white, true
+	}
+
+	return white + "v" + black, false
+}
+
+// tbPlacement is the position in the table: the squares of the pieces in the table order and the side to move
+type tbPlacement struct {
+	squares [TablebaseMaxPieces]Square
+	turn    Color
+}
+
+// tbTable is the table of the material signature with the stronger side as white
+// the pieces are the white king, the black king and then the other white and black pieces by the kind
+// the pawnless tables keep the white king in the a1-d1-d4 triangle and the tables with pawns keep it on the a-d files
+type tbTable struct {
+	signature string
+	pieces    []Piece
+	pawns     bool
+	kings     int
+	values    []tbValue
+}
+
+var (
+	// tbTriangle are the indices of the white king squares in the pawnless tables by the square
+	tbTriangle = [64]int{
+		0, 1, 2, 3, -1, -1, -1, -1,
+		-1, 4, 5, 6, -1, -1, -1, -1,
+		-1, -1, 7, 8, -1, -1, -1, -1,
+		-1, -1, -1, 9, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1,
+		-1, -1, -1, -1, -1, -1, -1, -1,
+	}
+	tbTriangleSquare = [10]Square{A1, B1, C1, D1, B2, C2, D2, C3, D3, D4}
+)
+
+func newTablebaseTable(signature string) (*tbTable, error) {
+	material, err := parseTablebaseSignature(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &tbTable{signature: signature, pieces: []Piece{WhiteKing, BlackKing}, kings: len(tbTriangleSquare)}
+
+	for _, color := range colors {
+		for _, kind := range pieceKinds[1:] {
+			for range material[color][kind] {
+				t.pieces = append(t.pieces, NewPiece(kind, color))
+			}
+		}
+	}
+
+	if material[White][Pawn]+material[Black][Pawn] > 0 {
+		t.pawns = true
+		t.kings = 32
+	}
+
+	return t, nil
+}
+
+// size is the number of the values: both sides to move, the white king squares and all squares for the other pieces
+func (t *tbTable) size() int {
+	n := 2 * t.kings
+	for range t.pieces[1:] {
+		n *= 64
+	}
+
+	return n
+}
+
+// placement gets the placement of the board pieces, the board is mirrored vertically with the colors swapped when it is flipped
+func (t *tbTable) placement(b *Board, turn Color, flip bool) tbPlacement {
+	occupancies := b.pieceOccupancies
+	pl := tbPlacement{turn: turn}
+
+	if flip {
+		pl.turn = turn.Opposite()
+	}
+
+	for i, piece := range t.pieces {
+		color := piece.Color()
+		if flip {
+			color = color.Opposite()
+		}
+
+		sq := Square(occupancies[color][piece.Kind()].PopLS1B())
+		if flip {
+			sq ^= 56
+		}
+
+		pl.squares[i] = sq
+	}
+
+	return pl
+}
+
+// index gets the index of the placement mirrored into its canonical symmetry
+func (t *tbTable) index(pl tbPlacement) uint32 {
+	n := len(t.pieces)
+	sq := pl.squares
+
+	if sq[0].File() > FileD {
+		for i := range n {
+			sq[i] ^= 7
+		}
+	}
+
+	if t.pawns {
+		return t.rawIndex(sq, pl.turn)
+	}
+
+	if sq[0].Rank() > Rank4 {
+		for i := range n {
+			sq[i] ^= 56
+		}
+	}
+
+	if int(sq[0].Rank()) > int(sq[0].File()) {
+		sq = t.diagonal(sq)
+	}
+
+	// the placement and its diagonal mirror both have the king in the triangle, the lower index is the canonical one
+	if int(sq[0].Rank()) == int(sq[0].File()) {
+		return min(t.rawIndex(sq, pl.turn), t.rawIndex(t.diagonal(sq), pl.turn))
+	}
+
+	return t.rawIndex(sq, pl.turn)
+}
+
+func (t *tbTable) diagonal(sq [TablebaseMaxPieces]Square) [TablebaseMaxPieces]Square {
+	for i := range t.pieces {
+		sq[i] = sq[i]>>3 | (sq[i]&7)<<3
+	}
+
+	return sq
+}
+
+// rawIndex gets the index of the squares without the symmetry, the squares of the same pieces are sorted so they have one index
+func (t *tbTable) rawIndex(sq [TablebaseMaxPieces]Square, turn Color) uint32 {
+	n := len(t.pieces)
+
+	for i := 1; i < n; i++ {
+		for j := i; j > 1 && t.pieces[j] == t.pieces[j-1] && sq[j] < sq[j-1]; j-- {
+			sq[j], sq[j-1] = sq[j-1], sq[j]
+		}
+	}
+
+	var king int
+	if t.pawns {
+		king = int(sq[0].Rank())*4 + int(sq[0].File())
+	} else {
+		king = tbTriangle[sq[0]]
+	}
+
+	idx := uint32(int(turn)*t.kings + king)
+	for _, s := range sq[1:n] {
+		idx = idx*64 + uint32(s)
+	}
+
+	return idx
+}
+
+// decode gets the placement of the index, it is not necessarily valid or canonical
+func (t *tbTable) decode(idx uint32) tbPlacement {
+	var pl tbPlacement
+
+	for i := len(t.pieces) - 1; i > 0; i-- {
+		pl.squares[i] = Square(idx % 64)
+		idx /= 64
+	}
+
+	king := int(idx) % t.kings
+	pl.turn = Color(int(idx) / t.kings)
+
+	if t.pawns {
+		pl.squares[0] = NewSquare(File(king%4), Rank(king/4))
+	} else {
+		pl.squares[0] = tbTriangleSquare[king]
+	}
+
+	return pl
+}
+
+// setup places the pieces of the placement on the position
+func (t *tbTable) setup(p *Position, pl tbPlacement) {
+	*p.Board = Board{}
+
+	for i, piece := range t.pieces {
+		p.Board.pieceOccupancies[piece.Color()][piece.Kind()].setBit(pl.squares[i])
+	}
+
+	p.Board.calcSideOccupancies()
+	p.Turn = pl.turn
+	p.Check = p.Board.IsInCheck(p.Turn)
+}
@@ -0,0 +1,435 @@
+package engine
+
+import (
+	"fmt"
+	"slices"
+)
+
+// the flags of the positions while the table is generated
+const (
+	// tbFlagNonLosing is set when a move out of the table keeps at least the draw
+	tbFlagNonLosing uint8 = 1 << iota
+	// the double push allows the en-passant capture which wins, draws or loses for the opponent
+	tbFlagEpWin
+	tbFlagEpDraw
+	tbFlagEpLoss
+	// tbFlagEpDone is set when the double push with the winning en-passant capture is counted as lost
+	tbFlagEpDone
+
+	tbFlagEp = tbFlagEpWin | tbFlagEpDraw | tbFlagEpLoss
+)
+
+// tbNoLevel is the unset distance to mate while the table is generated
+const tbNoLevel = 255
+
+// tbPredecessor is the position which moves into the table position, double is the double pawn push
+type tbPredecessor struct {
+	idx    uint32
+	double bool
+}
+
+// tbGenerator generates the table by the retrograde analysis:
+// the positions are resolved by the distance to mate from the checkmates, a position is won when it has a move to the lost position
+// and it is lost when all of its moves are to the won positions, the moves out of the table (captures and promotions) are probed in the smaller tables
+// the positions which are not resolved in the end are drawn
+type tbGenerator struct {
+	tb *Tablebase
+	t  *tbTable
+	// values are the resolved values or tbUnknown and tbInvalid
+	values []tbValue
+	// remaining are the numbers of the distinct table positions the moves lead to which are not yet won for the opponent
+	remaining []uint8
+	// maxWin are the longest wins of the opponent after the moves which are counted as lost
+	maxWin []uint8
+	// winLevel are the shortest found wins which are not yet resolved
+	winLevel []uint8
+	flags    []uint8
+	// epLevels are the distances to mate of the en-passant captures after the double pushes
+	epLevels map[uint32]uint8
+	// levels are the positions to resolve by the distance to mate, the odd ones are won and the even ones are lost
+	levels [tbMaxPlies + 1][]uint32
+	// epEvents are the positions with the double push that lose to the en-passant capture by the distance to mate of the capture
+	epEvents [tbMaxPlies + 1][]uint32
+	overflow bool
+
+	moves   MoveList
+	epMoves MoveList
+	preds   []tbPredecessor
+}
+
+func (tb *Tablebase) generate(t *tbTable) error {
+	size := t.size()
+
+	g := &tbGenerator{
+		tb:        tb,
+		t:         t,
+		values:    make([]tbValue, size),
+		remaining: make([]uint8, size),
+		maxWin:    make([]uint8, size),
+		winLevel:  make([]uint8, size),
+		flags:     make([]uint8, size),
+		epLevels:  make(map[uint32]uint8),
+	}
+
+	p := &Position{Board: &Board{}, EpSquare: SquareNone, Variant: VariantStandard, FullMoveClock: 1}
+
+	for idx := range uint32(size) {
+		if err := g.init(p, idx); err != nil {
+			return err
+		}
+	}
+
+	for level := range g.levels {
+		for _, idx := range g.epEvents[level] {
+			if g.values[idx] == tbUnknown && g.flags[idx]&tbFlagEpDone == 0 {
+				g.flags[idx] |= tbFlagEpDone
+				g.lose(idx, level)
+			}
+		}
+
+		for _, idx := range g.levels[level] {
+			if g.values[idx] != tbUnknown {
+				continue
+			}
+
+			if level%2 == 1 {
+				g.values[idx] = tbWin(level)
+				g.resolveWin(idx, level)
+			} else {
+				g.values[idx] = tbLoss(level)
+				g.resolveLoss(idx, level)
+			}
+		}
+
+		g.levels[level], g.epEvents[level] = nil, nil
+	}
+
+	if g.overflow {
+		return fmt.Errorf("distance to mate is longer than %d plies", tbMaxPlies)
+	}
+
+	for i, v := range g.values {
+		if v == tbUnknown || v == tbInvalid {
+			g.values[i] = tbDraw
+		}
+	}
+
+	t.values = g.values
+
+	return nil
+}
+
+// init sets up the position of the index, resolves the checkmates and the stalemates,
+// counts the moves in the table and probes the moves out of it
+func (g *tbGenerator) init(p *Position, idx uint32) error {
+	pl := g.t.decode(idx)
+
+	if !g.valid(pl) || g.t.index(pl) != idx {
+		g.values[idx] = tbInvalid
+		return nil
+	}
+
+	g.t.setup(p, pl)
+
+	if kingAttacksMask[pl.squares[0]].bitIsSet(pl.squares[1]) || p.Board.IsInCheck(p.Turn.Opposite()) {
+		g.values[idx] = tbInvalid
+		return nil
+	}
+
+	g.values[idx] = tbUnknown
+	g.winLevel[idx] = tbNoLevel
+
+	p.GenerateLegalMoves(&g.moves)
+
+	if g.moves.Len() == 0 {
+		if p.Check {
+			g.push(idx, 0)
+		} else {
+			g.values[idx] = tbDraw
+		}
+
+		return nil
+	}
+
+	var (
+		children [MaxMoves]uint32
+		n        int
+		maxWin   int
+		winLevel = tbNoLevel
+	)
+
+	for _, m := range g.moves.Moves() {
+		p.MakeMove(m)
+
+		if m.IsCapture() || m.Promotion().IsPromotion() {
+			v, err := g.tb.lookup(p)
+			if err != nil {
+				p.UnmakeMove()
+				return err
+			}
+
+			switch v = v.parent(); {
+			case v.isWin():
+				winLevel = min(winLevel, v.plies())
+				g.flags[idx] |= tbFlagNonLosing
+			case v.isLoss():
+				maxWin = max(maxWin, v.plies()-1)
+			default:
+				g.flags[idx] |= tbFlagNonLosing
+			}
+		} else {
+			if m.IsDoublePawn() {
+				if err := g.initEnPassant(p, idx); err != nil {
+					p.UnmakeMove()
+					return err
+				}
+			}
+
+			child := g.t.index(g.t.placement(p.Board, p.Turn, false))
+			if !slices.Contains(children[:n], child) {
+				children[n] = child
+				n++
+			}
+		}
+
+		p.UnmakeMove()
+	}
+
+	g.remaining[idx] = uint8(n)
+	g.maxWin[idx] = uint8(maxWin)
+
+	if winLevel != tbNoLevel {
+		g.pushWin(idx, winLevel)
+	}
+
+	if n == 0 && g.flags[idx]&tbFlagNonLosing == 0 {
+		g.push(idx, maxWin+1)
+	}
+
+	return nil
+}
+
+// valid checks that the pieces are on the distinct squares and the pawns are not on the first and last ranks
+func (g *tbGenerator) valid(pl tbPlacement) bool {
+	var occupancy bitboard
+
+	for i, piece := range g.t.pieces {
+		sq := pl.squares[i]
+		if occupancy.bitIsSet(sq) || (piece.IsPawn() && (sq.Rank() == Rank1 || sq.Rank() == Rank8)) {
+			return false
+		}
+
+		occupancy.setBit(sq)
+	}
+
+	return true
+}
+
+// initEnPassant probes the en-passant captures after the double push since the en-passant square is not a part of the table
+func (g *tbGenerator) initEnPassant(p *Position, idx uint32) error {
+	p.GenerateLegalMoves(&g.epMoves)
+
+	var (
+		best  tbValue
+		found bool
+	)
+
+	for _, m := range g.epMoves.Moves() {
+		if !m.IsEnPassant() {
+			continue
+		}
+
+		p.MakeMove(m)
+		v, err := g.tb.lookup(p)
+		p.UnmakeMove()
+
+		if err != nil {
+			return err
+		}
+
+		if v = v.parent(); !found || v.score() > best.score() {
+			best, found = v, true
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	g.epLevels[idx] = uint8(best.plies())
+
+	switch {
+	case best.isWin():
+		g.flags[idx] |= tbFlagEpWin
+		g.epEvents[best.plies()] = append(g.epEvents[best.plies()], idx)
+	case best.isLoss():
+		g.flags[idx] |= tbFlagEpLoss
+	default:
+		g.flags[idx] |= tbFlagEpDraw
+	}
+
+	return nil
+}
+
+func (g *tbGenerator) push(idx uint32, level int) {
+	if level > tbMaxPlies {
+		g.overflow = true
+		return
+	}
+
+	g.levels[level] = append(g.levels[level], idx)
+}
+
+// pushWin pushes the position to be won unless it is already pushed with the shorter win
+func (g *tbGenerator) pushWin(idx uint32, level int) {
+	if level < int(g.winLevel[idx]) {
+		g.winLevel[idx] = uint8(min(level, tbNoLevel))
+		g.push(idx, level)
+	}
+}
+
+// lose counts the move of the position as won for the opponent in the plies, the position is lost when there are no other moves
+func (g *tbGenerator) lose(idx uint32, plies int) {
+	g.remaining[idx]--
+	g.maxWin[idx] = max(g.maxWin[idx], uint8(plies))
+
+	if g.remaining[idx] == 0 && g.flags[idx]&tbFlagNonLosing == 0 {
+		g.push(idx, int(g.maxWin[idx])+1)
+	}
+}
+
+// resolveLoss wins the predecessors of the lost position
+func (g *tbGenerator) resolveLoss(idx uint32, level int) {
+	for _, pred := range g.predecessors(idx) {
+		if g.values[pred.idx] != tbUnknown {
+			continue
+		}
+
+		winLevel := level + 1
+
+		if pred.double {
+			switch g.flags[pred.idx] & tbFlagEp {
+			case tbFlagEpWin, tbFlagEpDraw:
+				// the opponent captures en-passant instead
+				continue
+			case tbFlagEpLoss:
+				// the opponent chooses the longer loss
+				winLevel = max(level, int(g.epLevels[pred.idx])) + 1
+			}
+		}
+
+		g.pushWin(pred.idx, winLevel)
+	}
+}
+
+// resolveWin counts the move of the predecessors to the won position as lost
+func (g *tbGenerator) resolveWin(idx uint32, level int) {
+	for _, pred := range g.predecessors(idx) {
+		if g.values[pred.idx] != tbUnknown {
+			continue
+		}
+
+		if pred.double && g.flags[pred.idx]&tbFlagEpWin != 0 {
+			if g.flags[pred.idx]&tbFlagEpDone != 0 {
+				continue
+			}
+
+			g.flags[pred.idx] |= tbFlagEpDone
+		}
+
+		g.lose(pred.idx, level)
+	}
+}
+
+// predecessors gets the distinct valid positions the side that is not to move could have come from by the non-capture moves
+func (g *tbGenerator) predecessors(idx uint32) []tbPredecessor {
+	pl := g.t.decode(idx)
+	mover := pl.turn.Opposite()
+
+	var occupancy bitboard
+	for i := range g.t.pieces {
+		occupancy.setBit(pl.squares[i])
+	}
+
+	g.preds = g.preds[:0]
+
+	for i, piece := range g.t.pieces {
+		if piece.Color() != mover {
+			continue
+		}
+
+		to := pl.squares[i]
+
+		var from bitboard
+
+		switch piece.Kind() {
+		case King:
+			from = kingAttacksMask[to]
+		case Queen:
+			from = getQueenAttacks(to, occupancy)
+		case Rook:
+			from = getRookAttacks(to, occupancy)
+		case Bishop:
+			from = getBishopAttacks(to, occupancy)
+		case Knight:
+			from = knightsAttacksMask[to]
+		case Pawn:
+			g.addPawnPredecessors(pl, i, occupancy)
+			continue
+		}
+
+		from &^= occupancy
+		for from > 0 {
+			g.addPredecessor(pl, i, Square(from.PopLS1B()), false)
+		}
+	}
+
+	return g.preds
+}
+
+// addPawnPredecessors adds the single and the double pushes of the pawn, the pawn could not have come from the first rank
+func (g *tbGenerator) addPawnPredecessors(pl tbPlacement, i int, occupancy bitboard) {
+	to := pl.squares[i]
+	back, singleRank, doubleRank := Square(-8), Rank3, Rank4
+
+	if g.t.pieces[i].IsBlack() {
+		back, singleRank, doubleRank = 8, Rank6, Rank5
+	}
+
+	single := to + back
+
+	if pawnRankReached(to.Rank(), singleRank, back) && !occupancy.bitIsSet(single) {
+		g.addPredecessor(pl, i, single, false)
+
+		if to.Rank() == doubleRank && !occupancy.bitIsSet(single+back) {
+			g.addPredecessor(pl, i, single+back, true)
+		}
+	}
+}
+
+// pawnRankReached checks that the pawn moving by the direction is at least on the rank
+func pawnRankReached(rank, minRank Rank, back Square) bool {
+	if back < 0 {
+		return rank >= minRank
+	}
+
+	return rank <= minRank
+}
+
+func (g *tbGenerator) addPredecessor(pl tbPlacement, i int, from Square, double bool) {
+	pl.squares[i] = from
+	pl.turn = pl.turn.Opposite()
+
+	idx := g.t.index(pl)
+	if g.values[idx] == tbInvalid {
+		return
+	}
+
+	for _, pred := range g.preds {
+		if pred.idx == idx {
+			return
+		}
+	}
+
+	g.preds = append(g.preds, tbPredecessor{idx: idx, double: double})
+}
@@ -0,0 +1,224 @@
+package engine
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+// testTablebase is shared by the tests so the tables are generated once
+var testTablebase = sync.OnceValue(func() *Tablebase { return NewTablebase("") })
+
+func loadTablebasePosition(t *testing.T, fen string) *Position {
+	t.Helper()
+
+	p := &Position{}
+	if err := p.LoadFromFEN(fen); err != nil {
+		t.Fatalf("failed to load fen: %v", err)
+	}
+
+	return p
+}
+
+func TestTablebaseProbe(t *testing.T) {
+	tb := testTablebase()
+
+	testCases := map[string]struct {
+		fen  string
+		want TablebaseResult
+	}{
+		"checkmated":              {fen: "k7/1Q6/1K6/8/8/8/8/8 b - - 0 1", want: TablebaseResult{WDL: WDLLoss, DTM: 0}},
+		"stalemate":               {fen: "k7/2Q5/1K6/8/8/8/8/8 b - - 0 1", want: TablebaseResult{WDL: WDLDraw}},
+		"mate in one":             {fen: "k7/8/1K6/8/8/8/8/6Q1 w - - 0 1", want: TablebaseResult{WDL: WDLWin, DTM: 1}},
+		"black mates in one":      {fen: "K7/8/1k6/8/8/8/8/6q1 b - - 0 1", want: TablebaseResult{WDL: WDLWin, DTM: 1}},
+		"queen is captured":       {fen: "8/8/8/8/8/2k5/8/Kq6 w - - 0 1", want: TablebaseResult{WDL: WDLDraw}},
+		"rook pawn in the corner": {fen: "k7/8/8/8/8/8/P7/K7 w - - 0 1", want: TablebaseResult{WDL: WDLDraw}},
+		"king in front of pawn":   {fen: "4k3/8/4K3/4P3/8/8/8/8 b - - 0 1", want: TablebaseResult{WDL: WDLLoss, DTM: 24}},
+		"bare kings":              {fen: "8/8/8/4k3/8/8/8/4K3 w - - 0 1", want: TablebaseResult{WDL: WDLDraw}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := loadTablebasePosition(t, tc.fen).ProbeTablebase(tb)
+			if err != nil {
+				t.Fatalf("failed to probe: %v", err)
+			}
+
+			if got != tc.want {
+				t.Fatalf("invalid result: want %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTablebaseLongestMate(t *testing.T) {
+	tb := testTablebase()
+
+	testCases := map[string]struct {
+		signature string
+		want      int
+	}{
+		"queen": {signature: "KQvK", want: 19},
+		"rook":  {signature: "KRvK", want: 31},
+		"pawn":  {signature: "KvKP", want: 55},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if err := tb.Generate(tc.signature); err != nil {
+				t.Fatalf("failed to generate: %v", err)
+			}
+
+			material, _ := parseTablebaseSignature(tc.signature)
+			sig, _ := material.signature()
+
+			var got int
+			for _, v := range tb.tables[sig].values {
+				if v.isWin() {
+					got = max(got, v.plies())
+				}
+			}
+
+			if got != tc.want {
+				t.Fatalf("invalid longest mate: want %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTablebaseBestMove(t *testing.T) {
+	tb := testTablebase()
+
+	testCases := map[string]struct {
+		fen string
+	}{
+		"queen": {fen: "8/8/8/3k4/8/8/8/KQ6 w - - 0 1"},
+		"rook":  {fen: "8/8/8/3k4/8/8/8/KR6 b - - 0 1"},
+		"pawn":  {fen: "4k3/8/4K3/4P3/8/8/8/8 b - - 0 1"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := loadTablebasePosition(t, tc.fen)
+
+			start, err := p.ProbeTablebase(tb)
+			if err != nil {
+				t.Fatalf("failed to probe: %v", err)
+			}
+
+			if start.WDL == WDLDraw {
+				t.Fatalf("invalid result: want decisive, got %+v", start)
+			}
+
+			for ply := range start.DTM {
+				m, res, err := tb.BestMove(p)
+				if err != nil {
+					t.Fatalf("failed to get best move: %v", err)
+				}
+
+				if res.DTM != start.DTM-ply {
+					t.Fatalf("invalid distance to mate at ply %d: want %d, got %d", ply, start.DTM-ply, res.DTM)
+				}
+
+				p.MakeMove(m)
+			}
+
+			var ml MoveList
+			if p.GenerateLegalMoves(&ml); ml.Len() != 0 || !p.Board.IsInCheck(p.Turn) {
+				t.Fatalf("invalid final position: want checkmate, got %s", p.Fen())
+			}
+		})
+	}
+}
+
+func TestTablebaseCache(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := NewTablebase(dir).Generate("KvKQ"); err != nil {
+		t.Fatalf("failed to generate: %v", err)
+	}
+
+	path := filepath.Join(dir, "KQvK.jtb")
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("invalid cache: %v", err)
+	}
+
+	p := loadTablebasePosition(t, "k7/8/1K6/8/8/8/8/6Q1 w - - 0 1")
+
+	got, err := p.ProbeTablebase(NewTablebase(dir))
+	if err != nil {
+		t.Fatalf("failed to probe cached table: %v", err)
+	}
+
+	if want := (TablebaseResult{WDL: WDLWin, DTM: 1}); got != want {
+		t.Fatalf("invalid cached result: want %+v, got %+v", want, got)
+	}
+
+	if err := os.WriteFile(path, []byte("JTB1"), 0o644); err != nil {
+		t.Fatalf("failed to corrupt cache: %v", err)
+	}
+
+	if _, err := p.ProbeTablebase(NewTablebase(dir)); !errors.Is(err, ErrTablebaseInvalidFile) {
+		t.Fatalf("invalid error: want %v, got %v", ErrTablebaseInvalidFile, err)
+	}
+}
+
+func TestTablebaseUnsupported(t *testing.T) {
+	tb := testTablebase()
+
+	testCases := map[string]struct {
+		fen     string
+		variant Variant
+	}{
+		"five pieces": {fen: "4k3/8/8/8/8/8/3PP3/3QK3 w - - 0 1"},
+		"castle":      {fen: "4k3/8/8/8/8/8/8/4K2R w K - 0 1"},
+		"variant":     {fen: "4k3/8/8/8/8/8/8/3QK3 w - - 0 1", variant: VariantAtomic},
+		"illegal":     {fen: "k7/8/1K6/8/8/8/8/7Q w - - 0 1"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := &Position{}
+			if err := p.LoadFromFENVariant(tc.fen, tc.variant); err != nil {
+				t.Fatalf("failed to load fen: %v", err)
+			}
+
+			if _, err := p.ProbeTablebase(tb); !errors.Is(err, ErrTablebaseUnsupported) {
+				t.Fatalf("invalid error: want %v, got %v", ErrTablebaseUnsupported, err)
+			}
+		})
+	}
+}
+
+func TestTablebaseSignature(t *testing.T) {
+	testCases := map[string]struct {
+		signature string
+		want      string
+		wantFlip  bool
+		wantErr   error
+	}{
+		"canonical":      {signature: "KQvK", want: "KQvK"},
+		"stronger black": {signature: "KvKQ", want: "KQvK", wantFlip: true},
+		"sorted pieces":  {signature: "KNBvK", want: "KBNvK"},
+		"rook for queen": {signature: "KRvKQ", want: "KQvKR", wantFlip: true},
+		"lowercase":      {signature: "kpvkp", want: "KPvKP"},
+		"invalid piece":  {signature: "KQvX", wantErr: ErrTablebaseUnsupported},
+		"missing king":   {signature: "QvK", wantErr: ErrTablebaseUnsupported},
+		"five pieces":    {signature: "KQRvKR", wantErr: ErrTablebaseUnsupported},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			material, err := parseTablebaseSignature(tc.signature)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("invalid error: want %v, got %v", tc.wantErr, err)
+			}
+
+			if tc.wantErr != nil {
+				return
+			}
+
+			got, flip := material.signature()
+			if got != tc.want || flip != tc.wantFlip {
+				t.Fatalf("invalid signature: want %s %v, got %s %v", tc.want, tc.wantFlip, got, flip)
+			}
+		})
+	}
+}
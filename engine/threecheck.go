@@ -0,0 +1,43 @@
+package engine
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// threeCheckLimit is the number of checks that wins the three-check game
+const threeCheckLimit = 3
+
+// reFenChecks are the checks given by white and black at the end of the three-check fen e.g. `+2+1`
+var reFenChecks = regexp.MustCompile(`^\+([0-3])\+([0-3])$`)
+
+// parseFenChecks parses the checks given by each color from the three-check fen suffix
+func parseFenChecks(token string) ([2]uint8, error) {
+	matches := reFenChecks.FindStringSubmatch(token)
+	if matches == nil {
+		return [2]uint8{}, fmt.Errorf("invalid FEN: invalid three-check checks %q", token)
+	}
+
+	return [2]uint8{matches[1][0] - '0', matches[2][0] - '0'}, nil
+}
+
+// fenChecksPart returns the three-check fen suffix with the leading space e.g. ` +2+1`
+func (p *Position) fenChecksPart() string {
+	return fmt.Sprintf(" +%d+%d", p.Checks[White], p.Checks[Black])
+}
+
+// isThreeCheck checks if the side gave the three checks in three-check
+func (p *Position) isThreeCheck(side Color) bool {
+	return p.Variant == VariantThreeCheck && p.Checks[side] >= threeCheckLimit
+}
+
+// addCheck counts the check given by the side
+func (p *Position) addCheck(side Color) {
+	if p.Checks[side] >= threeCheckLimit {
+		return
+	}
+
+	p.Hash ^= zobristCheckKey(side, p.Checks[side])
+	p.Checks[side]++
+	p.Hash ^= zobristCheckKey(side, p.Checks[side])
+}
@@ -0,0 +1,106 @@
+package engine
+
+import (
+	"testing"
+)
+
+func TestThreeCheckPerft(t *testing.T) {
+	testCases := map[string]struct {
+		fen   string
+		nodes []int64
+	}{
+		"starting position": {fen: FENStartingPosition + " +0+0", nodes: []int64{20, 400, 8902, 197281}},
+		"kiwipete":          {fen: "r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1 +1+1", nodes: []int64{48, 2039, 97862}},
+		"two checks given":  {fen: "rnbqkb1r/pppp1ppp/5n2/4p3/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq - 2 3 +2+0", nodes: []int64{27, 725, 20991}},
+		"in check":          {fen: "r1bqk2r/pppp1Bpp/2n2n2/2b1p3/4P3/5N2/PPPP1PPP/RNBQK2R b KQkq - 0 4 +1+0", nodes: []int64{3, 91, 3380, 103624}},
+		"last checks":       {fen: "4k3/8/8/8/8/8/8/4KQ2 w - - 0 1 +2+2", nodes: []int64{20, 57, 1469}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for i, want := range tc.nodes {
+				if got := Perft(tc.fen, i+1); got != want {
+					t.Fatalf("invalid perft nodes at depth %d: want %d, got %d", i+1, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestThreeCheckFen(t *testing.T) {
+	testCases := map[string]struct {
+		fen     string
+		wantErr bool
+	}{
+		"no checks":             {fen: FENStartingPosition + " +0+0"},
+		"checks given":          {fen: "r1bq1bnr/pppp1kpp/2n5/4p3/4P3/8/PPPP1PPP/RNBQK1NR w KQ - 0 4 +1+0"},
+		"game over":             {fen: "4k3/5Q2/8/8/8/8/8/4K3 b - - 1 1 +3+2"},
+		"fails too many checks": {fen: FENStartingPosition + " +4+0", wantErr: true},
+		"fails invalid checks":  {fen: FENStartingPosition + " 3+3", wantErr: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := &Position{}
+
+			err := p.LoadFromFEN(tc.fen)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("invalid error: want %v, got %v", tc.wantErr, err)
+			}
+
+			if tc.wantErr {
+				return
+			}
+
+			if p.Variant != VariantThreeCheck {
+				t.Fatalf("invalid variant: want %s, got %s", VariantThreeCheck, p.Variant)
+			}
+
+			if got := p.Fen(); got != tc.fen {
+				t.Fatalf("invalid fen: want %s, got %s", tc.fen, got)
+			}
+		})
+	}
+}
+
+func TestThreeCheckMoves(t *testing.T) {
+	testCases := map[string]struct {
+		fen        string
+		moves      []string
+		wantFen    string
+		wantStatus Status
+	}{
+		"check is counted":   {fen: FENStartingPosition + " +0+0", moves: []string{"e2e4", "e7e5", "f1c4", "b8c6", "c4f7", "e8f7"}, wantFen: "r1bq1bnr/pppp1kpp/2n5/4p3/4P3/8/PPPP1PPP/RNBQK1NR w KQ - 0 4 +1+0", wantStatus: StatusUnknown},
+		"third check wins":   {fen: "4k3/8/8/8/8/8/8/4KQ2 w - - 0 1 +2+2", moves: []string{"f1f7"}, wantFen: "4k3/5Q2/8/8/8/8/8/4K3 b - - 1 1 +3+2", wantStatus: StatusThreeCheck},
+		"standard fen input": {fen: FENStartingPosition, moves: []string{"e2e4", "f7f6", "d1h5"}, wantFen: "rnbqkbnr/ppppp1pp/5p2/7Q/4P3/8/PPPP1PPP/RNB1KBNR b KQkq - 1 2 +1+0", wantStatus: StatusUnknown},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewChessVariant(tc.fen, VariantThreeCheck)
+			if err != nil {
+				t.Fatalf("invalid fen: %v", err)
+			}
+
+			for _, uci := range tc.moves {
+				if _, err := c.MakeMoveUCI(uci); err != nil {
+					t.Fatalf("failed to make move %s: %v", uci, err)
+				}
+			}
+
+			if got := c.Position.Fen(); got != tc.wantFen {
+				t.Fatalf("invalid fen: want %s, got %s", tc.wantFen, got)
+			}
+
+			if got := c.Status(); got != tc.wantStatus {
+				t.Fatalf("invalid status: want %d, got %d", tc.wantStatus, got)
+			}
+
+			p := &Position{}
+			if err := p.LoadFromFEN(tc.wantFen); err != nil {
+				t.Fatalf("invalid fen: %v", err)
+			}
+
+			if p.Hash != c.Position.Hash {
+				t.Fatalf("invalid hash after the moves: want %d, got %d", p.Hash, c.Position.Hash)
+			}
+		})
+	}
+}
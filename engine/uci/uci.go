@@ -0,0 +1,358 @@
+package uci
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dankobg/juicer/engine"
+	"github.com/dankobg/juicer/engine/search"
+)
+
+const (
+	EngineName   = "juicer"
+	EngineAuthor = "dankobg"
+
+	minHashMB  = 1
+	maxHashMB  = 4096
+	minMultiPV = 1
+	maxMultiPV = 256
+)
+
+// Engine speaks the UCI protocol (https://www.wbec-ridderkerk.nl/html/UCIProtocol.html) over the reader and the writer
+type Engine struct {
+	in  io.Reader
+	out io.Writer
+
+	// mu guards the writes to out from the search goroutine
+	mu       sync.Mutex
+	searcher *search.Searcher
+	chess    *engine.Chess
+	multiPV  int
+	// chess960 writes and reads the castle moves in the king takes rook notation
+	chess960 bool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func New(in io.Reader, out io.Writer) *Engine {
+	return &Engine{
+		in:       in,
+		out:      out,
+		searcher: search.NewSearcher(search.DefaultHashMB),
+		multiPV:  1,
+	}
+}
+
+// Run reads the commands until the quit command or the end of the input
+func (e *Engine) Run(ctx context.Context) error {
+	defer e.stop()
+
+	scanner := bufio.NewScanner(e.in)
+	for scanner.Scan() {
+		if quit := e.handle(ctx, scanner.Text()); quit {
+			return nil
+		}
+	}
+
+	return scanner.Err()
+}
+
+// handle executes the single command and reports if the engine should quit
+func (e *Engine) handle(ctx context.Context, line string) bool {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return false
+	}
+
+	cmd, args := fields[0], fields[1:]
+
+	switch cmd {
+	case "uci":
+		e.writeln("id name %s", EngineName)
+		e.writeln("id author %s", EngineAuthor)
+		e.writeln("option name Hash type spin default %d min %d max %d", search.DefaultHashMB, minHashMB, maxHashMB)
+		e.writeln("option name MultiPV type spin default 1 min %d max %d", minMultiPV, maxMultiPV)
+		e.writeln("option name UCI_Chess960 type check default false")
+		e.writeln("uciok")
+	case "isready":
+		e.writeln("readyok")
+	case "ucinewgame":
+		e.stop()
+		e.searcher.Clear()
+		e.chess = nil
+	case "setoption":
+		e.stop()
+		e.setOption(args)
+	case "position":
+		e.stop()
+		e.setPosition(args)
+	case "go":
+		e.stop()
+		e.startSearch(ctx, args)
+	case "stop":
+		e.stop()
+	case "quit":
+		return true
+	default:
+		e.writeln("info string unknown command: %s", cmd)
+	}
+
+	return false
+}
+
+func (e *Engine) setOption(args []string) {
+	// setoption name <id> [value <x>]
+	var name, value []string
+
+	target := &name
+
+	for _, arg := range args {
+		switch arg {
+		case "name":
+			target = &name
+		case "value":
+			target = &value
+		default:
+			*target = append(*target, arg)
+		}
+	}
+
+	switch strings.ToLower(strings.Join(name, " ")) {
+	case "hash":
+		mb, err := strconv.Atoi(strings.Join(value, " "))
+		if err != nil || mb < minHashMB || mb > maxHashMB {
+			e.writeln("info string invalid Hash value: %s", strings.Join(value, " "))
+			return
+		}
+
+		e.searcher.ResizeHash(mb)
+	case "multipv":
+		n, err := strconv.Atoi(strings.Join(value, " "))
+		if err != nil || n < minMultiPV || n > maxMultiPV {
+			e.writeln("info string invalid MultiPV value: %s", strings.Join(value, " "))
+			return
+		}
+
+		e.multiPV = n
+	case "uci_chess960":
+		enabled, err := strconv.ParseBool(strings.Join(value, " "))
+		if err != nil {
+			e.writeln("info string invalid UCI_Chess960 value: %s", strings.Join(value, " "))
+			return
+		}
+
+		e.chess960 = enabled
+	default:
+		e.writeln("info string unknown option: %s", strings.Join(name, " "))
+	}
+}
+
+func (e *Engine) setPosition(args []string) {
+	// position [fen <fenstring> | startpos] moves <move1> ... <movei>
+	if len(args) == 0 {
+		return
+	}
+
+	fen := engine.FENStartingPosition
+	rest := args[1:]
+
+	switch args[0] {
+	case "startpos":
+	case "fen":
+		end := len(rest)
+		for i, arg := range rest {
+			if arg == "moves" {
+				end = i
+				break
+			}
+		}
+
+		fen = strings.Join(rest[:end], " ")
+		rest = rest[end:]
+	default:
+		e.writeln("info string invalid position: %s", strings.Join(args, " "))
+		return
+	}
+
+	c, err := e.newChess(fen)
+	if err != nil {
+		e.writeln("info string invalid fen: %v", err)
+		return
+	}
+
+	if len(rest) > 0 && rest[0] == "moves" {
+		for _, uciMove := range rest[1:] {
+			if _, err := c.MakeMoveUCI(uciMove); err != nil {
+				e.writeln("info string invalid move %s: %v", uciMove, err)
+				return
+			}
+		}
+	}
+
+	e.chess = c
+}
+
+func (e *Engine) newChess(fen string) (*engine.Chess, error) {
+	if e.chess960 {
+		return engine.NewChess960(fen)
+	}
+
+	return engine.NewChess(fen)
+}
+
+func (e *Engine) startSearch(ctx context.Context, args []string) {
+	limits, err := parseLimits(args)
+	if err != nil {
+		e.writeln("info string invalid go command: %v", err)
+		return
+	}
+
+	limits.MultiPV = e.multiPV
+
+	if e.chess == nil {
+		c, err := e.newChess(engine.FENStartingPosition)
+		if err != nil {
+			e.writeln("info string failed to load starting position: %v", err)
+			return
+		}
+
+		e.chess = c
+	}
+
+	searchCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	e.cancel, e.done = cancel, done
+
+	go func() {
+		defer close(done)
+
+		res := e.searcher.Search(searchCtx, e.chess, limits, e.writeInfo)
+
+		// the best move must not be sent before the stop command when searching infinitely
+		if limits.Infinite {
+			<-searchCtx.Done()
+		}
+
+		e.writeBestMove(res)
+	}()
+}
+
+// stop stops the running search and waits for its best move
+func (e *Engine) stop() {
+	if e.cancel == nil {
+		return
+	}
+
+	e.cancel()
+	<-e.done
+
+	e.cancel, e.done = nil, nil
+}
+
+func parseLimits(args []string) (search.Limits, error) {
+	var limits search.Limits
+
+	for i := 0; i < len(args); i++ {
+		name := args[i]
+
+		if name == "infinite" {
+			limits.Infinite = true
+			continue
+		}
+
+		if name == "ponder" {
+			continue
+		}
+
+		if i+1 >= len(args) {
+			return limits, fmt.Errorf("missing value for %s", name)
+		}
+
+		i++
+
+		value, err := strconv.ParseInt(args[i], 10, 64)
+		if err != nil {
+			return limits, fmt.Errorf("invalid value for %s: %w", name, err)
+		}
+
+		switch name {
+		case "depth":
+			limits.Depth = int(value)
+		case "nodes":
+			limits.Nodes = value
+		case "movetime":
+			limits.MoveTime = time.Duration(value) * time.Millisecond
+		case "wtime":
+			limits.WTime = time.Duration(value) * time.Millisecond
+		case "btime":
+			limits.BTime = time.Duration(value) * time.Millisecond
+		case "winc":
+			limits.WInc = time.Duration(value) * time.Millisecond
+		case "binc":
+			limits.BInc = time.Duration(value) * time.Millisecond
+		case "movestogo":
+			limits.MovesToGo = int(value)
+		case "mate":
+			limits.Mate = int(value)
+		default:
+			return limits, fmt.Errorf("unknown limit %s", name)
+		}
+	}
+
+	return limits, nil
+}
+
+func (e *Engine) writeInfo(info search.Info) {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "info depth %d seldepth %d multipv %d", info.Depth, info.SelDepth, info.MultiPV)
+
+	if info.Mate != 0 {
+		fmt.Fprintf(&sb, " score mate %d", info.Mate)
+	} else {
+		fmt.Fprintf(&sb, " score cp %d", info.Score)
+	}
+
+	ms := info.Time.Milliseconds()
+	nps := info.Nodes
+	if ms > 0 {
+		nps = info.Nodes * 1000 / ms
+	}
+
+	fmt.Fprintf(&sb, " nodes %d nps %d time %d pv", info.Nodes, nps, ms)
+
+	for _, m := range info.PV {
+		sb.WriteString(" " + m.ToUCI())
+	}
+
+	e.writeln("%s", sb.String())
+}
+
+func (e *Engine) writeBestMove(res search.Result) {
+	if res.BestMove == 0 {
+		e.writeln("bestmove 0000")
+		return
+	}
+
+	if res.PonderMove != 0 {
+		e.writeln("bestmove %s ponder %s", res.BestMove.ToUCI(), res.PonderMove.ToUCI())
+		return
+	}
+
+	e.writeln("bestmove %s", res.BestMove.ToUCI())
+}
+
+func (e *Engine) writeln(format string, args ...any) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	fmt.Fprintf(e.out, format+"\n", args...)
+}
@@ -0,0 +1,260 @@
+package uci
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dankobg/juicer/engine/search"
+)
+
+type testSession struct {
+	t     *testing.T
+	in    *io.PipeWriter
+	lines chan string
+	done  chan error
+}
+
+func newTestSession(t *testing.T) *testSession {
+	t.Helper()
+
+	inReader, inWriter := io.Pipe()
+	outReader, outWriter := io.Pipe()
+
+	s := &testSession{t: t, in: inWriter, lines: make(chan string, 1024), done: make(chan error, 1)}
+
+	go func() {
+		s.done <- New(inReader, outWriter).Run(context.Background())
+		outWriter.Close()
+	}()
+
+	go func() {
+		scanner := bufio.NewScanner(outReader)
+		for scanner.Scan() {
+			s.lines <- scanner.Text()
+		}
+		close(s.lines)
+	}()
+
+	t.Cleanup(func() { inWriter.Close() })
+
+	return s
+}
+
+func (s *testSession) send(cmd string) {
+	s.t.Helper()
+
+	if _, err := fmt.Fprintln(s.in, cmd); err != nil {
+		s.t.Fatalf("failed to send command %q: %v", cmd, err)
+	}
+}
+
+// expect reads the output lines until the line with the prefix and returns all the read lines
+func (s *testSession) expect(prefix string) []string {
+	s.t.Helper()
+
+	var lines []string
+
+	timeout := time.After(10 * time.Second)
+
+	for {
+		select {
+		case line, ok := <-s.lines:
+			if !ok {
+				s.t.Fatalf("output closed while waiting for %q, got %v", prefix, lines)
+			}
+
+			lines = append(lines, line)
+
+			if strings.HasPrefix(line, prefix) {
+				return lines
+			}
+		case <-timeout:
+			s.t.Fatalf("timed out waiting for %q, got %v", prefix, lines)
+		}
+	}
+}
+
+func TestHandshake(t *testing.T) {
+	s := newTestSession(t)
+
+	s.send("uci")
+
+	lines := s.expect("uciok")
+	if lines[0] != "id name "+EngineName {
+		t.Fatalf("invalid id name: want %q, got %q", "id name "+EngineName, lines[0])
+	}
+
+	s.send("isready")
+	s.expect("readyok")
+
+	s.send("quit")
+
+	if err := <-s.done; err != nil {
+		t.Fatalf("failed to quit: %v", err)
+	}
+}
+
+func TestGo(t *testing.T) {
+	testCases := map[string]struct {
+		position string
+		goCmd    string
+		want     string
+	}{
+		"mate in one from fen":       {position: "position fen 6k1/5ppp/8/8/8/8/5PPP/R5K1 w - - 0 1", goCmd: "go depth 3", want: "bestmove a1a8"},
+		"fools mate after moves":     {position: "position startpos moves f2f3 e7e5 g2g4", goCmd: "go depth 2", want: "bestmove d8h4"},
+		"fen with moves":             {position: "position fen 4k3/8/8/3q4/8/8/8/3RK3 b - - 0 1 moves d5e5 e1f2 e5d5", goCmd: "go movetime 200", want: "bestmove d1d5"},
+		"no legal moves":             {position: "position fen k7/1Q6/1K6/8/8/8/8/8 b - - 0 1", goCmd: "go depth 2", want: "bestmove 0000"},
+		"clock time":                 {position: "position fen 6k1/5ppp/8/8/8/8/5PPP/R5K1 w - - 0 1", goCmd: "go wtime 1000 btime 1000 winc 10 binc 10", want: "bestmove a1a8"},
+		"nodes limit from startpos":  {position: "position startpos", goCmd: "go nodes 1000", want: "bestmove "},
+		"missing position uses init": {position: "ucinewgame", goCmd: "go depth 1", want: "bestmove "},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := newTestSession(t)
+
+			s.send(tc.position)
+			s.send(tc.goCmd)
+
+			lines := s.expect("bestmove")
+			if got := lines[len(lines)-1]; !strings.HasPrefix(got, tc.want) {
+				t.Fatalf("invalid best move: want %q, got %q (%v)", tc.want, got, lines)
+			}
+
+			s.send("quit")
+		})
+	}
+}
+
+func TestGoInfiniteAndStop(t *testing.T) {
+	s := newTestSession(t)
+
+	s.send("position startpos")
+	s.send("go infinite")
+
+	s.expect("info depth 3")
+	s.send("stop")
+
+	lines := s.expect("bestmove")
+	if got := lines[len(lines)-1]; got == "bestmove 0000" {
+		t.Fatalf("invalid best move after stop: got %q", got)
+	}
+
+	s.send("quit")
+}
+
+func TestSetOptionMultiPV(t *testing.T) {
+	s := newTestSession(t)
+
+	s.send("setoption name MultiPV value 3")
+	s.send("setoption name Hash value 8")
+	s.send("position startpos")
+	s.send("go depth 2")
+
+	lines := s.expect("bestmove")
+
+	ranks := map[string]bool{}
+
+	for _, line := range lines {
+		if !strings.HasPrefix(line, "info depth 2 ") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		for i, f := range fields {
+			if f == "multipv" {
+				ranks[fields[i+1]] = true
+			}
+		}
+	}
+
+	if len(ranks) != 3 {
+		t.Fatalf("invalid multipv lines at depth 2: want %d, got %d (%v)", 3, len(ranks), lines)
+	}
+
+	s.send("setoption name MultiPV value 0")
+	lines = s.expect("info string")
+
+	if got := lines[len(lines)-1]; !strings.Contains(got, "invalid MultiPV") {
+		t.Fatalf("invalid option error: got %q", got)
+	}
+
+	s.send("quit")
+}
+
+func TestSetOptionChess960(t *testing.T) {
+	testCases := map[string]struct {
+		chess960 string
+		want     []string
+	}{
+		"standard castle notation": {chess960: "false", want: []string{"e1g1", "e1c1"}},
+		"king takes rook notation": {chess960: "true", want: []string{"e1h1", "e1a1"}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := newTestSession(t)
+
+			// every root move is reported in its own multipv line
+			s.send("setoption name MultiPV value 256")
+			s.send("setoption name UCI_Chess960 value " + tc.chess960)
+			s.send("position fen r3k2r/pppppppp/8/8/8/8/PPPPPPPP/R3K2R w KQkq - 0 1")
+			s.send("go depth 1")
+
+			lines := s.expect("bestmove")
+
+			rootMoves := map[string]bool{}
+
+			for _, line := range lines {
+				if _, pv, ok := strings.Cut(line, " pv "); ok {
+					rootMoves[strings.Fields(pv)[0]] = true
+				}
+			}
+
+			for _, m := range tc.want {
+				if !rootMoves[m] {
+					t.Fatalf("invalid castle move notation: want %s, got %v", m, lines)
+				}
+			}
+
+			s.send("quit")
+		})
+	}
+}
+
+func TestParseLimits(t *testing.T) {
+	testCases := map[string]struct {
+		args    string
+		want    search.Limits
+		wantErr bool
+	}{
+		"depth":       {args: "depth 5", want: search.Limits{Depth: 5}},
+		"nodes":       {args: "nodes 1000", want: search.Limits{Nodes: 1000}},
+		"movetime":    {args: "movetime 250", want: search.Limits{MoveTime: 250 * time.Millisecond}},
+		"clock":       {args: "wtime 60000 btime 50000 winc 1000 binc 500 movestogo 20", want: search.Limits{WTime: time.Minute, BTime: 50 * time.Second, WInc: time.Second, BInc: 500 * time.Millisecond, MovesToGo: 20}},
+		"infinite":    {args: "infinite", want: search.Limits{Infinite: true}},
+		"mate":        {args: "mate 3", want: search.Limits{Mate: 3}},
+		"no value":    {args: "depth", wantErr: true},
+		"bad value":   {args: "depth x", wantErr: true},
+		"unknown":     {args: "foo 1", wantErr: true},
+		"no limits":   {args: "", want: search.Limits{}},
+		"with ponder": {args: "ponder wtime 1000", want: search.Limits{WTime: time.Second}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseLimits(strings.Fields(tc.args))
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("invalid error: want error %v, got %v", tc.wantErr, err)
+			}
+
+			if !tc.wantErr && got != tc.want {
+				t.Fatalf("invalid limits: want %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,422 @@
+package uciclient
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTimeout = 10 * time.Second
+	infoBufferSize = 256
+)
+
+var (
+	ErrInvalidResponse = errors.New("invalid engine response")
+	ErrTimeout         = errors.New("engine did not respond in time")
+	ErrClosed          = errors.New("engine is closed")
+)
+
+// Config describes how to start the engine binary
+type Config struct {
+	Path string
+	Args []string
+	// Options are set after every (re)start, e.g. {"Hash": "64", "Threads": "2"}
+	Options map[string]string
+	// Timeout is how long to wait for the engine to answer to uci, isready and stop (default 10s)
+	Timeout time.Duration
+}
+
+// Client is the UCI engine process, the commands are serialized so only one of them runs at the time
+type Client struct {
+	cfg Config
+
+	// mu is held for the whole command (and the whole search), wmu only for writing to the engine and for closed
+	mu  sync.Mutex
+	wmu sync.Mutex
+
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	lines  chan string
+	done   chan struct{}
+	closed bool
+
+	name    string
+	author  string
+	options []Option
+}
+
+// New starts the engine process and runs the uci handshake
+func New(ctx context.Context, cfg Config) (*Client, error) {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+
+	cfg.Options = maps.Clone(cfg.Options)
+	if cfg.Options == nil {
+		cfg.Options = make(map[string]string)
+	}
+
+	c := &Client{cfg: cfg}
+
+	if err := c.start(ctx); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Client) Name() string {
+	return c.name
+}
+
+func (c *Client) Author() string {
+	return c.author
+}
+
+// Options returns the options declared by the engine in the handshake
+func (c *Client) Options() []Option {
+	return slices.Clone(c.options)
+}
+
+func (c *Client) start(ctx context.Context) error {
+	cmd := exec.Command(c.cfg.Path, c.cfg.Args...)
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return fmt.Errorf("failed to open engine stdin: %w", err)
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("failed to open engine stdout: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start engine %s: %w", c.cfg.Path, err)
+	}
+
+	lines := make(chan string)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(lines)
+
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			select {
+			case lines <- scanner.Text():
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	c.wmu.Lock()
+	c.cmd, c.stdin, c.lines, c.done, c.closed = cmd, stdin, lines, done, false
+	c.wmu.Unlock()
+
+	c.name, c.author, c.options = "", "", nil
+
+	if err := c.handshake(ctx); err != nil {
+		c.kill()
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) handshake(ctx context.Context) error {
+	if err := c.send("uci"); err != nil {
+		return err
+	}
+
+	err := c.readUntil(ctx, c.cfg.Timeout, func(line string) (bool, error) {
+		switch {
+		case strings.HasPrefix(line, "id name "):
+			c.name = strings.TrimPrefix(line, "id name ")
+		case strings.HasPrefix(line, "id author "):
+			c.author = strings.TrimPrefix(line, "id author ")
+		case strings.HasPrefix(line, "option "):
+			opt, err := parseOption(line)
+			if err != nil {
+				return false, err
+			}
+
+			c.options = append(c.options, opt)
+		case line == "uciok":
+			return true, nil
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed uci handshake: %w", err)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(c.cfg.Options)) {
+		if err := c.send(setOptionCommand(name, c.cfg.Options[name])); err != nil {
+			return err
+		}
+	}
+
+	return c.isReady(ctx)
+}
+
+// Restart kills the engine process and starts it again with the same config and options
+func (c *Client) Restart(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.kill()
+
+	return c.start(ctx)
+}
+
+// Close sends quit and kills the engine if it does not exit in time
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.isClosed() {
+		return nil
+	}
+
+	_ = c.send("quit")
+
+	exited := make(chan struct{})
+
+	go func() {
+		_ = c.cmd.Wait()
+		close(exited)
+	}()
+
+	select {
+	case <-exited:
+		c.markClosed()
+	case <-time.After(c.cfg.Timeout):
+		c.markClosed()
+		_ = c.cmd.Process.Kill()
+		<-exited
+	}
+
+	return nil
+}
+
+// Broken reports if the engine process was closed, crashed or killed because it stopped responding
+func (c *Client) Broken() bool {
+	return c.isClosed()
+}
+
+func (c *Client) isClosed() bool {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+
+	return c.closed
+}
+
+// markClosed reports if the client was closed by this call
+func (c *Client) markClosed() bool {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+
+	if c.closed {
+		return false
+	}
+
+	c.closed = true
+	close(c.done)
+	_ = c.stdin.Close()
+
+	return true
+}
+
+func (c *Client) kill() {
+	if !c.markClosed() {
+		return
+	}
+
+	_ = c.cmd.Process.Kill()
+	_ = c.cmd.Wait()
+}
+
+// IsReady waits until the engine processes all the previous commands
+func (c *Client) IsReady(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.isReady(ctx)
+}
+
+func (c *Client) isReady(ctx context.Context) error {
+	if err := c.send("isready"); err != nil {
+		return err
+	}
+
+	return c.readUntil(ctx, c.cfg.Timeout, func(line string) (bool, error) {
+		return line == "readyok", nil
+	})
+}
+
+// SetOption sets the engine option, it is set again after the restart
+func (c *Client) SetOption(ctx context.Context, name, value string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.send(setOptionCommand(name, value)); err != nil {
+		return err
+	}
+
+	c.cfg.Options[name] = value
+
+	return c.isReady(ctx)
+}
+
+func setOptionCommand(name, value string) string {
+	if value == "" {
+		return "setoption name " + name
+	}
+
+	return "setoption name " + name + " value " + value
+}
+
+// NewGame tells the engine that the next position is from the different game
+func (c *Client) NewGame(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.send("ucinewgame"); err != nil {
+		return err
+	}
+
+	return c.isReady(ctx)
+}
+
+// SetPosition sets the position from the fen (or the starting position for the empty fen) and the uci moves
+func (c *Client) SetPosition(fen string, moves ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var sb strings.Builder
+
+	sb.WriteString("position ")
+
+	if fen == "" {
+		sb.WriteString("startpos")
+	} else {
+		sb.WriteString("fen " + fen)
+	}
+
+	if len(moves) > 0 {
+		sb.WriteString(" moves " + strings.Join(moves, " "))
+	}
+
+	return c.send(sb.String())
+}
+
+// PerftResult is the node count for each root move from the go perft command (not part of the uci, but widely supported)
+type PerftResult struct {
+	Moves map[string]int64
+	Nodes int64
+}
+
+// Perft runs go perft for the position
+func (c *Client) Perft(ctx context.Context, fen string, depth int) (PerftResult, error) {
+	if err := c.SetPosition(fen); err != nil {
+		return PerftResult{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.send("go perft " + strconv.Itoa(depth)); err != nil {
+		return PerftResult{}, err
+	}
+
+	res := PerftResult{Moves: make(map[string]int64)}
+
+	err := c.readUntil(ctx, 0, func(line string) (bool, error) {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok || strings.HasPrefix(line, "info") {
+			return false, nil
+		}
+
+		nodes, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return false, nil
+		}
+
+		if name == "Nodes searched" {
+			res.Nodes = nodes
+			return true, nil
+		}
+
+		res.Moves[name] = nodes
+
+		return false, nil
+	})
+	if err != nil {
+		return PerftResult{}, fmt.Errorf("failed perft: %w", err)
+	}
+
+	return res, nil
+}
+
+func (c *Client) send(command string) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+
+	if c.closed {
+		return ErrClosed
+	}
+
+	if _, err := io.WriteString(c.stdin, command+"\n"); err != nil {
+		return fmt.Errorf("failed to send %q: %w", command, err)
+	}
+
+	return nil
+}
+
+// readUntil passes the engine lines to fn until it returns true, the timeout 0 means only the context can end it
+// the engine is killed on the timeout or the context cancellation and has to be restarted
+func (c *Client) readUntil(ctx context.Context, timeout time.Duration, fn func(line string) (bool, error)) error {
+	var deadline <-chan time.Time
+
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+
+		deadline = timer.C
+	}
+
+	for {
+		select {
+		case line, ok := <-c.lines:
+			if !ok {
+				c.kill()
+				return ErrClosed
+			}
+
+			found, err := fn(line)
+			if err != nil || found {
+				return err
+			}
+		case <-deadline:
+			c.kill()
+			return ErrTimeout
+		case <-ctx.Done():
+			// the engine is left in the unknown state in the middle of the command
+			c.kill()
+			return ctx.Err()
+		}
+	}
+}
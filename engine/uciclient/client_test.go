@@ -0,0 +1,242 @@
+package uciclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var fakeEnginePath string
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "fakeengine")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create temp dir: %v\n", err)
+		os.Exit(1)
+	}
+
+	fakeEnginePath = filepath.Join(dir, "fakeengine")
+
+	if out, err := exec.Command("go", "build", "-o", fakeEnginePath, "./testdata/fakeengine").CombinedOutput(); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to build fake engine: %v\n%s", err, out)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+
+	_ = os.RemoveAll(dir)
+
+	os.Exit(code)
+}
+
+func newTestClient(t *testing.T, mode string) *Client {
+	t.Helper()
+
+	c, err := New(context.Background(), Config{Path: fakeEnginePath, Args: []string{"-mode", mode}, Timeout: time.Second})
+	if err != nil {
+		t.Fatalf("failed to start engine: %v", err)
+	}
+
+	t.Cleanup(func() { _ = c.Close() })
+
+	return c
+}
+
+func TestHandshake(t *testing.T) {
+	c := newTestClient(t, "normal")
+
+	if c.Name() != "fakeengine" || c.Author() != "juicer" {
+		t.Fatalf("invalid id: want %q by %q, got %q by %q", "fakeengine", "juicer", c.Name(), c.Author())
+	}
+
+	opts := c.Options()
+	if len(opts) != 4 {
+		t.Fatalf("invalid number of options: want %d, got %d", 4, len(opts))
+	}
+
+	style := opts[2]
+	if style.Name != "Play Style" || style.Type != "combo" || style.Default != "Normal" || len(style.Vars) != 3 {
+		t.Fatalf("invalid combo option: got %+v", style)
+	}
+
+	if hash := opts[0]; hash.Name != "Hash" || hash.Min != "1" || hash.Max != "1024" {
+		t.Fatalf("invalid spin option: got %+v", hash)
+	}
+}
+
+func TestStartFailure(t *testing.T) {
+	if _, err := New(context.Background(), Config{Path: filepath.Join(t.TempDir(), "missing")}); err == nil {
+		t.Fatalf("invalid start of missing binary: want error, got nil")
+	}
+}
+
+func TestGo(t *testing.T) {
+	c := newTestClient(t, "normal")
+
+	if err := c.SetOption(context.Background(), "MultiPV", "2"); err != nil {
+		t.Fatalf("failed to set option: %v", err)
+	}
+
+	if err := c.NewGame(context.Background()); err != nil {
+		t.Fatalf("failed new game: %v", err)
+	}
+
+	if err := c.SetPosition("", "e2e4", "e7e5"); err != nil {
+		t.Fatalf("failed to set position: %v", err)
+	}
+
+	s, err := c.Go(context.Background(), Limits{Depth: 2})
+	if err != nil {
+		t.Fatalf("failed to start search: %v", err)
+	}
+
+	var infos []Info
+	for info := range s.Info() {
+		infos = append(infos, info)
+	}
+
+	best, err := s.Wait()
+	if err != nil {
+		t.Fatalf("failed search: %v", err)
+	}
+
+	if best != (BestMove{Move: "e2e4", Ponder: "e7e5"}) {
+		t.Fatalf("invalid best move: got %+v", best)
+	}
+
+	if infos[0].String != "position startpos moves e2e4 e7e5" {
+		t.Fatalf("invalid position: got %q", infos[0].String)
+	}
+
+	// info string and 2 depths with 2 lines each
+	if len(infos) != 5 {
+		t.Fatalf("invalid number of infos: want %d, got %d", 5, len(infos))
+	}
+
+	last := infos[4]
+	if last.Depth != 2 || last.MultiPV != 2 || last.Score == nil || last.Score.Value != 30 || len(last.PV) != 2 {
+		t.Fatalf("invalid last info: got %+v", last)
+	}
+}
+
+func TestGoInfiniteStop(t *testing.T) {
+	c := newTestClient(t, "normal")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	s, err := c.Go(ctx, Limits{Infinite: true})
+	if err != nil {
+		t.Fatalf("failed to start search: %v", err)
+	}
+
+	best, err := s.Wait()
+	if err != nil || best.Move != "e2e4" {
+		t.Fatalf("invalid stopped search: want e2e4, got %+v (%v)", best, err)
+	}
+
+	s, err = c.Go(context.Background(), Limits{Infinite: true})
+	if err != nil {
+		t.Fatalf("failed to start search: %v", err)
+	}
+
+	s.Stop()
+
+	if best, err := s.Wait(); err != nil || best.Move != "e2e4" {
+		t.Fatalf("invalid stopped search: want e2e4, got %+v (%v)", best, err)
+	}
+}
+
+func TestTimeoutAndRestart(t *testing.T) {
+	c := newTestClient(t, "hang")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	s, err := c.Go(ctx, Limits{Infinite: true})
+	if err != nil {
+		t.Fatalf("failed to start search: %v", err)
+	}
+
+	if _, err := s.Wait(); !errors.Is(err, ErrTimeout) {
+		t.Fatalf("invalid error of hanging engine: want %v, got %v", ErrTimeout, err)
+	}
+
+	if !c.Broken() {
+		t.Fatalf("invalid state of killed engine: want broken")
+	}
+
+	if err := c.IsReady(context.Background()); !errors.Is(err, ErrClosed) {
+		t.Fatalf("invalid error of killed engine: want %v, got %v", ErrClosed, err)
+	}
+
+	if err := c.Restart(context.Background()); err != nil {
+		t.Fatalf("failed to restart: %v", err)
+	}
+
+	if err := c.IsReady(context.Background()); err != nil {
+		t.Fatalf("failed isready after restart: %v", err)
+	}
+}
+
+func TestCrash(t *testing.T) {
+	c := newTestClient(t, "crash")
+
+	s, err := c.Go(context.Background(), Limits{Depth: 1})
+	if err != nil {
+		t.Fatalf("failed to start search: %v", err)
+	}
+
+	if _, err := s.Wait(); !errors.Is(err, ErrClosed) {
+		t.Fatalf("invalid error of crashed engine: want %v, got %v", ErrClosed, err)
+	}
+}
+
+func TestPerft(t *testing.T) {
+	c := newTestClient(t, "normal")
+
+	res, err := c.Perft(context.Background(), "", 3)
+	if err != nil {
+		t.Fatalf("failed perft: %v", err)
+	}
+
+	if res.Nodes != 800 || len(res.Moves) != 2 || res.Moves["a2a3"] != 380 {
+		t.Fatalf("invalid perft result: got %+v", res)
+	}
+}
+
+func TestPool(t *testing.T) {
+	p := NewPool(Config{Path: fakeEnginePath, Timeout: time.Second}, 1)
+	defer p.Close()
+
+	c, err := p.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("failed to acquire: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := p.Acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("invalid acquire from exhausted pool: want %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	c.kill()
+	p.Release(c)
+
+	again, err := p.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("failed to acquire: %v", err)
+	}
+
+	if again != c || again.Broken() {
+		t.Fatalf("invalid reused engine: want the restarted engine")
+	}
+
+	p.Release(again)
+}
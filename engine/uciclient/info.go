@@ -0,0 +1,235 @@
+package uciclient
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Score is the engine score from the side to move point of view
+type Score struct {
+	// Mate is true when the Value is the number of moves to mate instead of centipawns
+	Mate  bool
+	Value int
+	// LowerBound and UpperBound are set when the score is only a bound (fail high or fail low)
+	LowerBound bool
+	UpperBound bool
+}
+
+func (s Score) String() string {
+	if s.Mate {
+		return "mate " + strconv.Itoa(s.Value)
+	}
+
+	return "cp " + strconv.Itoa(s.Value)
+}
+
+// Info is the parsed engine info line, the fields that were not sent are left empty
+type Info struct {
+	Depth    int
+	SelDepth int
+	MultiPV  int
+	Score    *Score
+	Nodes    int64
+	NPS      int64
+	Time     time.Duration
+	HashFull int
+	TBHits   int64
+	PV       []string
+	// String is the free text sent with info string
+	String string
+}
+
+// ParseInfo parses the info line, unknown tokens are skipped
+func ParseInfo(line string) (Info, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 || fields[0] != "info" {
+		return Info{}, fmt.Errorf("%w: not an info line: %q", ErrInvalidResponse, line)
+	}
+
+	var info Info
+
+	for i := 1; i < len(fields); i++ {
+		name := fields[i]
+
+		switch name {
+		case "string":
+			info.String = strings.Join(fields[i+1:], " ")
+			return info, nil
+		case "pv":
+			info.PV = fields[i+1:]
+			return info, nil
+		case "score":
+			score, n, err := parseScore(fields[i+1:])
+			if err != nil {
+				return Info{}, fmt.Errorf("%w: %q: %w", ErrInvalidResponse, line, err)
+			}
+
+			info.Score = &score
+			i += n
+
+			continue
+		case "depth", "seldepth", "multipv", "nodes", "nps", "time", "hashfull", "tbhits":
+		default:
+			continue
+		}
+
+		if i+1 >= len(fields) {
+			return Info{}, fmt.Errorf("%w: missing value for %s: %q", ErrInvalidResponse, name, line)
+		}
+
+		i++
+
+		value, err := strconv.ParseInt(fields[i], 10, 64)
+		if err != nil {
+			return Info{}, fmt.Errorf("%w: invalid value for %s: %q", ErrInvalidResponse, name, line)
+		}
+
+		switch name {
+		case "depth":
+			info.Depth = int(value)
+		case "seldepth":
+			info.SelDepth = int(value)
+		case "multipv":
+			info.MultiPV = int(value)
+		case "nodes":
+			info.Nodes = value
+		case "nps":
+			info.NPS = value
+		case "time":
+			info.Time = time.Duration(value) * time.Millisecond
+		case "hashfull":
+			info.HashFull = int(value)
+		case "tbhits":
+			info.TBHits = value
+		}
+	}
+
+	return info, nil
+}
+
+// parseScore parses the tokens after the score keyword and returns the number of consumed tokens
+func parseScore(fields []string) (Score, int, error) {
+	if len(fields) < 2 {
+		return Score{}, 0, fmt.Errorf("missing score value")
+	}
+
+	var score Score
+
+	switch fields[0] {
+	case "cp":
+	case "mate":
+		score.Mate = true
+	default:
+		return Score{}, 0, fmt.Errorf("unknown score kind %s", fields[0])
+	}
+
+	value, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return Score{}, 0, fmt.Errorf("invalid score value %s", fields[1])
+	}
+
+	score.Value = value
+	n := 2
+
+	if len(fields) > 2 {
+		switch fields[2] {
+		case "lowerbound":
+			score.LowerBound = true
+			n++
+		case "upperbound":
+			score.UpperBound = true
+			n++
+		}
+	}
+
+	return score, n, nil
+}
+
+// BestMove is the final engine answer, the Move is "0000" or empty when there are no legal moves
+type BestMove struct {
+	Move   string
+	Ponder string
+}
+
+func parseBestMove(line string) (BestMove, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 2 || fields[0] != "bestmove" {
+		return BestMove{}, fmt.Errorf("%w: not a bestmove line: %q", ErrInvalidResponse, line)
+	}
+
+	bm := BestMove{Move: fields[1]}
+
+	if len(fields) >= 4 && fields[2] == "ponder" {
+		bm.Ponder = fields[3]
+	}
+
+	return bm, nil
+}
+
+// Option is the option declared by the engine in the handshake
+type Option struct {
+	Name    string
+	Type    string
+	Default string
+	Min     string
+	Max     string
+	Vars    []string
+}
+
+func parseOption(line string) (Option, error) {
+	// option name <id> type <t> [default <x>] [min <x>] [max <x>] [var <x>]*
+	fields := strings.Fields(line)
+	if len(fields) == 0 || fields[0] != "option" {
+		return Option{}, fmt.Errorf("%w: not an option line: %q", ErrInvalidResponse, line)
+	}
+
+	var (
+		opt     Option
+		keyword string
+		values  []string
+	)
+
+	flush := func() {
+		value := strings.Join(values, " ")
+
+		switch keyword {
+		case "name":
+			opt.Name = value
+		case "type":
+			opt.Type = value
+		case "default":
+			opt.Default = value
+		case "min":
+			opt.Min = value
+		case "max":
+			opt.Max = value
+		case "var":
+			opt.Vars = append(opt.Vars, value)
+		}
+
+		values = values[:0]
+	}
+
+	for _, f := range fields[1:] {
+		switch f {
+		// the option name and the values can contain spaces but not the keywords
+		case "name", "type", "default", "min", "max", "var":
+			flush()
+			keyword = f
+
+			continue
+		}
+
+		values = append(values, f)
+	}
+
+	flush()
+
+	if opt.Name == "" {
+		return Option{}, fmt.Errorf("%w: option without name: %q", ErrInvalidResponse, line)
+	}
+
+	return opt, nil
+}
@@ -0,0 +1,99 @@
+package uciclient
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseInfo(t *testing.T) {
+	testCases := map[string]struct {
+		line    string
+		want    Info
+		wantErr bool
+	}{
+		"full": {
+			line: "info depth 20 seldepth 28 multipv 1 score cp 31 nodes 1234567 nps 2000000 hashfull 120 tbhits 0 time 617 pv e2e4 e7e5 g1f3",
+			want: Info{Depth: 20, SelDepth: 28, MultiPV: 1, Score: &Score{Value: 31}, Nodes: 1234567, NPS: 2000000, HashFull: 120, Time: 617 * time.Millisecond, PV: []string{"e2e4", "e7e5", "g1f3"}},
+		},
+		"mate": {
+			line: "info depth 5 score mate -3 pv f7f6",
+			want: Info{Depth: 5, Score: &Score{Mate: true, Value: -3}, PV: []string{"f7f6"}},
+		},
+		"bound": {
+			line: "info depth 12 score cp -15 upperbound nodes 10",
+			want: Info{Depth: 12, Score: &Score{Value: -15, UpperBound: true}, Nodes: 10},
+		},
+		"string": {
+			line: "info string NNUE evaluation enabled",
+			want: Info{String: "NNUE evaluation enabled"},
+		},
+		"unknown tokens": {
+			line: "info depth 3 currmove e2e4 currmovenumber 1",
+			want: Info{Depth: 3},
+		},
+		"not info":      {line: "bestmove e2e4", wantErr: true},
+		"invalid value": {line: "info depth x", wantErr: true},
+		"invalid score": {line: "info score foo 1", wantErr: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseInfo(tc.line)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("invalid error: want error %v, got %v", tc.wantErr, err)
+			}
+
+			if !tc.wantErr && !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("invalid info: want %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParseBestMove(t *testing.T) {
+	testCases := map[string]struct {
+		line    string
+		want    BestMove
+		wantErr bool
+	}{
+		"with ponder":    {line: "bestmove e2e4 ponder e7e5", want: BestMove{Move: "e2e4", Ponder: "e7e5"}},
+		"without ponder": {line: "bestmove e7e8q", want: BestMove{Move: "e7e8q"}},
+		"no moves":       {line: "bestmove (none)", want: BestMove{Move: "(none)"}},
+		"invalid":        {line: "bestmove", wantErr: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseBestMove(tc.line)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("invalid error: want error %v, got %v", tc.wantErr, err)
+			}
+
+			if got != tc.want {
+				t.Fatalf("invalid best move: want %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLimitsCommand(t *testing.T) {
+	testCases := map[string]struct {
+		limits Limits
+		want   string
+	}{
+		"empty":    {limits: Limits{}, want: "go"},
+		"depth":    {limits: Limits{Depth: 10}, want: "go depth 10"},
+		"clock":    {limits: Limits{WTime: time.Minute, BTime: time.Minute, WInc: time.Second, BInc: time.Second}, want: "go wtime 60000 btime 60000 winc 1000 binc 1000"},
+		"infinite": {limits: Limits{Infinite: true, SearchMoves: []string{"e2e4", "d2d4"}}, want: "go searchmoves e2e4 d2d4 infinite"},
+		"mate":     {limits: Limits{Mate: 3, MoveTime: 500 * time.Millisecond}, want: "go mate 3 movetime 500"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.limits.command(); got != tc.want {
+				t.Fatalf("invalid command: want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
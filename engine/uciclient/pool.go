@@ -0,0 +1,109 @@
+package uciclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var ErrPoolClosed = errors.New("engine pool is closed")
+
+// Pool keeps up to size started engines of the same config for reuse, the broken engines are restarted on acquire
+type Pool struct {
+	cfg Config
+
+	mu     sync.Mutex
+	idle   []*Client
+	tokens chan struct{}
+	closed bool
+}
+
+func NewPool(cfg Config, size int) *Pool {
+	size = max(size, 1)
+
+	tokens := make(chan struct{}, size)
+	for range size {
+		tokens <- struct{}{}
+	}
+
+	return &Pool{cfg: cfg, tokens: tokens}
+}
+
+// Acquire returns the idle engine or starts the new one, it waits while all the engines are in use
+func (p *Pool) Acquire(ctx context.Context) (*Client, error) {
+	select {
+	case <-p.tokens:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	p.mu.Lock()
+
+	if p.closed {
+		p.mu.Unlock()
+		p.tokens <- struct{}{}
+
+		return nil, ErrPoolClosed
+	}
+
+	var c *Client
+
+	if n := len(p.idle); n > 0 {
+		c = p.idle[n-1]
+		p.idle = p.idle[:n-1]
+	}
+
+	p.mu.Unlock()
+
+	if c == nil {
+		client, err := New(ctx, p.cfg)
+		if err != nil {
+			p.tokens <- struct{}{}
+			return nil, err
+		}
+
+		return client, nil
+	}
+
+	if c.Broken() {
+		if err := c.Restart(ctx); err != nil {
+			p.tokens <- struct{}{}
+			return nil, fmt.Errorf("failed to restart engine: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+// Release returns the engine to the pool, it must not be used after that
+func (p *Pool) Release(c *Client) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		_ = c.Close()
+	} else {
+		p.idle = append(p.idle, c)
+	}
+
+	p.tokens <- struct{}{}
+}
+
+// Close closes the idle engines, the acquired engines are closed when they are released
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+
+	var errs []error
+
+	for _, c := range p.idle {
+		errs = append(errs, c.Close())
+	}
+
+	p.idle = nil
+
+	return errors.Join(errs...)
+}
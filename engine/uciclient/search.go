@@ -0,0 +1,178 @@
+package uciclient
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limits are sent with the go command, zero values are not sent
+type Limits struct {
+	Depth     int
+	Nodes     int64
+	Mate      int
+	MoveTime  time.Duration
+	WTime     time.Duration
+	BTime     time.Duration
+	WInc      time.Duration
+	BInc      time.Duration
+	MovesToGo int
+	Infinite  bool
+	// SearchMoves restricts the search to these uci moves
+	SearchMoves []string
+}
+
+func (l Limits) command() string {
+	var sb strings.Builder
+
+	sb.WriteString("go")
+
+	if len(l.SearchMoves) > 0 {
+		sb.WriteString(" searchmoves " + strings.Join(l.SearchMoves, " "))
+	}
+
+	for _, v := range []struct {
+		name  string
+		value int64
+	}{
+		{"depth", int64(l.Depth)},
+		{"nodes", l.Nodes},
+		{"mate", int64(l.Mate)},
+		{"movetime", l.MoveTime.Milliseconds()},
+		{"wtime", l.WTime.Milliseconds()},
+		{"btime", l.BTime.Milliseconds()},
+		{"winc", l.WInc.Milliseconds()},
+		{"binc", l.BInc.Milliseconds()},
+		{"movestogo", int64(l.MovesToGo)},
+	} {
+		if v.value > 0 {
+			sb.WriteString(" " + v.name + " " + strconv.FormatInt(v.value, 10))
+		}
+	}
+
+	if l.Infinite {
+		sb.WriteString(" infinite")
+	}
+
+	return sb.String()
+}
+
+// Search is the running go command
+type Search struct {
+	c    *Client
+	info chan Info
+	done chan struct{}
+	stop chan struct{}
+	best BestMove
+	err  error
+}
+
+// Go starts the search of the current position, the search is stopped when the context is done
+// the client is busy (the other commands wait) until the best move arrives
+func (c *Client) Go(ctx context.Context, limits Limits) (*Search, error) {
+	c.mu.Lock()
+
+	if err := c.send(limits.command()); err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	s := &Search{
+		c:    c,
+		info: make(chan Info, infoBufferSize),
+		done: make(chan struct{}),
+		stop: make(chan struct{}, 1),
+	}
+
+	go func() {
+		defer c.mu.Unlock()
+		defer close(s.done)
+		defer close(s.info)
+
+		s.best, s.err = s.run(ctx)
+	}()
+
+	return s, nil
+}
+
+func (s *Search) run(ctx context.Context) (BestMove, error) {
+	var (
+		stopSent bool
+		// deadline is how long to wait for the best move after the stop
+		deadline <-chan time.Time
+		ctxDone  = ctx.Done()
+	)
+
+	stop := func() error {
+		if stopSent {
+			return nil
+		}
+
+		stopSent = true
+		deadline = time.After(s.c.cfg.Timeout)
+
+		return s.c.send("stop")
+	}
+
+	for {
+		select {
+		case line, ok := <-s.c.lines:
+			if !ok {
+				s.c.kill()
+				return BestMove{}, ErrClosed
+			}
+
+			switch {
+			case strings.HasPrefix(line, "bestmove"):
+				return parseBestMove(line)
+			case strings.HasPrefix(line, "info"):
+				info, err := ParseInfo(line)
+				if err != nil {
+					continue
+				}
+
+				// the slow consumer only misses the intermediate infos
+				select {
+				case s.info <- info:
+				default:
+				}
+			}
+		case <-s.stop:
+			if err := stop(); err != nil {
+				return BestMove{}, err
+			}
+		case <-ctxDone:
+			if err := stop(); err != nil {
+				return BestMove{}, err
+			}
+
+			ctxDone = nil
+		case <-deadline:
+			s.c.kill()
+			return BestMove{}, fmt.Errorf("%w: no best move after stop", ErrTimeout)
+		}
+	}
+}
+
+// Info streams the parsed info lines, the channel is closed when the search is done
+// the infos are dropped when the channel buffer is full
+func (s *Search) Info() <-chan Info {
+	return s.info
+}
+
+// Stop sends the stop command, the best move is still returned from Wait
+func (s *Search) Stop() {
+	select {
+	case s.stop <- struct{}{}:
+	default:
+	}
+}
+
+// Wait waits for the best move
+func (s *Search) Wait() (BestMove, error) {
+	<-s.done
+
+	return s.best, s.err
+}
@@ -0,0 +1,100 @@
+// fakeengine is the minimal scripted uci engine for testing the client
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	mode := flag.String("mode", "normal", "normal, hang (never answers to stop) or crash (exits on go)")
+	flag.Parse()
+
+	var (
+		position string
+		multiPV  = 1
+	)
+
+	lines := make(chan string)
+
+	go func() {
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+
+	for line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "uci":
+			fmt.Println("id name fakeengine")
+			fmt.Println("id author juicer")
+			fmt.Println("option name Hash type spin default 16 min 1 max 1024")
+			fmt.Println("option name MultiPV type spin default 1 min 1 max 10")
+			fmt.Println("option name Play Style type combo default Normal var Solid var Normal var Risky")
+			fmt.Println("option name Clear Hash type button")
+			fmt.Println("uciok")
+		case "isready":
+			fmt.Println("readyok")
+		case "setoption":
+			if len(fields) == 5 && fields[2] == "MultiPV" {
+				multiPV, _ = strconv.Atoi(fields[4])
+			}
+		case "position":
+			position = strings.Join(fields[1:], " ")
+		case "go":
+			fmt.Println("info string position " + position)
+
+			if *mode == "crash" {
+				os.Exit(1)
+			}
+
+			if len(fields) == 3 && fields[1] == "perft" {
+				fmt.Println("a2a3: 380")
+				fmt.Println("b2b3: 420")
+				fmt.Println("")
+				fmt.Println("Nodes searched: 800")
+
+				continue
+			}
+
+			depth := 3
+			if len(fields) == 3 && fields[1] == "depth" {
+				depth, _ = strconv.Atoi(fields[2])
+			}
+
+			for d := 1; d <= depth; d++ {
+				for pv := 1; pv <= multiPV; pv++ {
+					fmt.Printf("info depth %d seldepth %d multipv %d score cp %d nodes %d nps 1000 time %d pv e2e4 e7e5\n", d, d+2, pv, 50-pv*10, d*100, d)
+				}
+			}
+
+			if *mode == "hang" {
+				continue
+			}
+
+			if len(fields) == 2 && fields[1] == "infinite" {
+				// the best move is sent only after the stop
+				for l := range lines {
+					if l == "stop" {
+						break
+					}
+				}
+			}
+
+			fmt.Println("bestmove e2e4 ponder e7e5")
+		case "quit":
+			return
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package engine
+
+import "fmt"
+
+// Variant is the set of rules the position is played by, chess960 is not a variant on its own because it only changes the start position and castling
+type Variant uint8
+
+const (
+	VariantStandard Variant = iota
+	VariantCrazyhouse
+	VariantAtomic
+	VariantKingOfTheHill
+	VariantThreeCheck
+	VariantHorde
+	VariantRacingKings
+)
+
+func (v Variant) String() string {
+	switch v {
+	case VariantStandard:
+		return "standard"
+	case VariantCrazyhouse:
+		return "crazyhouse"
+	case VariantAtomic:
+		return "atomic"
+	case VariantKingOfTheHill:
+		return "king-of-the-hill"
+	case VariantThreeCheck:
+		return "three-check"
+	case VariantHorde:
+		return "horde"
+	case VariantRacingKings:
+		return "racing-kings"
+	}
+
+	return ""
+}
+
+// StartingFEN returns the start position of the variant, the variants without their own start position use the standard one
+func (v Variant) StartingFEN() string {
+	switch v {
+	case VariantHorde:
+		return FENStartingPositionHorde
+	case VariantRacingKings:
+		return FENStartingPositionRacingKings
+	}
+
+	return FENStartingPosition
+}
+
+// NewChessVariant starts the game of the variant, the fen is read by the variant rules (e.g. the missing crazyhouse pockets are empty or the horde without the king)
+func NewChessVariant(fen string, variant Variant) (*Chess, error) {
+	p := &Position{}
+
+	if err := p.LoadFromFENVariant(fen, variant); err != nil {
+		return nil, fmt.Errorf("failed to start new game: %w", err)
+	}
+
+	return newChessFromPosition(p), nil
+}
+
+// isVariantEnd checks if the game is won by the variant rule (e.g. the exploded king or the king on the hill), there are no more moves then
+func (p *Position) isVariantEnd() bool {
+	for _, color := range colors {
+		if p.isKingExploded(color) || p.isKingOnTheHill(color) || p.isThreeCheck(color) || p.isHordeCaptured(color) {
+			return true
+		}
+	}
+
+	return p.isRaceFinished()
+}
+
+// VariantOutcome returns the winner of the game ended by the variant rule, the race is drawn when both kings reached the 8th rank
+func (p *Position) VariantOutcome() Outcome {
+	if !p.isVariantEnd() {
+		return OutcomeUnknown
+	}
+
+	if p.Variant == VariantRacingKings {
+		white, black := p.racingKingsOnGoal()
+
+		switch {
+		case white && black:
+			return OutcomeDraw
+		case white:
+			return OutcomeWhiteWon
+		default:
+			return OutcomeBlackWon
+		}
+	}
+
+	if p.Turn.IsWhite() {
+		return OutcomeBlackWon
+	}
+
+	return OutcomeWhiteWon
+}
+
+// SetVariant switches the position to the variant rules, the check and the hash are recalculated
+func (p *Position) SetVariant(variant Variant) {
+	p.Variant = variant
+	p.Check = p.isInCheck(p.Turn)
+	p.InitHash()
+}
@@ -1,15 +1,11 @@
 package engine
 
-import (
-	"math/rand/v2"
-	"sync"
-)
+import "math/rand/v2"
 
-var (
-	onceZobrist        sync.Once
-	zobristInitialized bool
-	defaultZobrist     zobrist
-)
+// zobristSeed seeds the zobrist keys so the hashes are the same in every run
+const zobristSeed = 0x6a75696365720001
+
+var defaultZobrist zobrist
 
 type zobrist struct {
 	seed            uint64
@@ -17,35 +13,55 @@ type zobrist struct {
 	castleKeys      map[CastleRights]uint64
 	turnKey         uint64
 	enpKeys         [64]uint64
+	// pocketKeys are hashed by the number of the crazyhouse pocket pieces, the empty pocket has no key
+	pocketKeys [2][6][maxPocketCount + 1]uint64
+	// checkKeys are hashed by the number of the checks given in three-check, no checks have no key
+	checkKeys [2][threeCheckLimit + 1]uint64
 }
 
 func initZobrist() {
-	if !zobristInitialized {
-		onceZobrist.Do(func() {
-			defaultZobrist = zobrist{
-				seed: rand.Uint64(),
+	rnd := rand.New(rand.NewPCG(zobristSeed, zobristSeed))
+
+	defaultZobrist = zobrist{
+		seed: zobristSeed,
+	}
+
+	for sq := A1; sq <= H8; sq++ {
+		for _, color := range colors {
+			for _, pk := range pieceKinds {
+				defaultZobrist.occupanciesKeys[color][pk][sq] = rnd.Uint64()
 			}
+		}
 
-			for sq := A1; sq <= H8; sq++ {
-				for _, color := range colors {
-					for _, pk := range pieceKinds {
-						defaultZobrist.occupanciesKeys[color][pk][sq] = rand.Uint64()
-					}
-				}
+		defaultZobrist.enpKeys[sq] = rnd.Uint64()
+	}
 
-				defaultZobrist.enpKeys[sq] = rand.Uint64()
+	for _, color := range colors {
+		for _, pk := range pieceKinds {
+			for n := 1; n <= maxPocketCount; n++ {
+				defaultZobrist.pocketKeys[color][pk][n] = rnd.Uint64()
 			}
+		}
 
-			defaultZobrist.castleKeys = make(map[CastleRights]uint64)
+		for n := 1; n <= threeCheckLimit; n++ {
+			defaultZobrist.checkKeys[color][n] = rnd.Uint64()
+		}
+	}
 
-			defaultZobrist.castleKeys[WhiteKingSideCastle] = rand.Uint64()
-			defaultZobrist.castleKeys[WhiteQueenSideCastle] = rand.Uint64()
-			defaultZobrist.castleKeys[BlackKingSideCastle] = rand.Uint64()
-			defaultZobrist.castleKeys[BlackQueenSideCastle] = rand.Uint64()
+	defaultZobrist.castleKeys = make(map[CastleRights]uint64)
 
-			defaultZobrist.turnKey = rand.Uint64()
+	defaultZobrist.castleKeys[WhiteKingSideCastle] = rnd.Uint64()
+	defaultZobrist.castleKeys[WhiteQueenSideCastle] = rnd.Uint64()
+	defaultZobrist.castleKeys[BlackKingSideCastle] = rnd.Uint64()
+	defaultZobrist.castleKeys[BlackQueenSideCastle] = rnd.Uint64()
 
-			zobristInitialized = true
-		})
-	}
+	defaultZobrist.turnKey = rnd.Uint64()
+}
+
+func zobristPocketKey(c Color, pk PieceKind, count uint8) uint64 {
+	return defaultZobrist.pocketKeys[c][pk][count]
+}
+
+func zobristCheckKey(c Color, count uint8) uint64 {
+	return defaultZobrist.checkKeys[c][count]
 }
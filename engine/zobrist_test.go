@@ -5,8 +5,6 @@ import (
 )
 
 func TestZobristSeed(t *testing.T) {
-	InitPrecalculatedTables()
-
 	p1, p2 := &Position{}, &Position{}
 	if err := p1.LoadFromFEN(FENStartingPosition); err != nil {
 		t.Fatalf("failed to load pos1: %v", err)
@@ -22,8 +20,6 @@ func TestZobristSeed(t *testing.T) {
 }
 
 func TestZobristTransposition(t *testing.T) {
-	InitPrecalculatedTables()
-
 	p1, p2 := &Position{}, &Position{}
 	if err := p1.LoadFromFEN(FENStartingPosition); err != nil {
 		t.Fatalf("failed to load pos1: %v", err)
@@ -48,8 +44,6 @@ func TestZobristTransposition(t *testing.T) {
 }
 
 func TestZobristEnpTranspositionDiff(t *testing.T) {
-	InitPrecalculatedTables()
-
 	p1, p2 := &Position{}, &Position{}
 	if err := p1.LoadFromFEN(FENStartingPosition); err != nil {
 		t.Fatalf("failed to load pos1: %v", err)
@@ -74,8 +68,6 @@ func TestZobristEnpTranspositionDiff(t *testing.T) {
 }
 
 func TestZobristTurnDiff(t *testing.T) {
-	InitPrecalculatedTables()
-
 	p1, p2 := &Position{}, &Position{}
 	if err := p1.LoadFromFEN("rnbqkbnr/pppp1ppp/4p3/8/8/4PP2/PPPP2PP/RNBQKBNR b - - 0 2"); err != nil {
 		t.Fatalf("failed to load pos1: %v", err)
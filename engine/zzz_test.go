@@ -6,8 +6,6 @@ import (
 )
 
 func TestJuicer(t *testing.T) {
-	InitPrecalculatedTables()
-
 	c, _ := NewChess(FENStartingPosition)
 
 	c.MakeMoveUCI("e2e4")
@@ -36,6 +36,11 @@ func GameToResponse(g models.Game) api.Game {
 		ReconnectTimeoutMs:     int64(g.ReconnectTimeoutMS),
 		Fen:                    g.Fen,
 		Pgn:                    g.PGN.Ptr(),
+		WhiteBotLevel:          g.WhiteBotLevel.Ptr(),
+		BlackBotLevel:          g.BlackBotLevel.Ptr(),
+		Eco:                    g.Eco.Ptr(),
+		OpeningName:            g.OpeningName.Ptr(),
+		OpeningVariation:       g.OpeningVariation.Ptr(),
 		StartTime:              g.StartTime.Ptr(),
 		EndTime:                g.EndTime.Ptr(),
 		LastMove:               g.LastMove.Ptr(),
@@ -77,6 +82,11 @@ func GameDetailsToResponse(g GameDetails) api.Game {
 		ReconnectTimeoutMs:     int64(g.ReconnectTimeoutMS),
 		Fen:                    g.Fen,
 		Pgn:                    g.PGN.Ptr(),
+		WhiteBotLevel:          g.WhiteBotLevel.Ptr(),
+		BlackBotLevel:          g.BlackBotLevel.Ptr(),
+		Eco:                    g.Eco.Ptr(),
+		OpeningName:            g.OpeningName.Ptr(),
+		OpeningVariation:       g.OpeningVariation.Ptr(),
 		StartTime:              g.StartTime.Ptr(),
 		EndTime:                g.EndTime.Ptr(),
 		LastMove:               g.LastMove.Ptr(),
@@ -3,6 +3,9 @@ package game
 import "errors"
 
 var (
-	ErrGameNotFound      = errors.New("game not found")
-	ErrGameAlreadyExists = errors.New("game already exists")
+	ErrGameNotFound            = errors.New("game not found")
+	ErrGameAlreadyExists       = errors.New("game already exists")
+	ErrGamePlyNotFound         = errors.New("game ply not found")
+	ErrUserBusy                = errors.New("user is already in the active game or in the pool")
+	ErrTimeControlNotQuickGame = errors.New("time control is not one of the quick games")
 )
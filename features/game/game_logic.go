@@ -15,12 +15,12 @@ import (
 	"github.com/aarondl/opt/omitnull"
 	api "github.com/dankobg/juicer/api/gen"
 	"github.com/dankobg/juicer/db/gen/models"
+	"github.com/dankobg/juicer/engine"
 	"github.com/dankobg/juicer/features/chat"
 	"github.com/dankobg/juicer/gameplay"
 	pb "github.com/dankobg/juicer/pb/proto/juicer"
 	"github.com/dankobg/juicer/ws"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5"
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -119,6 +119,12 @@ func (g *GameService) FetchProtoMappingsCacheLookups(ctx context.Context) error
 		"timed-out":             pb.GameResultStatus_GAME_RESULT_STATUS_TIMED_OUT,
 		"aborted":               pb.GameResultStatus_GAME_RESULT_STATUS_ABORTED,
 		"interrupted":           pb.GameResultStatus_GAME_RESULT_STATUS_INTERRUPTED,
+		"king-exploded":         pb.GameResultStatus_GAME_RESULT_STATUS_KING_EXPLODED,
+		"king-of-the-hill":      pb.GameResultStatus_GAME_RESULT_STATUS_KING_OF_THE_HILL,
+		"three-check":           pb.GameResultStatus_GAME_RESULT_STATUS_THREE_CHECK,
+		"all-pieces-captured":   pb.GameResultStatus_GAME_RESULT_STATUS_ALL_PIECES_CAPTURED,
+		"race-finished":         pb.GameResultStatus_GAME_RESULT_STATUS_RACE_FINISHED,
+		"dead-position":         pb.GameResultStatus_GAME_RESULT_STATUS_DEAD_POSITION,
 	}
 
 	gameStateNameToProto := map[string]pb.GameState{
@@ -555,6 +561,20 @@ func (g *GameService) handleGameFinishedEvent(event gameplay.GameFinishedEvent)
 		GameStateID:        omit.From(g.gameStateProtoToID(event.GameState)),
 	}
 
+	if gs, ok := g.gamestates[event.GameID]; ok {
+		opening, classified := gameOpening(gs)
+		if classified {
+			gameSetter.Eco = omitnull.From(opening.ECO)
+			gameSetter.OpeningName = omitnull.From(opening.Name)
+
+			if opening.Variation != "" {
+				gameSetter.OpeningVariation = omitnull.From(opening.Variation)
+			}
+		}
+
+		gameSetter.PGN = omitnull.From(gameStateToPGN(gs, event, opening))
+	}
+
 	if _, err := g.pst.Game.UpdateGame(context.Background(), event.GameID, gameSetter, nil, nil); err != nil {
 		g.log.Error("UpdateGame", slog.Int64("game_id", event.GameID), slog.Any("error", err))
 	}
@@ -581,6 +601,23 @@ func (g *GameService) handleGameFinishedEvent(event gameplay.GameFinishedEvent)
 	}
 }
 
+// gameOpening returns the opening of the latest named position of all the game moves (the same as the game_move rows)
+// so it doesn't depend on how much of the chess history is kept in memory
+func gameOpening(gs *gameplay.GameState) (engine.Opening, bool) {
+	for _, move := range slices.Backward(gs.GameMoves) {
+		chess, err := gameplay.NewVariantChess(gs.GameVariant, move.GetFen())
+		if err != nil {
+			continue
+		}
+
+		if o, ok := chess.Position.Opening(); ok {
+			return o, true
+		}
+	}
+
+	return engine.Opening{}, false
+}
+
 func (g *GameService) onIPCMsg(m *redis.Message) {
 	msg := &pb.Message{}
 	if err := protojson.Unmarshal([]byte(m.Payload), msg); err != nil {
@@ -741,7 +778,7 @@ func (g *GameService) handleIPCInitializeChannelsMsg(data *pb.InitializeChannels
 		}
 
 		game, err := g.pst.Game.GetGameByID(context.Background(), gameID, GetGameByIDFilters{})
-		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		if err != nil && !errors.Is(err, ErrGameNotFound) {
 			g.log.Error("handleIPCRequestInitialChannelsMsg GetGameByID", slog.String("user_id", userUid.String()), slog.String("conn_id", data.GetConnId()), slog.String("path", data.GetPath()), slog.Any("error", err))
 			return
 		}
@@ -957,6 +994,8 @@ func (g *GameService) onWSCMsg(m *redis.Message) {
 		g.handleWSCSeekGameMsg(clientAuthInfo, msg.GetSeekGame())
 	case *pb.Message_CancelSeekGame:
 		g.handleWSCCancelSeekGameMsg(clientAuthInfo, msg.GetCancelSeekGame())
+	case *pb.Message_SeekBotGame:
+		g.handleWSCSeekBotGameMsg(clientAuthInfo, msg.GetSeekBotGame())
 	case *pb.Message_AbortGame:
 		g.handleWSCAbortGame(clientAuthInfo, msg.GetAbortGame())
 	case *pb.Message_ResignGame:
@@ -1010,6 +1049,17 @@ func (g *GameService) handleWSCCancelSeekGameMsg(authInfo clientAuthInfo, data *
 	}
 }
 
+func (g *GameService) handleWSCSeekBotGameMsg(authInfo clientAuthInfo, data *pb.SeekBotGame) {
+	if data == nil {
+		return
+	}
+
+	if err := g.startBotGame(context.Background(), uuid.MustParse(authInfo.userID), data); err != nil {
+		g.log.Error("SeekBotGame start bot game failed", slog.Int("bot_level", int(data.GetBotLevel())), slog.String("user_id", authInfo.userID), slog.String("auth_state", authInfo.authState.String()), slog.Any("error", err))
+		return
+	}
+}
+
 func (g *GameService) handleWSCAbortGame(authInfo clientAuthInfo, data *pb.AbortGame) {
 	userID := uuid.MustParse(authInfo.userID)
 
@@ -1500,11 +1550,13 @@ func (g *GameService) sendGameInfo(gameID int64, userID, connID string, guest bo
 			whitePlayerInfo.Username = p.Username
 			whitePlayerInfo.Guest = p.Guest
 			whitePlayerInfo.Rating = 1500
+			whitePlayerInfo.BotLevel = int32(p.BotLevel)
 		} else {
 			blackPlayerInfo.UserId = p.ID.String()
 			blackPlayerInfo.Username = p.Username
 			blackPlayerInfo.Guest = p.Guest
 			blackPlayerInfo.Rating = 1500
+			blackPlayerInfo.BotLevel = int32(p.BotLevel)
 		}
 	}
 
@@ -1678,6 +1730,11 @@ func (g *GameService) loadGameState(gameID int64) (*gameplay.GameState, error) {
 	return gs, nil
 }
 
+// sortedGameMoves orders the game_move rows as they were played, the embedded moves are not ordered
+func sortedGameMoves(moves []models.GameMove) []models.GameMove {
+	return slices.SortedFunc(slices.Values(moves), func(a, b models.GameMove) int { return cmp.Compare(a.ID, b.ID) })
+}
+
 func (g *GameService) gameStateFromPersistence(ctx context.Context, game models.Game, moves *[]models.GameMove, hashes *[]models.GameHistoryHash) (*gameplay.GameState, error) {
 	var whiteID, blackID uuid.UUID
 	if game.GuestBlackID.IsValue() && game.GuestWhiteID.IsValue() {
@@ -1705,23 +1762,45 @@ func (g *GameService) gameStateFromPersistence(ctx context.Context, game models.
 		{ID: blackID, Username: blackUsername, Color: pb.Color_COLOR_BLACK, Guest: !game.Rated},
 	}
 
+	if game.WhiteBotLevel.IsValue() {
+		bot, err := gameplay.NewBotPlayer(int(game.WhiteBotLevel.MustGet()), pb.Color_COLOR_WHITE)
+		if err != nil {
+			return nil, err
+		}
+
+		players[0] = bot
+	}
+
+	if game.BlackBotLevel.IsValue() {
+		bot, err := gameplay.NewBotPlayer(int(game.BlackBotLevel.MustGet()), pb.Color_COLOR_BLACK)
+		if err != nil {
+			return nil, err
+		}
+
+		players[1] = bot
+	}
+
 	gtc := &pb.GameTimeControl{ClockMs: game.TimeControlClockMS, IncrementMs: game.TimeControlIncrementMS}
 
-	thresholds := []gameplay.CategoryThreshold{}
-	for _, x := range g.categoryThresholds {
-		thresholds = append(thresholds, gameplay.CategoryThreshold{
-			UpperLimit:   x.upperLimit,
-			TimeCategory: x.timeCategory,
-		})
+	var sortedMoves []models.GameMove
+	if moves != nil {
+		sortedMoves = sortedGameMoves(*moves)
+	}
+
+	// the game is replayed from the start position (the first game move without the uci) so the chess history
+	// is complete for the pgn and the opening, the game without it continues from the current fen
+	startFEN, replay := game.Fen, false
+	if len(sortedMoves) > 0 && sortedMoves[0].Uci == "" {
+		startFEN, replay = sortedMoves[0].Fen, true
 	}
 
 	gs, err := gameplay.NewGameState(
 		game.ID,
 		players,
 		gtc,
-		thresholds,
+		g.gameplayThresholds(),
 		g.gameEvent,
-		gameplay.WithFEN(game.Fen),
+		gameplay.WithFEN(startFEN),
 		gameplay.WithRated(game.Rated),
 		gameplay.WithGameVariant(g.gameVariantIDToProto(game.GameVariantID)),
 		gameplay.WithGameTimeKind(g.gameTimeKindIDToProto(game.GameTimeKindID)),
@@ -1738,21 +1817,29 @@ func (g *GameService) gameStateFromPersistence(ctx context.Context, game models.
 		return nil, err
 	}
 
-	gs.Chess.Repetitions = uint16(game.Repetitions)
+	if replay {
+		for _, m := range sortedMoves[1:] {
+			if _, err := gs.Chess.MakeMoveUCI(m.Uci); err != nil {
+				return nil, fmt.Errorf("failed to replay game move %s: %w", m.Uci, err)
+			}
+		}
+	} else {
+		gs.Chess.Repetitions = uint16(game.Repetitions)
 
-	if hashes != nil && len(*hashes) > 0 {
-		gs.Chess.HistoryHashes = make([]uint64, len(*hashes))
-		for i, hash := range *hashes {
-			gs.Chess.HistoryHashes[i] = uint64(hash.Hash)
+		if hashes != nil && len(*hashes) > 0 {
+			gs.Chess.HistoryHashes = make([]uint64, len(*hashes))
+			for i, hash := range *hashes {
+				gs.Chess.HistoryHashes[i] = uint64(hash.Hash)
+			}
 		}
 	}
 
 	var gameMoves []*pb.GameMove
 
-	if moves != nil && len(*moves) > 0 {
-		gameMoves = make([]*pb.GameMove, len(*moves))
+	if len(sortedMoves) > 0 {
+		gameMoves = make([]*pb.GameMove, len(sortedMoves))
 
-		for i, m := range *moves {
+		for i, m := range sortedMoves {
 			move := &pb.GameMove{
 				Fen: m.Fen,
 			}
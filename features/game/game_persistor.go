@@ -79,6 +79,7 @@ type ActiveGamePersistor interface {
 	ListUserActiveGames(ctx context.Context, userID uuid.UUID, filters ListActiveGameFilters) (pagination.WithTotal[GameDetails], error)
 	IsGameActive(ctx context.Context, gameID int64) (bool, error)
 	IsUserInActiveGame(ctx context.Context, userID uuid.UUID, gameID int64) (bool, error)
+	IsUserInAnyActiveGame(ctx context.Context, userID uuid.UUID) (bool, error)
 	ListActiveGameUsers(ctx context.Context, gameID int64) ([2]uuid.UUID, error)
 	CreateActiveGame(ctx context.Context, gs *gameplay.GameState) error
 	UpdateActiveGame(ctx context.Context, gameID int64, in models.GameSetter) error
@@ -0,0 +1,168 @@
+package game
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dankobg/juicer/engine"
+	"github.com/dankobg/juicer/engine/pgn"
+	"github.com/dankobg/juicer/gameplay"
+	pb "github.com/dankobg/juicer/pb/proto/juicer"
+)
+
+// gameStateToPGN exports the finished game with the tags known to the gamestate, the opening tags are skipped for the unclassified opening
+func gameStateToPGN(gs *gameplay.GameState, event gameplay.GameFinishedEvent, opening engine.Opening) string {
+	g := pgn.FromChess(gs.Chess)
+
+	eventName := "Casual game"
+	if gs.Rated {
+		eventName = "Rated game"
+	}
+
+	g.Tags.Set("Event", eventName)
+
+	if gs.StartTime != nil {
+		startTime := gs.StartTime.UTC()
+		g.Tags.Set("Date", startTime.Format("2006.01.02"))
+		g.Tags.Set("UTCDate", startTime.Format("2006.01.02"))
+		g.Tags.Set("UTCTime", startTime.Format(time.TimeOnly))
+	}
+
+	if gs.White != nil {
+		g.Tags.Set("White", gs.White.Username)
+	}
+
+	if gs.Black != nil {
+		g.Tags.Set("Black", gs.Black.Username)
+	}
+
+	g.Tags.Set("Result", gameResultToPGN(event.GameResult))
+	g.Tags.Set("Variant", gameVariantToPGN(gs.GameVariant))
+
+	if tc := gs.GameTimeControl; tc != nil {
+		g.Tags.Set("TimeControl", fmt.Sprintf("%d+%d", tc.GetClockMs()/1000, tc.GetIncrementMs()/1000))
+	}
+
+	g.Tags.Set("Termination", gameResultStatusToPGN(event.GameResultStatus))
+	if reason := gameResultStatusReasonToPGN(event.GameResultStatus); reason != "" {
+		g.Tags.Set("TerminationDetails", reason)
+	}
+
+	if opening.ECO != "" {
+		g.Tags.Set("ECO", opening.ECO)
+		g.Tags.Set("Opening", opening.String())
+	}
+
+	return g.String()
+}
+
+func gameResultToPGN(x pb.GameResult) string {
+	switch x {
+	case pb.GameResult_GAME_RESULT_WHITE_WON:
+		return pgn.ResultWhiteWon
+	case pb.GameResult_GAME_RESULT_BLACK_WON:
+		return pgn.ResultBlackWon
+	case pb.GameResult_GAME_RESULT_DRAW:
+		return pgn.ResultDraw
+	default:
+		return pgn.ResultUnknown
+	}
+}
+
+func gameVariantToPGN(x pb.GameVariant) string {
+	switch x {
+	case pb.GameVariant_GAME_VARIANT_ATOMIC:
+		return "Atomic"
+	case pb.GameVariant_GAME_VARIANT_CRAZYHOUSE:
+		return "Crazyhouse"
+	case pb.GameVariant_GAME_VARIANT_CHESS960:
+		return "Chess960"
+	case pb.GameVariant_GAME_VARIANT_KING_OF_THE_HILL:
+		return "King of the Hill"
+	case pb.GameVariant_GAME_VARIANT_THREE_CHECK:
+		return "Three-check"
+	case pb.GameVariant_GAME_VARIANT_HORDE:
+		return "Horde"
+	case pb.GameVariant_GAME_VARIANT_RACING_KINGS:
+		return "Racing Kings"
+	default:
+		return "Standard"
+	}
+}
+
+// gameResultStatusToPGN maps the result status to one of the standard pgn termination values
+func gameResultStatusToPGN(x pb.GameResultStatus) string {
+	switch x {
+	case pb.GameResultStatus_GAME_RESULT_STATUS_CHECKMATE,
+		pb.GameResultStatus_GAME_RESULT_STATUS_INSUFFICIENT_MATERIAL,
+		pb.GameResultStatus_GAME_RESULT_STATUS_THREEFOLD_REPETITION,
+		pb.GameResultStatus_GAME_RESULT_STATUS_FIVEFOLD_REPETITION,
+		pb.GameResultStatus_GAME_RESULT_STATUS_FIFTY_MOVE_RULE,
+		pb.GameResultStatus_GAME_RESULT_STATUS_SEVENTYFIVE_MOVE_RULE,
+		pb.GameResultStatus_GAME_RESULT_STATUS_STALEMATE,
+		pb.GameResultStatus_GAME_RESULT_STATUS_RESIGNATION,
+		pb.GameResultStatus_GAME_RESULT_STATUS_DRAW_AGREED,
+		pb.GameResultStatus_GAME_RESULT_STATUS_KING_EXPLODED,
+		pb.GameResultStatus_GAME_RESULT_STATUS_KING_OF_THE_HILL,
+		pb.GameResultStatus_GAME_RESULT_STATUS_THREE_CHECK,
+		pb.GameResultStatus_GAME_RESULT_STATUS_ALL_PIECES_CAPTURED,
+		pb.GameResultStatus_GAME_RESULT_STATUS_RACE_FINISHED,
+		pb.GameResultStatus_GAME_RESULT_STATUS_DEAD_POSITION:
+		return "normal"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_FLAGGED:
+		return "time forfeit"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_ADJUDICATION:
+		return "adjudication"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_TIMED_OUT,
+		pb.GameResultStatus_GAME_RESULT_STATUS_ABORTED:
+		return "abandoned"
+	default:
+		return "unterminated"
+	}
+}
+
+// gameResultStatusReasonToPGN describes the specific reason the game ended for the custom termination details tag
+func gameResultStatusReasonToPGN(x pb.GameResultStatus) string {
+	switch x {
+	case pb.GameResultStatus_GAME_RESULT_STATUS_CHECKMATE:
+		return "checkmate"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_INSUFFICIENT_MATERIAL:
+		return "insufficient material"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_THREEFOLD_REPETITION:
+		return "threefold repetition"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_FIVEFOLD_REPETITION:
+		return "fivefold repetition"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_FIFTY_MOVE_RULE:
+		return "fifty move rule"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_SEVENTYFIVE_MOVE_RULE:
+		return "seventy-five move rule"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_STALEMATE:
+		return "stalemate"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_RESIGNATION:
+		return "resignation"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_DRAW_AGREED:
+		return "draw agreed"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_FLAGGED:
+		return "time forfeit"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_ADJUDICATION:
+		return "adjudication"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_TIMED_OUT:
+		return "abandoned"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_ABORTED:
+		return "aborted"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_KING_EXPLODED:
+		return "king exploded"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_KING_OF_THE_HILL:
+		return "king in the center"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_THREE_CHECK:
+		return "three checks"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_ALL_PIECES_CAPTURED:
+		return "all pieces captured"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_RACE_FINISHED:
+		return "king reached the 8th rank"
+	case pb.GameResultStatus_GAME_RESULT_STATUS_DEAD_POSITION:
+		return "dead position"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,97 @@
+package game
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/dankobg/juicer/api/gen"
+	"github.com/dankobg/juicer/engine/render"
+	"github.com/dankobg/juicer/gameplay"
+)
+
+// gameAnimationDelay is how long every move is shown in the game gif
+const gameAnimationDelay = time.Second
+
+// gameAnimationSize is the board size of the game gif, it is smaller than the svg because every frame is a bitmap
+const gameAnimationSize = 320
+
+// RenderGamePosition draws the game position after the ply as the svg board, the current position without the ply
+func (g *GameService) RenderGamePosition(ctx context.Context, request api.GetGamePositionSvgRequestObject) ([]byte, error) {
+	diagrams, firstPly, err := g.gameDiagrams(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ply := firstPly + len(diagrams) - 1
+	if request.Params.Ply != nil {
+		ply = int(*request.Params.Ply)
+	}
+
+	if ply < firstPly || ply >= firstPly+len(diagrams) {
+		return nil, fmt.Errorf("%w: %d", ErrGamePlyNotFound, ply)
+	}
+
+	opts := &render.Options{Flip: request.Params.Flip != nil && *request.Params.Flip, Coordinates: true}
+
+	var buf bytes.Buffer
+	if err := render.SVG(&buf, diagrams[ply-firstPly], opts); err != nil {
+		return nil, fmt.Errorf("failed to render game position: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// RenderGameAnimation draws all the game positions as the animated gif
+func (g *GameService) RenderGameAnimation(ctx context.Context, request api.GetGameAnimationGifRequestObject) ([]byte, error) {
+	diagrams, _, err := g.gameDiagrams(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &render.Options{Size: gameAnimationSize, Flip: request.Params.Flip != nil && *request.Params.Flip, Coordinates: true}
+
+	var buf bytes.Buffer
+	if err := render.GIF(&buf, diagrams, gameAnimationDelay, opts); err != nil {
+		return nil, fmt.Errorf("failed to render game animation: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// gameDiagrams returns the game positions from the game_move rows with the ply of the first one, the first row
+// without the uci is the start position (the random one in chess960)
+func (g *GameService) gameDiagrams(ctx context.Context, gameID int64) ([]render.Diagram, int, error) {
+	filters := GetGameByIDFilters{GetGameParams: api.GetGameParams{Embed: &[]api.GetGameParamsEmbed{api.GetGameParamsEmbedMoves}}}
+
+	game, err := g.pst.Game.GetGameByID(ctx, gameID, filters)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get game by id: %w", err)
+	}
+
+	if game.GameMoves.Val == nil || len(*game.GameMoves.Val) == 0 {
+		return nil, 0, fmt.Errorf("%w: the game has no known positions", ErrGamePlyNotFound)
+	}
+
+	variant := g.gameVariantIDToProto(game.GameVariantID)
+	moves := sortedGameMoves(*game.GameMoves.Val)
+
+	firstPly := 0
+	if moves[0].Uci != "" {
+		firstPly = 1
+	}
+
+	diagrams := make([]render.Diagram, len(moves))
+
+	for i, move := range moves {
+		chess, err := gameplay.NewVariantChess(variant, move.Fen)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to load game move %s position: %w", move.Uci, err)
+		}
+
+		diagrams[i] = render.NewDiagram(chess.Position, move.Uci)
+	}
+
+	return diagrams, firstPly, nil
+}
@@ -129,6 +129,86 @@ func (g *GameService) processPoolMatchFound(ctx context.Context, pair [2]string,
 
 	gtc := &pb.GameTimeControl{ClockMs: pool.ClockMS, IncrementMs: pool.IncrementMS}
 
+	gs, err := gameplay.NewGameState(-1, players, gtc, g.gameplayThresholds(), g.gameEvent, gameplay.WithRated(pool.Rated))
+	if err != nil {
+		g.log.Error("gameplay.NewGameState", slog.Any("error", err))
+		return
+	}
+
+	g.createGame(ctx, gs)
+}
+
+// startBotGame starts the unrated game of the user against the built-in bot of the seek level
+func (g *GameService) startBotGame(ctx context.Context, userID uuid.UUID, seek *pb.SeekBotGame) error {
+	if level := int(seek.GetBotLevel()); level < gameplay.MinBotLevel || level > gameplay.MaxBotLevel {
+		return gameplay.ErrBotLevelInvalid
+	}
+
+	if !isQuickGameTimeControl(seek.GetGameTimeControl()) {
+		return ErrTimeControlNotQuickGame
+	}
+
+	// every bot game runs its own game loop and search, so the user can't start more of them at once
+	inActiveGame, err := g.pst.ActiveGame.IsUserInAnyActiveGame(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	inPool, err := g.pst.Pool.IsUserInPool(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if inActiveGame || inPool {
+		return ErrUserBusy
+	}
+
+	userColor, botColor := pb.Color_COLOR_WHITE, pb.Color_COLOR_BLACK
+
+	switch seek.GetSide() {
+	case pb.GameSideChoice_GAME_SIDE_CHOICE_WHITE:
+	case pb.GameSideChoice_GAME_SIDE_CHOICE_BLACK:
+		userColor, botColor = botColor, userColor
+	default:
+		if rand.IntN(2) == 1 {
+			userColor, botColor = botColor, userColor
+		}
+	}
+
+	bot, err := gameplay.NewBotPlayer(int(seek.GetBotLevel()), botColor)
+	if err != nil {
+		return err
+	}
+
+	players := [2]gameplay.Player{
+		{ID: userID, Username: "guest", Color: userColor, Guest: true},
+		bot,
+	}
+
+	gtc := &pb.GameTimeControl{ClockMs: seek.GetGameTimeControl().GetClockMs(), IncrementMs: seek.GetGameTimeControl().GetIncrementMs()}
+
+	gs, err := gameplay.NewGameState(-1, players, gtc, g.gameplayThresholds(), g.gameEvent, gameplay.WithRated(false))
+	if err != nil {
+		return err
+	}
+
+	g.createGame(ctx, gs)
+
+	return nil
+}
+
+// isQuickGameTimeControl checks if the time control is one of the quick games
+func isQuickGameTimeControl(gtc *pb.GameTimeControl) bool {
+	for _, quickGame := range QuickGames {
+		if gtc.GetClockMs() == quickGame.ClockSecs*1000 && gtc.GetIncrementMs() == quickGame.IncrementSecs*1000 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *GameService) gameplayThresholds() []gameplay.CategoryThreshold {
 	thresholds := []gameplay.CategoryThreshold{}
 	for _, x := range g.categoryThresholds {
 		thresholds = append(thresholds, gameplay.CategoryThreshold{
@@ -137,12 +217,12 @@ func (g *GameService) processPoolMatchFound(ctx context.Context, pair [2]string,
 		})
 	}
 
-	gs, err := gameplay.NewGameState(-1, players, gtc, thresholds, g.gameEvent, gameplay.WithRated(pool.Rated))
-	if err != nil {
-		g.log.Error("gameplay.NewGameState", slog.Any("error", err))
-		return
-	}
+	return thresholds
+}
 
+// createGame stores the new game, starts it and lets the players know the game is found,
+// the bots are stored as the guests with their level
+func (g *GameService) createGame(ctx context.Context, gs *gameplay.GameState) {
 	gameRemainingSecs := gs.GameTimeControl.GetClockMs() / 1000
 	gameRemainingNs := (int64(gs.GameTimeControl.GetClockMs()) % 1000) * int64(time.Millisecond)
 
@@ -166,7 +246,7 @@ func (g *GameService) processPoolMatchFound(ctx context.Context, pair [2]string,
 		Fen:                    omit.From(gs.Chess.Position.Fen()),
 		Repetitions:            omit.From(int32(gs.Chess.Repetitions)),
 	}
-	if pool.Rated {
+	if gs.Rated {
 		gameSetter.WhiteID = omitnull.From(gs.White.ID)
 		gameSetter.BlackID = omitnull.From(gs.Black.ID)
 	} else {
@@ -174,6 +254,14 @@ func (g *GameService) processPoolMatchFound(ctx context.Context, pair [2]string,
 		gameSetter.GuestBlackID = omitnull.From(gs.Black.ID)
 	}
 
+	if gs.White.IsBot() {
+		gameSetter.WhiteBotLevel = omitnull.From(int32(gs.White.BotLevel))
+	}
+
+	if gs.Black.IsBot() {
+		gameSetter.BlackBotLevel = omitnull.From(int32(gs.Black.BotLevel))
+	}
+
 	if gs.GameResult != pb.GameResult_GAME_RESULT_UNSPECIFIED {
 		gameSetter.GameResultID = omitnull.From(g.gameResultProtoToID(gs.GameResult))
 	}
@@ -204,8 +292,6 @@ func (g *GameService) processPoolMatchFound(ctx context.Context, pair [2]string,
 		}
 	}
 
-	gs.Start(ctx)
-
 	game, err := g.pst.Game.CreateGame(ctx, gameSetter, moveSetters, hashSetters)
 	if err != nil {
 		g.log.Error("CreateGame", slog.Any("error", err))
@@ -216,6 +302,9 @@ func (g *GameService) processPoolMatchFound(ctx context.Context, pair [2]string,
 
 	g.gamestates[game.ID] = gs
 
+	// the bot can move right away so the game is started only once it has the id
+	gs.Start(ctx)
+
 	if err := g.pst.ActiveGame.CreateActiveGame(ctx, gs); err != nil {
 		g.log.Error("CreateActiveGame", slog.Any("error", err))
 	}
@@ -226,12 +315,14 @@ func (g *GameService) processPoolMatchFound(ctx context.Context, pair [2]string,
 	if err != nil {
 		g.log.Error("protojson marshal Message_GameFound", slog.Any("error", err))
 	} else {
-		if err := g.bus.Publish(ctx, "user."+userID1.String(), gameFoundMsgBytes); err != nil {
-			g.log.Error("publish Message_GameFound", slog.Any("error", err))
-		}
-
-		if err := g.bus.Publish(ctx, "user."+userID2.String(), gameFoundMsgBytes); err != nil {
-			g.log.Error("publish Message_GameFound", slog.Any("error", err))
+		for _, player := range []*gameplay.Player{gs.White, gs.Black} {
+			if player.IsBot() {
+				continue
+			}
+
+			if err := g.bus.Publish(ctx, "user."+player.ID.String(), gameFoundMsgBytes); err != nil {
+				g.log.Error("publish Message_GameFound", slog.Any("error", err))
+			}
 		}
 	}
 }
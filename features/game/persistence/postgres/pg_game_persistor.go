@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 
@@ -170,6 +171,24 @@ func (pst *PgGamePersistor) ListGames(ctx context.Context, filters game.ListGame
 			q.Apply(sm.Where(models.Games.Columns.GameStateID.In(psql.Arg(gameStateIDs...))))
 		}
 
+		if filters.Eco != nil {
+			ecos := make([]any, len(*filters.Eco))
+			for i, eco := range *filters.Eco {
+				ecos[i] = eco
+			}
+
+			q.Apply(sm.Where(models.Games.Columns.Eco.In(psql.Arg(ecos...))))
+		}
+
+		if filters.OpeningName != nil {
+			openingNames := make([]any, len(*filters.OpeningName))
+			for i, name := range *filters.OpeningName {
+				openingNames[i] = name
+			}
+
+			q.Apply(sm.Where(models.Games.Columns.OpeningName.In(psql.Arg(openingNames...))))
+		}
+
 		if filters.Rated != nil {
 			q.Apply(sm.Where(models.Games.Columns.Rated.EQ(psql.Arg(*filters.Rated))))
 		}
@@ -267,7 +286,10 @@ func (pst *PgGamePersistor) GetGameByID(ctx context.Context, gameID int64, filte
 
 	gameDetails, err := bob.One(ctx, pst.Exec, q, scan.StructMapper[game.GameDetails](scan.WithTypeConverter(orm.NullTypeConverter{})))
 	if err != nil {
-		return game.GameDetails{}, fmt.Errorf("query game")
+		if errors.Is(err, sql.ErrNoRows) {
+			return game.GameDetails{}, game.ErrGameNotFound
+		}
+		return game.GameDetails{}, fmt.Errorf("query game: %w", err)
 	}
 
 	return gameDetails, nil
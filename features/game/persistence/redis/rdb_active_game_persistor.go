@@ -58,6 +58,17 @@ func (pst *RedisActiveGamePersistor) IsUserInActiveGame(ctx context.Context, use
 	return ok, nil
 }
 
+func (pst *RedisActiveGamePersistor) IsUserInAnyActiveGame(ctx context.Context, userID uuid.UUID) (bool, error) {
+	activeGamesUserKey := "active-games:user:" + userID.String()
+
+	n, err := pst.rdb.SCard(ctx, activeGamesUserKey).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
 func (pst *RedisActiveGamePersistor) ListActiveGameUsers(ctx context.Context, gameID int64) ([2]uuid.UUID, error) {
 	panic("")
 }
@@ -125,6 +125,17 @@ func (pst *RedisPoolPersistor) LeavePool(ctx context.Context, userID uuid.UUID)
 	return nil
 }
 
+func (pst *RedisPoolPersistor) IsUserInPool(ctx context.Context, userID uuid.UUID) (bool, error) {
+	poolUserKey := fmt.Sprintf("pool-user:%s", userID.String())
+
+	n, err := pst.rdb.Exists(ctx, poolUserKey).Result()
+	if err != nil {
+		return false, fmt.Errorf("IsUserInPool failed: %w", err)
+	}
+
+	return n == 1, nil
+}
+
 func (pst *RedisPoolPersistor) ListPoolPlayers(ctx context.Context, pool game.Pool) ([]string, error) {
 	poolKey := fmt.Sprintf("pool:%s", pool.Name())
 
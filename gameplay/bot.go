@@ -0,0 +1,224 @@
+package gameplay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/dankobg/juicer/engine"
+	"github.com/dankobg/juicer/engine/search"
+	pb "github.com/dankobg/juicer/pb/proto/juicer"
+	"github.com/google/uuid"
+)
+
+var ErrBotLevelInvalid = errors.New("bot level must be between 1 and 8")
+
+const (
+	MinBotLevel = 1
+	MaxBotLevel = 8
+)
+
+const (
+	// botHashMB is the transposition table size of every bot, the bots search shallow so it is kept small
+	botHashMB = 4
+	// botMovesToGo is how many moves the bot expects to play with the remaining clock time
+	botMovesToGo = 35
+	// botMinThinkTime is the shortest think time so the bot doesn't play instantly
+	botMinThinkTime = 400 * time.Millisecond
+)
+
+// botNamespace is the namespace of the bot ids, the bot of the same level has the same id in all the games
+var botNamespace = uuid.MustParse("5b1f7c1e-6a0c-4d4e-9f4b-3c6f2e0a9d17")
+
+// botLevel is the strength of the bot, the weaker bots search shallower, see the position through the noise
+// and sometimes play the random move instead of the best one
+type botLevel struct {
+	depth int
+	// noise is the max static evaluation error in centipawns
+	noise int
+	// blunderChance is the probability of playing the random move instead of the searched one
+	blunderChance float64
+	// thinkFactor scales the part of the clock spent on the move
+	thinkFactor float64
+}
+
+var botLevels = [MaxBotLevel + 1]botLevel{
+	1: {depth: 1, noise: 350, blunderChance: 0.30, thinkFactor: 0.3},
+	2: {depth: 1, noise: 200, blunderChance: 0.20, thinkFactor: 0.4},
+	3: {depth: 2, noise: 120, blunderChance: 0.12, thinkFactor: 0.5},
+	4: {depth: 3, noise: 80, blunderChance: 0.08, thinkFactor: 0.6},
+	5: {depth: 4, noise: 50, blunderChance: 0.05, thinkFactor: 0.7},
+	6: {depth: 5, noise: 30, blunderChance: 0.02, thinkFactor: 0.8},
+	7: {depth: 7, noise: 15, blunderChance: 0.01, thinkFactor: 0.9},
+	8: {depth: 0, noise: 0, blunderChance: 0, thinkFactor: 1},
+}
+
+// BotID returns the id of the bot with the level
+func BotID(level int) uuid.UUID {
+	return uuid.NewSHA1(botNamespace, []byte(BotUsername(level)))
+}
+
+// BotUsername returns the username of the bot with the level
+func BotUsername(level int) string {
+	return fmt.Sprintf("juicer-bot-%d", level)
+}
+
+// NewBotPlayer returns the built-in bot of the level playing the color
+func NewBotPlayer(level int, color pb.Color) (Player, error) {
+	if level < MinBotLevel || level > MaxBotLevel {
+		return Player{}, ErrBotLevelInvalid
+	}
+
+	return Player{ID: BotID(level), Username: BotUsername(level), Color: color, Guest: true, BotLevel: level}, nil
+}
+
+// bot plays the moves of the bot player, it thinks in its own goroutine and submits the move
+// as the PlayMoveUCICmd like any other player, it never aborts, resigns or leaves the game
+type bot struct {
+	player   *Player
+	level    botLevel
+	searcher *search.Searcher
+}
+
+func newBot(player *Player) *bot {
+	level := botLevels[player.BotLevel]
+
+	searcher := search.NewSearcher(botHashMB)
+	searcher.SetEvaluator(noisyEvaluator(level.noise, rand.Uint64()))
+
+	return &bot{player: player, level: level, searcher: searcher}
+}
+
+// botTurn is the snapshot of the game the bot thinks about, so the game loop is free to process other commands
+type botTurn struct {
+	gameID    int64
+	chess     *engine.Chess
+	remaining time.Duration
+	increment time.Duration
+	version   int
+}
+
+// noisyEvaluator adds the error of at most the noise to the static evaluation, the same position
+// always gets the same error in the game so the transposition table stays consistent
+func noisyEvaluator(noise int, seed uint64) search.Evaluator {
+	if noise <= 0 {
+		return engine.Evaluate
+	}
+
+	return func(p *engine.Position) int {
+		h := (p.Hash ^ seed) * 0x9e3779b97f4a7c15
+		return engine.Evaluate(p) + int((h>>32)%uint64(2*noise+1)) - noise
+	}
+}
+
+// thinkTime is how long the bot takes for the move, it spends the part of the clock with some randomness
+// like the human would, the first moves are quick because the clock doesn't run yet
+func (b *bot) thinkTime(turn botTurn) time.Duration {
+	if turn.chess.Position.Ply < 2 {
+		return botMinThinkTime + rand.N(time.Second)
+	}
+
+	budget := turn.remaining/botMovesToGo + turn.increment*3/4
+	budget = time.Duration(float64(budget) * b.level.thinkFactor * (0.5 + rand.Float64()))
+
+	return min(max(budget, botMinThinkTime), max(turn.remaining/10, time.Millisecond))
+}
+
+// chooseMove searches the move within the think time, the level decides whether it is replaced by the blunder
+func (b *bot) chooseMove(ctx context.Context, turn botTurn, thinkTime time.Duration) (engine.Move, bool) {
+	legalMoves := turn.chess.Position.LegalMoves()
+	if len(legalMoves) == 0 {
+		return 0, false
+	}
+
+	res := b.searcher.Search(ctx, turn.chess, search.Limits{Depth: b.level.depth, MoveTime: thinkTime}, nil)
+	if ctx.Err() != nil {
+		return 0, false
+	}
+
+	if len(legalMoves) > 1 && rand.Float64() < b.level.blunderChance {
+		blunders := slices.DeleteFunc(legalMoves, func(m engine.Move) bool { return m == res.BestMove })
+		return blunders[rand.IntN(len(blunders))], true
+	}
+
+	return res.BestMove, true
+}
+
+// play thinks about the move and submits it to the game once the think time passed
+func (b *bot) play(ctx context.Context, turn botTurn, gameCommand chan<- GameCommand) {
+	start := time.Now()
+	thinkTime := b.thinkTime(turn)
+
+	move, ok := b.chooseMove(ctx, turn, thinkTime)
+	if !ok {
+		return
+	}
+
+	wait := time.NewTimer(thinkTime - time.Since(start))
+	defer wait.Stop()
+
+	select {
+	case <-wait.C:
+	case <-ctx.Done():
+		return
+	}
+
+	// the game can end while the move waits for the game loop, the cancelled think doesn't play it then
+	select {
+	case gameCommand <- PlayMoveUCICmd{
+		GameID: turn.gameID,
+		UserID: b.player.ID,
+		UCI:    move.String(),
+		Ack:    int32(turn.version),
+	}:
+	case <-ctx.Done():
+	}
+}
+
+// playBotMove starts the bot thinking when it is the bot's turn in the ongoing game
+func (gs *GameState) playBotMove(ctx context.Context) {
+	if gs.GameResult != pb.GameResult_GAME_RESULT_UNSPECIFIED || gs.GameState != pb.GameState_GAME_STATE_ACTIVE {
+		return
+	}
+
+	color, remaining := pb.Color_COLOR_WHITE, gs.WhiteRemainingGameTime
+	if gs.Chess.Position.Turn.IsBlack() {
+		color, remaining = pb.Color_COLOR_BLACK, gs.BlackRemainingGameTime
+	}
+
+	b, ok := gs.bots[gs.GetPlayerByColor(color).ID]
+	if !ok {
+		return
+	}
+
+	// the clock of the side to move is already running since the last move
+	if gs.LastMove != nil && gs.Chess.Position.Ply >= 2 {
+		remaining -= time.Since(*gs.LastMove)
+	}
+
+	turn := botTurn{
+		gameID: gs.GameID,
+		chess: &engine.Chess{
+			Position:      gs.Chess.Position.Copy(),
+			HistoryHashes: slices.Clone(gs.Chess.HistoryHashes),
+		},
+		remaining: max(remaining, 0),
+		increment: time.Duration(gs.GameTimeControl.GetIncrementMs()) * time.Millisecond,
+		version:   gs.Version + 1,
+	}
+
+	if gs.botCancel != nil {
+		gs.botCancel()
+	}
+
+	thinkCtx, cancel := context.WithCancel(ctx)
+	gs.botCancel = cancel
+
+	go func() {
+		defer cancel()
+		b.play(thinkCtx, turn, gs.GameCommand)
+	}()
+}
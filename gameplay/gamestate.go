@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
 	"sync/atomic"
 	"time"
 
@@ -50,6 +51,12 @@ type Player struct {
 	Username string
 	Color    pb.Color
 	Guest    bool
+	// BotLevel is the level of the built-in bot playing the side, 0 for the humans
+	BotLevel int
+}
+
+func (p *Player) IsBot() bool {
+	return p.BotLevel > 0
 }
 
 type DrawOffer struct {
@@ -92,6 +99,79 @@ type GameState struct {
 	firstMoveTimer         *time.Timer
 	whiteReconnectTimer    *time.Timer
 	blackReconnectTimer    *time.Timer
+	bots                   map[uuid.UUID]*bot
+	botCancel              context.CancelFunc
+}
+
+// engineVariants are the game variants with their own engine rules
+var engineVariants = map[pb.GameVariant]engine.Variant{
+	pb.GameVariant_GAME_VARIANT_CRAZYHOUSE:       engine.VariantCrazyhouse,
+	pb.GameVariant_GAME_VARIANT_ATOMIC:           engine.VariantAtomic,
+	pb.GameVariant_GAME_VARIANT_KING_OF_THE_HILL: engine.VariantKingOfTheHill,
+	pb.GameVariant_GAME_VARIANT_THREE_CHECK:      engine.VariantThreeCheck,
+	pb.GameVariant_GAME_VARIANT_HORDE:            engine.VariantHorde,
+	pb.GameVariant_GAME_VARIANT_RACING_KINGS:     engine.VariantRacingKings,
+}
+
+// variantResultStatuses are the result statuses of the games ended by the variant rule
+var variantResultStatuses = map[engine.Status]pb.GameResultStatus{
+	engine.StatusKingExploded:      pb.GameResultStatus_GAME_RESULT_STATUS_KING_EXPLODED,
+	engine.StatusKingOfTheHill:     pb.GameResultStatus_GAME_RESULT_STATUS_KING_OF_THE_HILL,
+	engine.StatusThreeCheck:        pb.GameResultStatus_GAME_RESULT_STATUS_THREE_CHECK,
+	engine.StatusAllPiecesCaptured: pb.GameResultStatus_GAME_RESULT_STATUS_ALL_PIECES_CAPTURED,
+	engine.StatusRaceFinished:      pb.GameResultStatus_GAME_RESULT_STATUS_RACE_FINISHED,
+}
+
+// flaggedResult is the result of the game lost on time by the side, it is drawn when the opponent can't checkmate by any series of legal moves
+func flaggedResult(chess *engine.Chess, flagged engine.Color) pb.GameResult {
+	if !chess.Position.CanCheckmate(flagged.Opposite()) {
+		return pb.GameResult_GAME_RESULT_DRAW
+	}
+
+	if flagged.IsWhite() {
+		return pb.GameResult_GAME_RESULT_BLACK_WON
+	}
+
+	return pb.GameResult_GAME_RESULT_WHITE_WON
+}
+
+// NewVariantChess loads the chess of the game variant from the fen, the empty fen is the variant start position
+// (the random one in chess960)
+func NewVariantChess(variant pb.GameVariant, fen string) (*engine.Chess, error) {
+	return newGameChess(&gameOpts{gameVariant: variant, fen: fen, chess960Index: -1})
+}
+
+// newGameChess starts the chess from the fen option, or from the chosen (random by default) start position in the chess960 game
+func newGameChess(gopts *gameOpts) (*engine.Chess, error) {
+	if gopts.gameVariant != pb.GameVariant_GAME_VARIANT_CHESS960 {
+		variant, ok := engineVariants[gopts.gameVariant]
+
+		if gopts.fen == "" {
+			gopts.fen = variant.StartingFEN()
+		}
+
+		if ok {
+			return engine.NewChessVariant(gopts.fen, variant)
+		}
+
+		return engine.NewChess(gopts.fen)
+	}
+
+	if gopts.fen == "" {
+		index := gopts.chess960Index
+		if index < 0 {
+			index = rand.IntN(engine.Chess960StartPositions)
+		}
+
+		fen, err := engine.Chess960StartingFEN(index)
+		if err != nil {
+			return nil, err
+		}
+
+		gopts.fen = fen
+	}
+
+	return engine.NewChess960(gopts.fen)
 }
 
 func NewGameState(gameID int64, players [2]Player, gameTimeControl *pb.GameTimeControl, thresholds []CategoryThreshold, gameEvent chan GameEvent, opts ...GameOption) (*GameState, error) {
@@ -106,7 +186,6 @@ func NewGameState(gameID int64, players [2]Player, gameTimeControl *pb.GameTimeC
 
 	gopts := &gameOpts{
 		gameID:           gameID,
-		fen:              engine.FENStartingPosition,
 		gameVariant:      pb.GameVariant_GAME_VARIANT_STANDARD,
 		gameTimeControl:  gameTimeControl,
 		gameTimeKind:     pb.GameTimeKind_GAME_TIME_KIND_REALTIME,
@@ -115,12 +194,13 @@ func NewGameState(gameID int64, players [2]Player, gameTimeControl *pb.GameTimeC
 		reconnectTimeout: defaultReconnectTimeout,
 		firstMoveTimeout: defaultFirstMoveTimeout,
 		startTime:        new(time.Now()),
+		chess960Index:    -1,
 	}
 	for _, o := range opts {
 		o.apply(gopts)
 	}
 
-	chess, err := engine.NewChess(gopts.fen)
+	chess, err := newGameChess(gopts)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrChessEngineInit, err)
 	}
@@ -128,14 +208,20 @@ func NewGameState(gameID int64, players [2]Player, gameTimeControl *pb.GameTimeC
 	var white, black *Player
 
 	playersByID := make(map[uuid.UUID]*Player)
+	bots := make(map[uuid.UUID]*bot)
 
 	for _, p := range players {
+		player := &Player{ID: p.ID, Username: p.Username, Color: p.Color, Guest: p.Guest, BotLevel: p.BotLevel}
 		if p.Color == pb.Color_COLOR_WHITE {
-			white = &Player{ID: p.ID, Username: p.Username, Color: p.Color, Guest: p.Guest}
-			playersByID[p.ID] = white
+			white = player
 		} else {
-			black = &Player{ID: p.ID, Username: p.Username, Color: p.Color, Guest: p.Guest}
-			playersByID[p.ID] = black
+			black = player
+		}
+
+		playersByID[p.ID] = player
+
+		if player.IsBot() {
+			bots[p.ID] = newBot(player)
 		}
 	}
 
@@ -167,6 +253,7 @@ func NewGameState(gameID int64, players [2]Player, gameTimeControl *pb.GameTimeC
 		GameCommand:            make(chan GameCommand, 100),
 		GameEvent:              gameEvent,
 		PendingDrawOffers:      make(map[uuid.UUID]*DrawOffer),
+		bots:                   bots,
 	}
 
 	return gs, nil
@@ -217,6 +304,8 @@ func (gs *GameState) Start(ctx context.Context) {
 	gs.firstMoveTimer = time.NewTimer(gs.FirstMoveTimeout)
 
 	go func() {
+		gs.playBotMove(ctx)
+
 		for {
 			select {
 			case <-Tick(gs.firstMoveTimer):
@@ -229,14 +318,9 @@ func (gs *GameState) Start(ctx context.Context) {
 				}
 
 			case <-Tick(gs.activeGameTimer):
-				gameResult := pb.GameResult_GAME_RESULT_BLACK_WON
-				if gs.Chess.Position.Turn.IsBlack() {
-					gameResult = pb.GameResult_GAME_RESULT_WHITE_WON
-				}
-
 				gs.GameEvent <- GameFinishedEvent{
 					GameID:           gs.GameID,
-					GameResult:       gameResult,
+					GameResult:       flaggedResult(gs.Chess, gs.Chess.Position.Turn),
 					GameResultStatus: pb.GameResultStatus_GAME_RESULT_STATUS_FLAGGED,
 					GameState:        pb.GameState_GAME_STATE_FINISHED,
 					EndTime:          time.Now(),
@@ -334,10 +418,17 @@ func (gs *GameState) Start(ctx context.Context) {
 							UserID: c.UserID,
 							Err:    err,
 						}
+
+						// the rejected bot move (e.g. the outdated ack) is thought again so the bot never stalls
+						if _, ok := gs.bots[c.UserID]; ok {
+							gs.playBotMove(ctx)
+						}
 					} else {
 						for _, event := range events {
 							gs.GameEvent <- event
 						}
+
+						gs.playBotMove(ctx)
 					}
 
 				case LeftGame:
@@ -691,11 +782,17 @@ func (gs *GameState) playMoveUCI(c PlayMoveUCICmd) ([]GameEvent, error) {
 
 			if gs.WhiteRemainingGameTime <= 0 {
 				flaggedAt := gs.LastMove.Add(previousRemaining)
+
+				gs.GameResult = flaggedResult(gs.Chess, engine.White)
+				gs.GameResultStatus = pb.GameResultStatus_GAME_RESULT_STATUS_FLAGGED
+				gs.GameState = pb.GameState_GAME_STATE_FINISHED
+				gs.EndTime = &flaggedAt
+
 				events = append(events, GameFinishedEvent{
 					GameID:           gs.GameID,
-					GameResult:       pb.GameResult_GAME_RESULT_BLACK_WON,
-					GameResultStatus: pb.GameResultStatus_GAME_RESULT_STATUS_FLAGGED,
-					GameState:        pb.GameState_GAME_STATE_FINISHED,
+					GameResult:       gs.GameResult,
+					GameResultStatus: gs.GameResultStatus,
+					GameState:        gs.GameState,
 					EndTime:          flaggedAt,
 				})
 				terminated = true
@@ -708,11 +805,17 @@ func (gs *GameState) playMoveUCI(c PlayMoveUCICmd) ([]GameEvent, error) {
 
 			if gs.BlackRemainingGameTime <= 0 {
 				flaggedAt := gs.LastMove.Add(previousRemaining)
+
+				gs.GameResult = flaggedResult(gs.Chess, engine.Black)
+				gs.GameResultStatus = pb.GameResultStatus_GAME_RESULT_STATUS_FLAGGED
+				gs.GameState = pb.GameState_GAME_STATE_FINISHED
+				gs.EndTime = &flaggedAt
+
 				events = append(events, GameFinishedEvent{
 					GameID:           gs.GameID,
-					GameResult:       pb.GameResult_GAME_RESULT_WHITE_WON,
-					GameResultStatus: pb.GameResultStatus_GAME_RESULT_STATUS_FLAGGED,
-					GameState:        pb.GameState_GAME_STATE_FINISHED,
+					GameResult:       gs.GameResult,
+					GameResultStatus: gs.GameResultStatus,
+					GameState:        gs.GameState,
 					EndTime:          flaggedAt,
 				})
 				terminated = true
@@ -803,8 +906,9 @@ func (gs *GameState) playMoveUCI(c PlayMoveUCICmd) ([]GameEvent, error) {
 
 	events = append(events, playMoveUciEvent)
 
-	if gs.Chess.IsTerminated() {
-		switch gs.Chess.Status() {
+	// the status is worked out once per move, the dead position check can search the locked structures
+	if status := gs.Chess.Status(); status != engine.StatusUnknown {
+		switch status {
 		case engine.StatusInsufficientMaterial:
 			gs.GameState = pb.GameState_GAME_STATE_FINISHED
 			gs.GameResult = pb.GameResult_GAME_RESULT_DRAW
@@ -838,6 +942,23 @@ func (gs *GameState) playMoveUCI(c PlayMoveUCICmd) ([]GameEvent, error) {
 			gs.GameState = pb.GameState_GAME_STATE_FINISHED
 			gs.GameResult = pb.GameResult_GAME_RESULT_DRAW
 			gs.GameResultStatus = pb.GameResultStatus_GAME_RESULT_STATUS_STALEMATE
+		case engine.StatusDeadPosition:
+			gs.GameState = pb.GameState_GAME_STATE_FINISHED
+			gs.GameResult = pb.GameResult_GAME_RESULT_DRAW
+			gs.GameResultStatus = pb.GameResultStatus_GAME_RESULT_STATUS_DEAD_POSITION
+		case engine.StatusKingExploded, engine.StatusKingOfTheHill, engine.StatusThreeCheck, engine.StatusAllPiecesCaptured, engine.StatusRaceFinished:
+			gs.GameState = pb.GameState_GAME_STATE_FINISHED
+			gs.GameResultStatus = variantResultStatuses[status]
+
+			// the race can be drawn or won by white after black's move so the winner is not always the player
+			switch gs.Chess.VariantOutcome() {
+			case engine.OutcomeWhiteWon:
+				gs.GameResult = pb.GameResult_GAME_RESULT_WHITE_WON
+			case engine.OutcomeBlackWon:
+				gs.GameResult = pb.GameResult_GAME_RESULT_BLACK_WON
+			case engine.OutcomeDraw:
+				gs.GameResult = pb.GameResult_GAME_RESULT_DRAW
+			}
 		}
 
 		events = append(events, GameFinishedEvent{
@@ -870,6 +991,10 @@ func (gs *GameState) Stop() {
 	if gs.blackReconnectTimer != nil {
 		gs.blackReconnectTimer.Stop()
 	}
+
+	if gs.botCancel != nil {
+		gs.botCancel()
+	}
 }
 
 func (gs *GameState) whiteReconnectTimeoutExpired() bool {
@@ -28,6 +28,7 @@ type gameOpts struct {
 	endTime          *time.Time
 	version          int
 	gameMoves        []*pb.GameMove
+	chess960Index    int
 }
 
 type GameOption interface {
@@ -57,6 +58,13 @@ type gameVariantOpt pb.GameVariant
 func (o gameVariantOpt) apply(g *gameOpts)                  { g.gameVariant = pb.GameVariant(o) }
 func WithGameVariant(gameVariant pb.GameVariant) GameOption { return gameVariantOpt(gameVariant) }
 
+type chess960StartPositionOpt int
+
+func (o chess960StartPositionOpt) apply(g *gameOpts) { g.chess960Index = int(o) }
+func WithChess960StartPosition(index int) GameOption {
+	return chess960StartPositionOpt(index)
+}
+
 type gameTimeKindOpt pb.GameTimeKind
 
 func (o gameTimeKindOpt) apply(g *gameOpts)                    { g.gameTimeKind = pb.GameTimeKind(o) }
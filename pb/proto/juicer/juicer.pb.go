@@ -328,6 +328,12 @@ const (
 	GameResultStatus_GAME_RESULT_STATUS_TIMED_OUT             GameResultStatus = 12
 	GameResultStatus_GAME_RESULT_STATUS_ABORTED               GameResultStatus = 13
 	GameResultStatus_GAME_RESULT_STATUS_INTERRUPTED           GameResultStatus = 14
+	GameResultStatus_GAME_RESULT_STATUS_KING_EXPLODED         GameResultStatus = 15
+	GameResultStatus_GAME_RESULT_STATUS_KING_OF_THE_HILL      GameResultStatus = 16
+	GameResultStatus_GAME_RESULT_STATUS_THREE_CHECK           GameResultStatus = 17
+	GameResultStatus_GAME_RESULT_STATUS_ALL_PIECES_CAPTURED   GameResultStatus = 18
+	GameResultStatus_GAME_RESULT_STATUS_RACE_FINISHED         GameResultStatus = 19
+	GameResultStatus_GAME_RESULT_STATUS_DEAD_POSITION         GameResultStatus = 20
 )
 
 // Enum value maps for GameResultStatus.
@@ -348,6 +354,12 @@ var (
 		12: "GAME_RESULT_STATUS_TIMED_OUT",
 		13: "GAME_RESULT_STATUS_ABORTED",
 		14: "GAME_RESULT_STATUS_INTERRUPTED",
+		15: "GAME_RESULT_STATUS_KING_EXPLODED",
+		16: "GAME_RESULT_STATUS_KING_OF_THE_HILL",
+		17: "GAME_RESULT_STATUS_THREE_CHECK",
+		18: "GAME_RESULT_STATUS_ALL_PIECES_CAPTURED",
+		19: "GAME_RESULT_STATUS_RACE_FINISHED",
+		20: "GAME_RESULT_STATUS_DEAD_POSITION",
 	}
 	GameResultStatus_value = map[string]int32{
 		"GAME_RESULT_STATUS_UNSPECIFIED":           0,
@@ -365,6 +377,12 @@ var (
 		"GAME_RESULT_STATUS_TIMED_OUT":             12,
 		"GAME_RESULT_STATUS_ABORTED":               13,
 		"GAME_RESULT_STATUS_INTERRUPTED":           14,
+		"GAME_RESULT_STATUS_KING_EXPLODED":         15,
+		"GAME_RESULT_STATUS_KING_OF_THE_HILL":      16,
+		"GAME_RESULT_STATUS_THREE_CHECK":           17,
+		"GAME_RESULT_STATUS_ALL_PIECES_CAPTURED":   18,
+		"GAME_RESULT_STATUS_RACE_FINISHED":         19,
+		"GAME_RESULT_STATUS_DEAD_POSITION":         20,
 	}
 )
 
@@ -589,6 +607,7 @@ type Message struct {
 	//	*Message_ListGameChats
 	//	*Message_GameChat
 	//	*Message_GameChats
+	//	*Message_SeekBotGame
 	//	*Message_PlayerLeft
 	//	*Message_PlayerRejoined
 	//	*Message_DrawDeclined
@@ -908,6 +927,15 @@ func (x *Message) GetGameChats() *GameChatList {
 	return nil
 }
 
+func (x *Message) GetSeekBotGame() *SeekBotGame {
+	if x != nil {
+		if x, ok := x.Event.(*Message_SeekBotGame); ok {
+			return x.SeekBotGame
+		}
+	}
+	return nil
+}
+
 func (x *Message) GetPlayerLeft() *PlayerLeft {
 	if x != nil {
 		if x, ok := x.Event.(*Message_PlayerLeft); ok {
@@ -1095,6 +1123,10 @@ type Message_GameChats struct {
 	GameChats *GameChatList `protobuf:"bytes,30,opt,name=game_chats,json=gameChats,proto3,oneof"`
 }
 
+type Message_SeekBotGame struct {
+	SeekBotGame *SeekBotGame `protobuf:"bytes,31,opt,name=seek_bot_game,json=seekBotGame,proto3,oneof"`
+}
+
 type Message_PlayerLeft struct {
 	PlayerLeft *PlayerLeft `protobuf:"bytes,62,opt,name=player_left,json=playerLeft,proto3,oneof"`
 }
@@ -1183,6 +1215,8 @@ func (*Message_GameChat) isMessage_Event() {}
 
 func (*Message_GameChats) isMessage_Event() {}
 
+func (*Message_SeekBotGame) isMessage_Event() {}
+
 func (*Message_PlayerLeft) isMessage_Event() {}
 
 func (*Message_PlayerRejoined) isMessage_Event() {}
@@ -2061,6 +2095,67 @@ func (*CancelSeekGame) Descriptor() ([]byte, []int) {
 	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{17}
 }
 
+// SeekBotGame starts the unrated game against the built-in bot
+type SeekBotGame struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GameTimeControl *GameTimeControl       `protobuf:"bytes,1,opt,name=game_time_control,json=gameTimeControl,proto3" json:"game_time_control,omitempty"`
+	BotLevel        int32                  `protobuf:"varint,2,opt,name=bot_level,json=botLevel,proto3" json:"bot_level,omitempty"`
+	Side            GameSideChoice         `protobuf:"varint,3,opt,name=side,proto3,enum=pb.GameSideChoice" json:"side,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SeekBotGame) Reset() {
+	*x = SeekBotGame{}
+	mi := &file_proto_juicer_juicer_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeekBotGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeekBotGame) ProtoMessage() {}
+
+func (x *SeekBotGame) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_juicer_juicer_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeekBotGame.ProtoReflect.Descriptor instead.
+func (*SeekBotGame) Descriptor() ([]byte, []int) {
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SeekBotGame) GetGameTimeControl() *GameTimeControl {
+	if x != nil {
+		return x.GameTimeControl
+	}
+	return nil
+}
+
+func (x *SeekBotGame) GetBotLevel() int32 {
+	if x != nil {
+		return x.BotLevel
+	}
+	return 0
+}
+
+func (x *SeekBotGame) GetSide() GameSideChoice {
+	if x != nil {
+		return x.Side
+	}
+	return GameSideChoice_GAME_SIDE_CHOICE_UNSPECIFIED
+}
+
 // PlayerInfo is info about player
 type PlayerInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2069,13 +2164,14 @@ type PlayerInfo struct {
 	Guest         bool                   `protobuf:"varint,3,opt,name=guest,proto3" json:"guest,omitempty"`
 	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
 	Rating        int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	BotLevel      int32                  `protobuf:"varint,6,opt,name=bot_level,json=botLevel,proto3" json:"bot_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PlayerInfo) Reset() {
 	*x = PlayerInfo{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[18]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2087,7 +2183,7 @@ func (x *PlayerInfo) String() string {
 func (*PlayerInfo) ProtoMessage() {}
 
 func (x *PlayerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[18]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2100,7 +2196,7 @@ func (x *PlayerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerInfo.ProtoReflect.Descriptor instead.
 func (*PlayerInfo) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{18}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PlayerInfo) GetUserId() string {
@@ -2138,6 +2234,13 @@ func (x *PlayerInfo) GetRating() int32 {
 	return 0
 }
 
+func (x *PlayerInfo) GetBotLevel() int32 {
+	if x != nil {
+		return x.BotLevel
+	}
+	return 0
+}
+
 // GameMove is the game move
 type GameMove struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2152,7 +2255,7 @@ type GameMove struct {
 
 func (x *GameMove) Reset() {
 	*x = GameMove{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[19]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2164,7 +2267,7 @@ func (x *GameMove) String() string {
 func (*GameMove) ProtoMessage() {}
 
 func (x *GameMove) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[19]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2177,7 +2280,7 @@ func (x *GameMove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameMove.ProtoReflect.Descriptor instead.
 func (*GameMove) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{19}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GameMove) GetFen() string {
@@ -2225,7 +2328,7 @@ type GameFound struct {
 
 func (x *GameFound) Reset() {
 	*x = GameFound{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[20]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2237,7 +2340,7 @@ func (x *GameFound) String() string {
 func (*GameFound) ProtoMessage() {}
 
 func (x *GameFound) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[20]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2250,7 +2353,7 @@ func (x *GameFound) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameFound.ProtoReflect.Descriptor instead.
 func (*GameFound) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{20}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GameFound) GetGameId() int32 {
@@ -2295,7 +2398,7 @@ type GameInfo struct {
 
 func (x *GameInfo) Reset() {
 	*x = GameInfo{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[21]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2307,7 +2410,7 @@ func (x *GameInfo) String() string {
 func (*GameInfo) ProtoMessage() {}
 
 func (x *GameInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[21]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2320,7 +2423,7 @@ func (x *GameInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameInfo.ProtoReflect.Descriptor instead.
 func (*GameInfo) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{21}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GameInfo) GetGameId() int32 {
@@ -2515,7 +2618,7 @@ type AbortGame struct {
 
 func (x *AbortGame) Reset() {
 	*x = AbortGame{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[22]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2527,7 +2630,7 @@ func (x *AbortGame) String() string {
 func (*AbortGame) ProtoMessage() {}
 
 func (x *AbortGame) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[22]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2540,7 +2643,7 @@ func (x *AbortGame) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbortGame.ProtoReflect.Descriptor instead.
 func (*AbortGame) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{22}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *AbortGame) GetGameId() int32 {
@@ -2560,7 +2663,7 @@ type ResignGame struct {
 
 func (x *ResignGame) Reset() {
 	*x = ResignGame{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[23]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2572,7 +2675,7 @@ func (x *ResignGame) String() string {
 func (*ResignGame) ProtoMessage() {}
 
 func (x *ResignGame) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[23]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2585,7 +2688,7 @@ func (x *ResignGame) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResignGame.ProtoReflect.Descriptor instead.
 func (*ResignGame) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{23}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ResignGame) GetGameId() int32 {
@@ -2605,7 +2708,7 @@ type OfferDraw struct {
 
 func (x *OfferDraw) Reset() {
 	*x = OfferDraw{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[24]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2617,7 +2720,7 @@ func (x *OfferDraw) String() string {
 func (*OfferDraw) ProtoMessage() {}
 
 func (x *OfferDraw) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[24]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2630,7 +2733,7 @@ func (x *OfferDraw) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OfferDraw.ProtoReflect.Descriptor instead.
 func (*OfferDraw) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{24}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *OfferDraw) GetGameId() int32 {
@@ -2653,7 +2756,7 @@ type DrawOffer struct {
 
 func (x *DrawOffer) Reset() {
 	*x = DrawOffer{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[25]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2665,7 +2768,7 @@ func (x *DrawOffer) String() string {
 func (*DrawOffer) ProtoMessage() {}
 
 func (x *DrawOffer) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[25]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2678,7 +2781,7 @@ func (x *DrawOffer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DrawOffer.ProtoReflect.Descriptor instead.
 func (*DrawOffer) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{25}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DrawOffer) GetGameId() int32 {
@@ -2720,7 +2823,7 @@ type DrawDeclined struct {
 
 func (x *DrawDeclined) Reset() {
 	*x = DrawDeclined{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[26]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2732,7 +2835,7 @@ func (x *DrawDeclined) String() string {
 func (*DrawDeclined) ProtoMessage() {}
 
 func (x *DrawDeclined) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[26]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2745,7 +2848,7 @@ func (x *DrawDeclined) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DrawDeclined.ProtoReflect.Descriptor instead.
 func (*DrawDeclined) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{26}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DrawDeclined) GetGameId() int32 {
@@ -2772,7 +2875,7 @@ type DeclineDraw struct {
 
 func (x *DeclineDraw) Reset() {
 	*x = DeclineDraw{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[27]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2784,7 +2887,7 @@ func (x *DeclineDraw) String() string {
 func (*DeclineDraw) ProtoMessage() {}
 
 func (x *DeclineDraw) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[27]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2797,7 +2900,7 @@ func (x *DeclineDraw) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeclineDraw.ProtoReflect.Descriptor instead.
 func (*DeclineDraw) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{27}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *DeclineDraw) GetGameId() int32 {
@@ -2817,7 +2920,7 @@ type AcceptDraw struct {
 
 func (x *AcceptDraw) Reset() {
 	*x = AcceptDraw{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[28]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2829,7 +2932,7 @@ func (x *AcceptDraw) String() string {
 func (*AcceptDraw) ProtoMessage() {}
 
 func (x *AcceptDraw) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[28]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2842,7 +2945,7 @@ func (x *AcceptDraw) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptDraw.ProtoReflect.Descriptor instead.
 func (*AcceptDraw) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{28}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AcceptDraw) GetGameId() int32 {
@@ -2862,7 +2965,7 @@ type SendLobbyChat struct {
 
 func (x *SendLobbyChat) Reset() {
 	*x = SendLobbyChat{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[29]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2874,7 +2977,7 @@ func (x *SendLobbyChat) String() string {
 func (*SendLobbyChat) ProtoMessage() {}
 
 func (x *SendLobbyChat) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[29]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2887,7 +2990,7 @@ func (x *SendLobbyChat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendLobbyChat.ProtoReflect.Descriptor instead.
 func (*SendLobbyChat) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{29}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SendLobbyChat) GetMessage() string {
@@ -2908,7 +3011,7 @@ type ListLobbyChats struct {
 
 func (x *ListLobbyChats) Reset() {
 	*x = ListLobbyChats{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[30]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2920,7 +3023,7 @@ func (x *ListLobbyChats) String() string {
 func (*ListLobbyChats) ProtoMessage() {}
 
 func (x *ListLobbyChats) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[30]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2933,7 +3036,7 @@ func (x *ListLobbyChats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLobbyChats.ProtoReflect.Descriptor instead.
 func (*ListLobbyChats) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{30}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ListLobbyChats) GetCursor() string {
@@ -2963,7 +3066,7 @@ type LobbyChat struct {
 
 func (x *LobbyChat) Reset() {
 	*x = LobbyChat{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[31]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2975,7 +3078,7 @@ func (x *LobbyChat) String() string {
 func (*LobbyChat) ProtoMessage() {}
 
 func (x *LobbyChat) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[31]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2988,7 +3091,7 @@ func (x *LobbyChat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LobbyChat.ProtoReflect.Descriptor instead.
 func (*LobbyChat) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{31}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *LobbyChat) GetMessageId() string {
@@ -3030,7 +3133,7 @@ type LobbyChatList struct {
 
 func (x *LobbyChatList) Reset() {
 	*x = LobbyChatList{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[32]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3042,7 +3145,7 @@ func (x *LobbyChatList) String() string {
 func (*LobbyChatList) ProtoMessage() {}
 
 func (x *LobbyChatList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[32]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3055,7 +3158,7 @@ func (x *LobbyChatList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LobbyChatList.ProtoReflect.Descriptor instead.
 func (*LobbyChatList) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{32}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *LobbyChatList) GetLobbyChats() []*LobbyChat {
@@ -3083,7 +3186,7 @@ type SendGameChat struct {
 
 func (x *SendGameChat) Reset() {
 	*x = SendGameChat{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[33]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3095,7 +3198,7 @@ func (x *SendGameChat) String() string {
 func (*SendGameChat) ProtoMessage() {}
 
 func (x *SendGameChat) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[33]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3108,7 +3211,7 @@ func (x *SendGameChat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendGameChat.ProtoReflect.Descriptor instead.
 func (*SendGameChat) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{33}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *SendGameChat) GetGameId() int32 {
@@ -3137,7 +3240,7 @@ type ListGameChats struct {
 
 func (x *ListGameChats) Reset() {
 	*x = ListGameChats{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[34]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3149,7 +3252,7 @@ func (x *ListGameChats) String() string {
 func (*ListGameChats) ProtoMessage() {}
 
 func (x *ListGameChats) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[34]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3162,7 +3265,7 @@ func (x *ListGameChats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListGameChats.ProtoReflect.Descriptor instead.
 func (*ListGameChats) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{34}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListGameChats) GetGameId() int32 {
@@ -3197,7 +3300,7 @@ type ChatUserSnapshot struct {
 
 func (x *ChatUserSnapshot) Reset() {
 	*x = ChatUserSnapshot{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[35]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3209,7 +3312,7 @@ func (x *ChatUserSnapshot) String() string {
 func (*ChatUserSnapshot) ProtoMessage() {}
 
 func (x *ChatUserSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[35]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3222,7 +3325,7 @@ func (x *ChatUserSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChatUserSnapshot.ProtoReflect.Descriptor instead.
 func (*ChatUserSnapshot) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{35}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ChatUserSnapshot) GetId() string {
@@ -3253,7 +3356,7 @@ type GameChat struct {
 
 func (x *GameChat) Reset() {
 	*x = GameChat{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[36]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3265,7 +3368,7 @@ func (x *GameChat) String() string {
 func (*GameChat) ProtoMessage() {}
 
 func (x *GameChat) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[36]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3278,7 +3381,7 @@ func (x *GameChat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameChat.ProtoReflect.Descriptor instead.
 func (*GameChat) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{36}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GameChat) GetGameId() int32 {
@@ -3328,7 +3431,7 @@ type GameChatList struct {
 
 func (x *GameChatList) Reset() {
 	*x = GameChatList{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[37]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3340,7 +3443,7 @@ func (x *GameChatList) String() string {
 func (*GameChatList) ProtoMessage() {}
 
 func (x *GameChatList) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[37]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3353,7 +3456,7 @@ func (x *GameChatList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameChatList.ProtoReflect.Descriptor instead.
 func (*GameChatList) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{37}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GameChatList) GetGameId() int32 {
@@ -3389,7 +3492,7 @@ type PlayMoveUCI struct {
 
 func (x *PlayMoveUCI) Reset() {
 	*x = PlayMoveUCI{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[38]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3401,7 +3504,7 @@ func (x *PlayMoveUCI) String() string {
 func (*PlayMoveUCI) ProtoMessage() {}
 
 func (x *PlayMoveUCI) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[38]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3414,7 +3517,7 @@ func (x *PlayMoveUCI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayMoveUCI.ProtoReflect.Descriptor instead.
 func (*PlayMoveUCI) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{38}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *PlayMoveUCI) GetGameId() int32 {
@@ -3449,7 +3552,7 @@ type MoveAck struct {
 
 func (x *MoveAck) Reset() {
 	*x = MoveAck{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[39]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3461,7 +3564,7 @@ func (x *MoveAck) String() string {
 func (*MoveAck) ProtoMessage() {}
 
 func (x *MoveAck) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[39]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3474,7 +3577,7 @@ func (x *MoveAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveAck.ProtoReflect.Descriptor instead.
 func (*MoveAck) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{39}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *MoveAck) GetGameId() int32 {
@@ -3510,7 +3613,7 @@ type MoveSync struct {
 
 func (x *MoveSync) Reset() {
 	*x = MoveSync{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[40]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3522,7 +3625,7 @@ func (x *MoveSync) String() string {
 func (*MoveSync) ProtoMessage() {}
 
 func (x *MoveSync) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[40]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3535,7 +3638,7 @@ func (x *MoveSync) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveSync.ProtoReflect.Descriptor instead.
 func (*MoveSync) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{40}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *MoveSync) GetGameId() int32 {
@@ -3621,7 +3724,7 @@ type GameFinished struct {
 
 func (x *GameFinished) Reset() {
 	*x = GameFinished{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[41]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3633,7 +3736,7 @@ func (x *GameFinished) String() string {
 func (*GameFinished) ProtoMessage() {}
 
 func (x *GameFinished) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[41]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3646,7 +3749,7 @@ func (x *GameFinished) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameFinished.ProtoReflect.Descriptor instead.
 func (*GameFinished) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{41}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GameFinished) GetGameId() int32 {
@@ -3689,7 +3792,7 @@ type PlayerLeft struct {
 
 func (x *PlayerLeft) Reset() {
 	*x = PlayerLeft{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[42]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3701,7 +3804,7 @@ func (x *PlayerLeft) String() string {
 func (*PlayerLeft) ProtoMessage() {}
 
 func (x *PlayerLeft) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[42]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3714,7 +3817,7 @@ func (x *PlayerLeft) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerLeft.ProtoReflect.Descriptor instead.
 func (*PlayerLeft) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{42}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *PlayerLeft) GetGameId() int32 {
@@ -3750,7 +3853,7 @@ type PlayerRejoined struct {
 
 func (x *PlayerRejoined) Reset() {
 	*x = PlayerRejoined{}
-	mi := &file_proto_juicer_juicer_proto_msgTypes[43]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3762,7 +3865,7 @@ func (x *PlayerRejoined) String() string {
 func (*PlayerRejoined) ProtoMessage() {}
 
 func (x *PlayerRejoined) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_juicer_juicer_proto_msgTypes[43]
+	mi := &file_proto_juicer_juicer_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3775,7 +3878,7 @@ func (x *PlayerRejoined) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerRejoined.ProtoReflect.Descriptor instead.
 func (*PlayerRejoined) Descriptor() ([]byte, []int) {
-	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{43}
+	return file_proto_juicer_juicer_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *PlayerRejoined) GetGameId() int32 {
@@ -3806,7 +3909,7 @@ const file_proto_juicer_juicer_proto_rawDesc = "" +
 	"\x19proto/juicer/juicer.proto\x12\x02pb\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"O\n" +
 	"\x0fGameTimeControl\x12\x19\n" +
 	"\bclock_ms\x18\x01 \x01(\x05R\aclockMs\x12!\n" +
-	"\fincrement_ms\x18\x02 \x01(\x05R\vincrementMs\"\xc1\x0f\n" +
+	"\fincrement_ms\x18\x02 \x01(\x05R\vincrementMs\"\xf8\x0f\n" +
 	"\aMessage\x12'\n" +
 	"\aproblem\x18\x01 \x01(\v2\v.pb.ProblemH\x00R\aproblem\x12'\n" +
 	"\alatency\x18\x02 \x01(\v2\v.pb.LatencyH\x00R\alatency\x12-\n" +
@@ -3847,7 +3950,8 @@ const file_proto_juicer_juicer_proto_rawDesc = "" +
 	"\x0flist_game_chats\x18\x1c \x01(\v2\x11.pb.ListGameChatsH\x00R\rlistGameChats\x12+\n" +
 	"\tgame_chat\x18\x1d \x01(\v2\f.pb.GameChatH\x00R\bgameChat\x121\n" +
 	"\n" +
-	"game_chats\x18\x1e \x01(\v2\x10.pb.GameChatListH\x00R\tgameChats\x121\n" +
+	"game_chats\x18\x1e \x01(\v2\x10.pb.GameChatListH\x00R\tgameChats\x125\n" +
+	"\rseek_bot_game\x18\x1f \x01(\v2\x0f.pb.SeekBotGameH\x00R\vseekBotGame\x121\n" +
 	"\vplayer_left\x18> \x01(\v2\x0e.pb.PlayerLeftH\x00R\n" +
 	"playerLeft\x12=\n" +
 	"\x0fplayer_rejoined\x18? \x01(\v2\x12.pb.PlayerRejoinedH\x00R\x0eplayerRejoined\x127\n" +
@@ -3908,7 +4012,11 @@ const file_proto_juicer_juicer_proto_rawDesc = "" +
 	"\x05black\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x05black\"K\n" +
 	"\bSeekGame\x12?\n" +
 	"\x11game_time_control\x18\x01 \x01(\v2\x13.pb.GameTimeControlR\x0fgameTimeControl\"\x10\n" +
-	"\x0eCancelSeekGame\"\x8e\x01\n" +
+	"\x0eCancelSeekGame\"\x93\x01\n" +
+	"\vSeekBotGame\x12?\n" +
+	"\x11game_time_control\x18\x01 \x01(\v2\x13.pb.GameTimeControlR\x0fgameTimeControl\x12\x1b\n" +
+	"\tbot_level\x18\x02 \x01(\x05R\bbotLevel\x12&\n" +
+	"\x04side\x18\x03 \x01(\x0e2\x12.pb.GameSideChoiceR\x04side\"\xab\x01\n" +
 	"\n" +
 	"PlayerInfo\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
@@ -3916,7 +4024,8 @@ const file_proto_juicer_juicer_proto_rawDesc = "" +
 	"\x05guest\x18\x03 \x01(\bR\x05guest\x12\x1d\n" +
 	"\n" +
 	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12\x16\n" +
-	"\x06rating\x18\x05 \x01(\x05R\x06rating\"\xc5\x01\n" +
+	"\x06rating\x18\x05 \x01(\x05R\x06rating\x12\x1b\n" +
+	"\tbot_level\x18\x06 \x01(\x05R\bbotLevel\"\xc5\x01\n" +
 	"\bGameMove\x12\x10\n" +
 	"\x03fen\x18\x01 \x01(\tR\x03fen\x12\x15\n" +
 	"\x03uci\x18\x02 \x01(\tH\x00R\x03uci\x88\x01\x01\x12\x15\n" +
@@ -4107,7 +4216,7 @@ const file_proto_juicer_juicer_proto_rawDesc = "" +
 	"\x15GAME_RESULT_WHITE_WON\x10\x01\x12\x19\n" +
 	"\x15GAME_RESULT_BLACK_WON\x10\x02\x12\x14\n" +
 	"\x10GAME_RESULT_DRAW\x10\x03\x12\x1b\n" +
-	"\x17GAME_RESULT_INTERRUPTED\x10\x04*\xca\x04\n" +
+	"\x17GAME_RESULT_INTERRUPTED\x10\x04*\xb5\x06\n" +
 	"\x10GameResultStatus\x12\"\n" +
 	"\x1eGAME_RESULT_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cGAME_RESULT_STATUS_CHECKMATE\x10\x01\x12,\n" +
@@ -4124,7 +4233,13 @@ const file_proto_juicer_juicer_proto_rawDesc = "" +
 	"\x1fGAME_RESULT_STATUS_ADJUDICATION\x10\v\x12 \n" +
 	"\x1cGAME_RESULT_STATUS_TIMED_OUT\x10\f\x12\x1e\n" +
 	"\x1aGAME_RESULT_STATUS_ABORTED\x10\r\x12\"\n" +
-	"\x1eGAME_RESULT_STATUS_INTERRUPTED\x10\x0e*s\n" +
+	"\x1eGAME_RESULT_STATUS_INTERRUPTED\x10\x0e\x12$\n" +
+	" GAME_RESULT_STATUS_KING_EXPLODED\x10\x0f\x12'\n" +
+	"#GAME_RESULT_STATUS_KING_OF_THE_HILL\x10\x10\x12\"\n" +
+	"\x1eGAME_RESULT_STATUS_THREE_CHECK\x10\x11\x12*\n" +
+	"&GAME_RESULT_STATUS_ALL_PIECES_CAPTURED\x10\x12\x12$\n" +
+	" GAME_RESULT_STATUS_RACE_FINISHED\x10\x13\x12$\n" +
+	" GAME_RESULT_STATUS_DEAD_POSITION\x10\x14*s\n" +
 	"\tGameState\x12\x1a\n" +
 	"\x16GAME_STATE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11GAME_STATE_ACTIVE\x10\x01\x12\x17\n" +
@@ -4149,7 +4264,7 @@ func file_proto_juicer_juicer_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_juicer_juicer_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_proto_juicer_juicer_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_proto_juicer_juicer_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_proto_juicer_juicer_proto_goTypes = []any{
 	(Color)(0),                    // 0: pb.Color
 	(GameVariant)(0),              // 1: pb.GameVariant
@@ -4177,35 +4292,36 @@ var file_proto_juicer_juicer_proto_goTypes = []any{
 	(*Clocks)(nil),                // 23: pb.Clocks
 	(*SeekGame)(nil),              // 24: pb.SeekGame
 	(*CancelSeekGame)(nil),        // 25: pb.CancelSeekGame
-	(*PlayerInfo)(nil),            // 26: pb.PlayerInfo
-	(*GameMove)(nil),              // 27: pb.GameMove
-	(*GameFound)(nil),             // 28: pb.GameFound
-	(*GameInfo)(nil),              // 29: pb.GameInfo
-	(*AbortGame)(nil),             // 30: pb.AbortGame
-	(*ResignGame)(nil),            // 31: pb.ResignGame
-	(*OfferDraw)(nil),             // 32: pb.OfferDraw
-	(*DrawOffer)(nil),             // 33: pb.DrawOffer
-	(*DrawDeclined)(nil),          // 34: pb.DrawDeclined
-	(*DeclineDraw)(nil),           // 35: pb.DeclineDraw
-	(*AcceptDraw)(nil),            // 36: pb.AcceptDraw
-	(*SendLobbyChat)(nil),         // 37: pb.SendLobbyChat
-	(*ListLobbyChats)(nil),        // 38: pb.ListLobbyChats
-	(*LobbyChat)(nil),             // 39: pb.LobbyChat
-	(*LobbyChatList)(nil),         // 40: pb.LobbyChatList
-	(*SendGameChat)(nil),          // 41: pb.SendGameChat
-	(*ListGameChats)(nil),         // 42: pb.ListGameChats
-	(*ChatUserSnapshot)(nil),      // 43: pb.ChatUserSnapshot
-	(*GameChat)(nil),              // 44: pb.GameChat
-	(*GameChatList)(nil),          // 45: pb.GameChatList
-	(*PlayMoveUCI)(nil),           // 46: pb.PlayMoveUCI
-	(*MoveAck)(nil),               // 47: pb.MoveAck
-	(*MoveSync)(nil),              // 48: pb.MoveSync
-	(*GameFinished)(nil),          // 49: pb.GameFinished
-	(*PlayerLeft)(nil),            // 50: pb.PlayerLeft
-	(*PlayerRejoined)(nil),        // 51: pb.PlayerRejoined
-	nil,                           // 52: pb.GameInfo.PendingDrawOffersEntry
-	(*durationpb.Duration)(nil),   // 53: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil), // 54: google.protobuf.Timestamp
+	(*SeekBotGame)(nil),           // 26: pb.SeekBotGame
+	(*PlayerInfo)(nil),            // 27: pb.PlayerInfo
+	(*GameMove)(nil),              // 28: pb.GameMove
+	(*GameFound)(nil),             // 29: pb.GameFound
+	(*GameInfo)(nil),              // 30: pb.GameInfo
+	(*AbortGame)(nil),             // 31: pb.AbortGame
+	(*ResignGame)(nil),            // 32: pb.ResignGame
+	(*OfferDraw)(nil),             // 33: pb.OfferDraw
+	(*DrawOffer)(nil),             // 34: pb.DrawOffer
+	(*DrawDeclined)(nil),          // 35: pb.DrawDeclined
+	(*DeclineDraw)(nil),           // 36: pb.DeclineDraw
+	(*AcceptDraw)(nil),            // 37: pb.AcceptDraw
+	(*SendLobbyChat)(nil),         // 38: pb.SendLobbyChat
+	(*ListLobbyChats)(nil),        // 39: pb.ListLobbyChats
+	(*LobbyChat)(nil),             // 40: pb.LobbyChat
+	(*LobbyChatList)(nil),         // 41: pb.LobbyChatList
+	(*SendGameChat)(nil),          // 42: pb.SendGameChat
+	(*ListGameChats)(nil),         // 43: pb.ListGameChats
+	(*ChatUserSnapshot)(nil),      // 44: pb.ChatUserSnapshot
+	(*GameChat)(nil),              // 45: pb.GameChat
+	(*GameChatList)(nil),          // 46: pb.GameChatList
+	(*PlayMoveUCI)(nil),           // 47: pb.PlayMoveUCI
+	(*MoveAck)(nil),               // 48: pb.MoveAck
+	(*MoveSync)(nil),              // 49: pb.MoveSync
+	(*GameFinished)(nil),          // 50: pb.GameFinished
+	(*PlayerLeft)(nil),            // 51: pb.PlayerLeft
+	(*PlayerRejoined)(nil),        // 52: pb.PlayerRejoined
+	nil,                           // 53: pb.GameInfo.PendingDrawOffersEntry
+	(*durationpb.Duration)(nil),   // 54: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 55: google.protobuf.Timestamp
 }
 var file_proto_juicer_juicer_proto_depIdxs = []int32{
 	15, // 0: pb.Message.problem:type_name -> pb.Problem
@@ -4219,77 +4335,80 @@ var file_proto_juicer_juicer_proto_depIdxs = []int32{
 	19, // 8: pb.Message.initial_channels:type_name -> pb.InitialChannels
 	21, // 9: pb.Message.presence_state:type_name -> pb.PresenceState
 	22, // 10: pb.Message.presence_diff:type_name -> pb.PresenceDiff
-	37, // 11: pb.Message.send_lobby_chat:type_name -> pb.SendLobbyChat
-	38, // 12: pb.Message.list_lobby_chats:type_name -> pb.ListLobbyChats
-	39, // 13: pb.Message.lobby_chat:type_name -> pb.LobbyChat
-	40, // 14: pb.Message.lobby_chats:type_name -> pb.LobbyChatList
+	38, // 11: pb.Message.send_lobby_chat:type_name -> pb.SendLobbyChat
+	39, // 12: pb.Message.list_lobby_chats:type_name -> pb.ListLobbyChats
+	40, // 13: pb.Message.lobby_chat:type_name -> pb.LobbyChat
+	41, // 14: pb.Message.lobby_chats:type_name -> pb.LobbyChatList
 	24, // 15: pb.Message.seek_game:type_name -> pb.SeekGame
 	25, // 16: pb.Message.cancel_seek_game:type_name -> pb.CancelSeekGame
-	28, // 17: pb.Message.game_found:type_name -> pb.GameFound
-	30, // 18: pb.Message.abort_game:type_name -> pb.AbortGame
-	31, // 19: pb.Message.resign_game:type_name -> pb.ResignGame
-	32, // 20: pb.Message.offer_draw:type_name -> pb.OfferDraw
-	36, // 21: pb.Message.accept_draw:type_name -> pb.AcceptDraw
-	35, // 22: pb.Message.decline_draw:type_name -> pb.DeclineDraw
-	46, // 23: pb.Message.play_move_uci:type_name -> pb.PlayMoveUCI
-	48, // 24: pb.Message.move_sync:type_name -> pb.MoveSync
-	49, // 25: pb.Message.game_finished:type_name -> pb.GameFinished
-	41, // 26: pb.Message.send_game_chat:type_name -> pb.SendGameChat
-	42, // 27: pb.Message.list_game_chats:type_name -> pb.ListGameChats
-	44, // 28: pb.Message.game_chat:type_name -> pb.GameChat
-	45, // 29: pb.Message.game_chats:type_name -> pb.GameChatList
-	50, // 30: pb.Message.player_left:type_name -> pb.PlayerLeft
-	51, // 31: pb.Message.player_rejoined:type_name -> pb.PlayerRejoined
-	34, // 32: pb.Message.draw_declined:type_name -> pb.DrawDeclined
-	33, // 33: pb.Message.draw_offer:type_name -> pb.DrawOffer
-	47, // 34: pb.Message.move_ack:type_name -> pb.MoveAck
-	29, // 35: pb.Message.game_info:type_name -> pb.GameInfo
-	10, // 36: pb.Message.echo:type_name -> pb.Echo
-	20, // 37: pb.PresenceState.presences:type_name -> pb.Presence
-	20, // 38: pb.PresenceDiff.joined:type_name -> pb.Presence
-	20, // 39: pb.PresenceDiff.left:type_name -> pb.Presence
-	53, // 40: pb.Clocks.white:type_name -> google.protobuf.Duration
-	53, // 41: pb.Clocks.black:type_name -> google.protobuf.Duration
-	8,  // 42: pb.SeekGame.game_time_control:type_name -> pb.GameTimeControl
-	54, // 43: pb.GameMove.played_at:type_name -> google.protobuf.Timestamp
-	1,  // 44: pb.GameInfo.game_variant:type_name -> pb.GameVariant
-	2,  // 45: pb.GameInfo.game_time_kind:type_name -> pb.GameTimeKind
-	3,  // 46: pb.GameInfo.game_time_category:type_name -> pb.GameTimeCategory
-	6,  // 47: pb.GameInfo.game_state:type_name -> pb.GameState
-	8,  // 48: pb.GameInfo.game_time_control:type_name -> pb.GameTimeControl
-	0,  // 49: pb.GameInfo.color:type_name -> pb.Color
-	23, // 50: pb.GameInfo.clocks:type_name -> pb.Clocks
-	26, // 51: pb.GameInfo.white:type_name -> pb.PlayerInfo
-	26, // 52: pb.GameInfo.black:type_name -> pb.PlayerInfo
-	27, // 53: pb.GameInfo.game_moves:type_name -> pb.GameMove
-	54, // 54: pb.GameInfo.start_time:type_name -> google.protobuf.Timestamp
-	54, // 55: pb.GameInfo.end_time:type_name -> google.protobuf.Timestamp
-	4,  // 56: pb.GameInfo.game_result:type_name -> pb.GameResult
-	5,  // 57: pb.GameInfo.game_result_status:type_name -> pb.GameResultStatus
-	54, // 58: pb.GameInfo.last_move:type_name -> google.protobuf.Timestamp
-	52, // 59: pb.GameInfo.pending_draw_offers:type_name -> pb.GameInfo.PendingDrawOffersEntry
-	54, // 60: pb.GameInfo.white_disconnected_at:type_name -> google.protobuf.Timestamp
-	54, // 61: pb.GameInfo.black_disconnected_at:type_name -> google.protobuf.Timestamp
-	54, // 62: pb.DrawOffer.offered_at:type_name -> google.protobuf.Timestamp
-	43, // 63: pb.LobbyChat.user:type_name -> pb.ChatUserSnapshot
-	54, // 64: pb.LobbyChat.posted_at:type_name -> google.protobuf.Timestamp
-	39, // 65: pb.LobbyChatList.lobby_chats:type_name -> pb.LobbyChat
-	43, // 66: pb.GameChat.user:type_name -> pb.ChatUserSnapshot
-	54, // 67: pb.GameChat.posted_at:type_name -> google.protobuf.Timestamp
-	44, // 68: pb.GameChatList.game_chats:type_name -> pb.GameChat
-	23, // 69: pb.MoveSync.clocks:type_name -> pb.Clocks
-	54, // 70: pb.MoveSync.played_at:type_name -> google.protobuf.Timestamp
-	4,  // 71: pb.GameFinished.game_result:type_name -> pb.GameResult
-	5,  // 72: pb.GameFinished.game_result_status:type_name -> pb.GameResultStatus
-	6,  // 73: pb.GameFinished.game_state:type_name -> pb.GameState
-	54, // 74: pb.PlayerLeft.left_at:type_name -> google.protobuf.Timestamp
-	54, // 75: pb.PlayerRejoined.rejoined_at:type_name -> google.protobuf.Timestamp
-	33, // 76: pb.GameInfo.PendingDrawOffersEntry.value:type_name -> pb.DrawOffer
-	77, // [77:77] is the sub-list for method output_type
-	77, // [77:77] is the sub-list for method input_type
-	77, // [77:77] is the sub-list for extension type_name
-	77, // [77:77] is the sub-list for extension extendee
-	0,  // [0:77] is the sub-list for field type_name
+	29, // 17: pb.Message.game_found:type_name -> pb.GameFound
+	31, // 18: pb.Message.abort_game:type_name -> pb.AbortGame
+	32, // 19: pb.Message.resign_game:type_name -> pb.ResignGame
+	33, // 20: pb.Message.offer_draw:type_name -> pb.OfferDraw
+	37, // 21: pb.Message.accept_draw:type_name -> pb.AcceptDraw
+	36, // 22: pb.Message.decline_draw:type_name -> pb.DeclineDraw
+	47, // 23: pb.Message.play_move_uci:type_name -> pb.PlayMoveUCI
+	49, // 24: pb.Message.move_sync:type_name -> pb.MoveSync
+	50, // 25: pb.Message.game_finished:type_name -> pb.GameFinished
+	42, // 26: pb.Message.send_game_chat:type_name -> pb.SendGameChat
+	43, // 27: pb.Message.list_game_chats:type_name -> pb.ListGameChats
+	45, // 28: pb.Message.game_chat:type_name -> pb.GameChat
+	46, // 29: pb.Message.game_chats:type_name -> pb.GameChatList
+	26, // 30: pb.Message.seek_bot_game:type_name -> pb.SeekBotGame
+	51, // 31: pb.Message.player_left:type_name -> pb.PlayerLeft
+	52, // 32: pb.Message.player_rejoined:type_name -> pb.PlayerRejoined
+	35, // 33: pb.Message.draw_declined:type_name -> pb.DrawDeclined
+	34, // 34: pb.Message.draw_offer:type_name -> pb.DrawOffer
+	48, // 35: pb.Message.move_ack:type_name -> pb.MoveAck
+	30, // 36: pb.Message.game_info:type_name -> pb.GameInfo
+	10, // 37: pb.Message.echo:type_name -> pb.Echo
+	20, // 38: pb.PresenceState.presences:type_name -> pb.Presence
+	20, // 39: pb.PresenceDiff.joined:type_name -> pb.Presence
+	20, // 40: pb.PresenceDiff.left:type_name -> pb.Presence
+	54, // 41: pb.Clocks.white:type_name -> google.protobuf.Duration
+	54, // 42: pb.Clocks.black:type_name -> google.protobuf.Duration
+	8,  // 43: pb.SeekGame.game_time_control:type_name -> pb.GameTimeControl
+	8,  // 44: pb.SeekBotGame.game_time_control:type_name -> pb.GameTimeControl
+	7,  // 45: pb.SeekBotGame.side:type_name -> pb.GameSideChoice
+	55, // 46: pb.GameMove.played_at:type_name -> google.protobuf.Timestamp
+	1,  // 47: pb.GameInfo.game_variant:type_name -> pb.GameVariant
+	2,  // 48: pb.GameInfo.game_time_kind:type_name -> pb.GameTimeKind
+	3,  // 49: pb.GameInfo.game_time_category:type_name -> pb.GameTimeCategory
+	6,  // 50: pb.GameInfo.game_state:type_name -> pb.GameState
+	8,  // 51: pb.GameInfo.game_time_control:type_name -> pb.GameTimeControl
+	0,  // 52: pb.GameInfo.color:type_name -> pb.Color
+	23, // 53: pb.GameInfo.clocks:type_name -> pb.Clocks
+	27, // 54: pb.GameInfo.white:type_name -> pb.PlayerInfo
+	27, // 55: pb.GameInfo.black:type_name -> pb.PlayerInfo
+	28, // 56: pb.GameInfo.game_moves:type_name -> pb.GameMove
+	55, // 57: pb.GameInfo.start_time:type_name -> google.protobuf.Timestamp
+	55, // 58: pb.GameInfo.end_time:type_name -> google.protobuf.Timestamp
+	4,  // 59: pb.GameInfo.game_result:type_name -> pb.GameResult
+	5,  // 60: pb.GameInfo.game_result_status:type_name -> pb.GameResultStatus
+	55, // 61: pb.GameInfo.last_move:type_name -> google.protobuf.Timestamp
+	53, // 62: pb.GameInfo.pending_draw_offers:type_name -> pb.GameInfo.PendingDrawOffersEntry
+	55, // 63: pb.GameInfo.white_disconnected_at:type_name -> google.protobuf.Timestamp
+	55, // 64: pb.GameInfo.black_disconnected_at:type_name -> google.protobuf.Timestamp
+	55, // 65: pb.DrawOffer.offered_at:type_name -> google.protobuf.Timestamp
+	44, // 66: pb.LobbyChat.user:type_name -> pb.ChatUserSnapshot
+	55, // 67: pb.LobbyChat.posted_at:type_name -> google.protobuf.Timestamp
+	40, // 68: pb.LobbyChatList.lobby_chats:type_name -> pb.LobbyChat
+	44, // 69: pb.GameChat.user:type_name -> pb.ChatUserSnapshot
+	55, // 70: pb.GameChat.posted_at:type_name -> google.protobuf.Timestamp
+	45, // 71: pb.GameChatList.game_chats:type_name -> pb.GameChat
+	23, // 72: pb.MoveSync.clocks:type_name -> pb.Clocks
+	55, // 73: pb.MoveSync.played_at:type_name -> google.protobuf.Timestamp
+	4,  // 74: pb.GameFinished.game_result:type_name -> pb.GameResult
+	5,  // 75: pb.GameFinished.game_result_status:type_name -> pb.GameResultStatus
+	6,  // 76: pb.GameFinished.game_state:type_name -> pb.GameState
+	55, // 77: pb.PlayerLeft.left_at:type_name -> google.protobuf.Timestamp
+	55, // 78: pb.PlayerRejoined.rejoined_at:type_name -> google.protobuf.Timestamp
+	34, // 79: pb.GameInfo.PendingDrawOffersEntry.value:type_name -> pb.DrawOffer
+	80, // [80:80] is the sub-list for method output_type
+	80, // [80:80] is the sub-list for method input_type
+	80, // [80:80] is the sub-list for extension type_name
+	80, // [80:80] is the sub-list for extension extendee
+	0,  // [0:80] is the sub-list for field type_name
 }
 
 func init() { file_proto_juicer_juicer_proto_init() }
@@ -4328,6 +4447,7 @@ func file_proto_juicer_juicer_proto_init() {
 		(*Message_ListGameChats)(nil),
 		(*Message_GameChat)(nil),
 		(*Message_GameChats)(nil),
+		(*Message_SeekBotGame)(nil),
 		(*Message_PlayerLeft)(nil),
 		(*Message_PlayerRejoined)(nil),
 		(*Message_DrawDeclined)(nil),
@@ -4336,17 +4456,17 @@ func file_proto_juicer_juicer_proto_init() {
 		(*Message_GameInfo)(nil),
 		(*Message_Echo)(nil),
 	}
-	file_proto_juicer_juicer_proto_msgTypes[19].OneofWrappers = []any{}
-	file_proto_juicer_juicer_proto_msgTypes[21].OneofWrappers = []any{}
-	file_proto_juicer_juicer_proto_msgTypes[30].OneofWrappers = []any{}
-	file_proto_juicer_juicer_proto_msgTypes[34].OneofWrappers = []any{}
+	file_proto_juicer_juicer_proto_msgTypes[20].OneofWrappers = []any{}
+	file_proto_juicer_juicer_proto_msgTypes[22].OneofWrappers = []any{}
+	file_proto_juicer_juicer_proto_msgTypes[31].OneofWrappers = []any{}
+	file_proto_juicer_juicer_proto_msgTypes[35].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_juicer_juicer_proto_rawDesc), len(file_proto_juicer_juicer_proto_rawDesc)),
 			NumEnums:      8,
-			NumMessages:   45,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
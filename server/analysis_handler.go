@@ -0,0 +1,23 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	api "github.com/dankobg/juicer/api/gen"
+	"github.com/dankobg/juicer/engine"
+)
+
+func (a *ApiHandler) GetAnalysisEval(ctx context.Context, request api.GetAnalysisEvalRequestObject) (api.GetAnalysisEvalResponseObject, error) {
+	p := &engine.Position{}
+	if err := p.LoadFromFEN(request.Params.Fen); err != nil {
+		return api.GetAnalysisEval400JSONResponse{GenericErrorResponseJSONResponse: newGenericResp(http.StatusBadRequest, "invalid_fen", "invalid fen", err.Error())}, nil
+	}
+
+	resp := api.GetAnalysisEval200JSONResponse{
+		Fen:     p.Fen(),
+		ScoreCp: int32(engine.Evaluate(p)),
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	api "github.com/dankobg/juicer/api/gen"
+	"github.com/dankobg/juicer/features/game"
+)
+
+func (a *ApiHandler) GetGamePositionSvg(ctx context.Context, request api.GetGamePositionSvgRequestObject) (api.GetGamePositionSvgResponseObject, error) {
+	svg, err := a.game.RenderGamePosition(ctx, request)
+	if err != nil {
+		if errors.Is(err, game.ErrGameNotFound) {
+			return api.GetGamePositionSvg404JSONResponse{NotFoundErrorResponseJSONResponse: newNotFoundResp("game_not_found", "game not found")}, nil
+		}
+		if errors.Is(err, game.ErrGamePlyNotFound) {
+			return api.GetGamePositionSvg400JSONResponse{GenericErrorResponseJSONResponse: newGenericResp(http.StatusBadRequest, "invalid_ply", "invalid ply", err.Error())}, nil
+		}
+		return nil, fmt.Errorf("failed to render game position: %w", err)
+	}
+
+	resp := api.GetGamePositionSvg200ImageSvgXMLResponse{
+		Body:          bytes.NewReader(svg),
+		ContentLength: int64(len(svg)),
+	}
+
+	return resp, nil
+}
+
+func (a *ApiHandler) GetGameAnimationGif(ctx context.Context, request api.GetGameAnimationGifRequestObject) (api.GetGameAnimationGifResponseObject, error) {
+	anim, err := a.game.RenderGameAnimation(ctx, request)
+	if err != nil {
+		if errors.Is(err, game.ErrGameNotFound) {
+			return api.GetGameAnimationGif404JSONResponse{NotFoundErrorResponseJSONResponse: newNotFoundResp("game_not_found", "game not found")}, nil
+		}
+		if errors.Is(err, game.ErrGamePlyNotFound) {
+			return api.GetGameAnimationGif400JSONResponse{GenericErrorResponseJSONResponse: newGenericResp(http.StatusBadRequest, "invalid_ply", "invalid ply", err.Error())}, nil
+		}
+		return nil, fmt.Errorf("failed to render game animation: %w", err)
+	}
+
+	resp := api.GetGameAnimationGif200ImageGifResponse{
+		Body:          bytes.NewReader(anim),
+		ContentLength: int64(len(anim)),
+	}
+
+	return resp, nil
+}
@@ -5,6 +5,7 @@ import (
 	"html/template"
 	"net/http"
 	"net/http/pprof"
+	"strings"
 
 	api "github.com/dankobg/juicer/api/gen"
 	"github.com/dankobg/juicer/api/validators"
@@ -100,7 +101,24 @@ func (a *ApiHandler) SetupRoutes(env, uploadDir string) http.Handler {
 	oapiMux := http.NewServeMux()
 	apiSrv := api.NewStrictHandler(a, make([]api.StrictMiddlewareFunc, 0))
 	oapiHandler := api.HandlerFromMuxWithBaseURL(apiSrv, oapiMux, "/api/v1")
-	mux.Handle("/api/v1/", oapiMiddleware(oapiHandler))
+	mux.Handle("/api/v1/", gameGIFAlias(oapiMiddleware(oapiHandler)))
 
 	return middlewareChain(mux)
 }
+
+// gameGIFAlias serves `/api/v1/games/{id}.gif` as `/api/v1/games/{id}/animation.gif`, the mux wildcard must be
+// the whole path segment so the extension can't follow it in the spec route
+func gameGIFAlias(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rest, isGame := strings.CutPrefix(r.URL.Path, "/api/v1/games/")
+		id, isGIF := strings.CutSuffix(rest, ".gif")
+
+		if isGame && isGIF && id != "" && !strings.Contains(id, "/") {
+			r = r.Clone(r.Context())
+			r.URL.Path = "/api/v1/games/" + id + "/animation.gif"
+			r.URL.RawPath = ""
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
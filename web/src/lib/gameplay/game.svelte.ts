@@ -543,6 +543,24 @@ function formatGameConcludedMsg(gameResult: GameResult, gameResultStatus: GameRe
 		case GameResultStatus.INTERRUPTED:
 			msg += ', game was interrupted before it could finish';
 			break;
+		case GameResultStatus.KING_EXPLODED:
+			msg += ' by exploding the king';
+			break;
+		case GameResultStatus.KING_OF_THE_HILL:
+			msg += ' by reaching the center';
+			break;
+		case GameResultStatus.THREE_CHECK:
+			msg += ' by three checks';
+			break;
+		case GameResultStatus.ALL_PIECES_CAPTURED:
+			msg += ' by capturing all pieces';
+			break;
+		case GameResultStatus.RACE_FINISHED:
+			msg += ' by reaching the 8th rank';
+			break;
+		case GameResultStatus.DEAD_POSITION:
+			msg += ' by dead position';
+			break;
 		default:
 			break;
 	}
@@ -1,4 +1,4 @@
-import { MessageSchema, type LobbyChat, type LobbyChatList } from '$lib/gen/juicer_pb';
+import { GameSideChoice, MessageSchema, type LobbyChat, type LobbyChatList } from '$lib/gen/juicer_pb';
 import { ws } from '$lib/ws/juicer-ws.svelte';
 import { create } from '@bufbuild/protobuf';
 import { chatManager, LOBBY_CHAT_CHANNEL, type ChatMessage } from './chat-manager.svelte';
@@ -33,6 +33,20 @@ class LobbyManager {
 		this.seekingGameTimeControl = null;
 	}
 
+	seekBotGame(clockMs: number, incrementMs: number, botLevel: number, side = GameSideChoice.RANDOM): void {
+		const seekBotGameMsg = create(MessageSchema, {
+			event: {
+				case: 'seekBotGame',
+				value: {
+					gameTimeControl: { clockMs, incrementMs },
+					botLevel,
+					side
+				}
+			}
+		});
+		ws.send(seekBotGameMsg);
+	}
+
 	sendLobbyChat(message: string): void {
 		const sendLobbyChatMsg = create(MessageSchema, {
 			event: { case: 'sendLobbyChat', value: { message } }
@@ -12,7 +12,7 @@ import type { Message as Message$1 } from "@bufbuild/protobuf";
  * Describes the file juicer.proto.
  */
 export const file_juicer: GenFile = /*@__PURE__*/
-  fileDesc("CgxqdWljZXIucHJvdG8SAnBiIjkKD0dhbWVUaW1lQ29udHJvbBIQCghjbG9ja19tcxgBIAEoBRIUCgxpbmNyZW1lbnRfbXMYAiABKAUi7QsKB01lc3NhZ2USHgoHcHJvYmxlbRgBIAEoCzILLnBiLlByb2JsZW1IABIeCgdsYXRlbmN5GAIgASgLMgsucGIuTGF0ZW5jeUgAEiIKCWhlYXJ0YmVhdBgDIAEoCzINLnBiLkhlYXJ0YmVhdEgAEiEKCWxlYXZlX3RhYhgEIAEoCzIMLnBiLkxlYXZlVGFiSAASIwoKbGVhdmVfc2l0ZRgFIAEoCzINLnBiLkxlYXZlU2l0ZUgAEi8KEGNsaWVudF9jb25uZWN0ZWQYBiABKAsyEy5wYi5DbGllbnRDb25uZWN0ZWRIABI1ChNjbGllbnRfZGlzY29ubmVjdGVkGAcgASgLMhYucGIuQ2xpZW50RGlzY29ubmVjdGVkSAASNQoTaW5pdGlhbGl6ZV9jaGFubmVscxgIIAEoCzIWLnBiLkluaXRpYWxpemVDaGFubmVsc0gAEi8KEGluaXRpYWxfY2hhbm5lbHMYCSABKAsyEy5wYi5Jbml0aWFsQ2hhbm5lbHNIABIrCg5wcmVzZW5jZV9zdGF0ZRgKIAEoCzIRLnBiLlByZXNlbmNlU3RhdGVIABIpCg1wcmVzZW5jZV9kaWZmGAsgASgLMhAucGIuUHJlc2VuY2VEaWZmSAASLAoPc2VuZF9sb2JieV9jaGF0GAwgASgLMhEucGIuU2VuZExvYmJ5Q2hhdEgAEi4KEGxpc3RfbG9iYnlfY2hhdHMYDiABKAsyEi5wYi5MaXN0TG9iYnlDaGF0c0gAEiMKCmxvYmJ5X2NoYXQYDSABKAsyDS5wYi5Mb2JieUNoYXRIABIoCgtsb2JieV9jaGF0cxgPIAEoCzIRLnBiLkxvYmJ5Q2hhdExpc3RIABIhCglzZWVrX2dhbWUYECABKAsyDC5wYi5TZWVrR2FtZUgAEi4KEGNhbmNlbF9zZWVrX2dhbWUYESABKAsyEi5wYi5DYW5jZWxTZWVrR2FtZUgAEiMKCmdhbWVfZm91bmQYEiABKAsyDS5wYi5HYW1lRm91bmRIABIjCgphYm9ydF9nYW1lGBMgASgLMg0ucGIuQWJvcnRHYW1lSAASJQoLcmVzaWduX2dhbWUYFCABKAsyDi5wYi5SZXNpZ25HYW1lSAASIwoKb2ZmZXJfZHJhdxgVIAEoCzINLnBiLk9mZmVyRHJhd0gAEiUKC2FjY2VwdF9kcmF3GBYgASgLMg4ucGIuQWNjZXB0RHJhd0gAEicKDGRlY2xpbmVfZHJhdxgXIAEoCzIPLnBiLkRlY2xpbmVEcmF3SAASKAoNcGxheV9tb3ZlX3VjaRgYIAEoCzIPLnBiLlBsYXlNb3ZlVUNJSAASIQoJbW92ZV9zeW5jGBkgASgLMgwucGIuTW92ZVN5bmNIABIpCg1nYW1lX2ZpbmlzaGVkGBogASgLMhAucGIuR2FtZUZpbmlzaGVkSAASKgoOc2VuZF9nYW1lX2NoYXQYGyABKAsyEC5wYi5TZW5kR2FtZUNoYXRIABIsCg9saXN0X2dhbWVfY2hhdHMYHCABKAsyES5wYi5MaXN0R2FtZUNoYXRzSAASIQoJZ2FtZV9jaGF0GB0gASgLMgwucGIuR2FtZUNoYXRIABImCgpnYW1lX2NoYXRzGB4gASgLMhAucGIuR2FtZUNoYXRMaXN0SAASJQoLcGxheWVyX2xlZnQYPiABKAsyDi5wYi5QbGF5ZXJMZWZ0SAASLQoPcGxheWVyX3Jlam9pbmVkGD8gASgLMhIucGIuUGxheWVyUmVqb2luZWRIABIpCg1kcmF3X2RlY2xpbmVkGEAgASgLMhAucGIuRHJhd0RlY2xpbmVkSAASIwoKZHJhd19vZmZlchhBIAEoCzINLnBiLkRyYXdPZmZlckgAEh8KCG1vdmVfYWNrGEIgASgLMgsucGIuTW92ZUFja0gAEiEKCWdhbWVfaW5mbxhDIAEoCzIMLnBiLkdhbWVJbmZvSAASGAoEZWNobxhFIAEoCzIILnBiLkVjaG9IAEIHCgVldmVudCIXCgRFY2hvEg8KB21lc3NhZ2UYASABKAkiPAoJSGVhcnRiZWF0Eg8KB3VzZXJfaWQYASABKAkSDwoHY29ubl9pZBgCIAEoCRINCgVndWVzdBgDIAEoCCI7CghMZWF2ZVRhYhIPCgd1c2VyX2lkGAEgASgJEg8KB2Nvbm5faWQYAiABKAkSDQoFZ3Vlc3QYAyABKAgiPAoJTGVhdmVTaXRlEg8KB3VzZXJfaWQYASABKAkSDwoHY29ubl9pZBgCIAEoCRINCgVndWVzdBgDIAEoCCIdCgdMYXRlbmN5EhIKCmxhdGVuY3lfbXMYASABKAUiGgoHUHJvYmxlbRIPCgdtZXNzYWdlGAEgASgJIlQKD0NsaWVudENvbm5lY3RlZBIPCgd1c2VyX2lkGAEgASgJEg8KB2Nvbm5faWQYAiABKAkSDQoFZ3Vlc3QYAyABKAgSEAoIY2hhbm5lbHMYBCADKAkiRQoSQ2xpZW50RGlzY29ubmVjdGVkEg8KB3VzZXJfaWQYASABKAkSDwoHY29ubl9pZBgCIAEoCRINCgVndWVzdBgDIAEoCCJTChJJbml0aWFsaXplQ2hhbm5lbHMSDwoHdXNlcl9pZBgBIAEoCRIPCgdjb25uX2lkGAIgASgJEg0KBWd1ZXN0GAMgASgIEgwKBHBhdGgYBCABKAkiIwoPSW5pdGlhbENoYW5uZWxzEhAKCGNoYW5uZWxzGAEgAygJIk0KCFByZXNlbmNlEg8KB3VzZXJfaWQYASABKAkSEAoIdXNlcm5hbWUYAiABKAkSDQoFZ3Vlc3QYAyABKAgSDwoHY2hhbm5lbBgEIAEoCSIwCg1QcmVzZW5jZVN0YXRlEh8KCXByZXNlbmNlcxgBIAMoCzIMLnBiLlByZXNlbmNlIkgKDFByZXNlbmNlRGlmZhIcCgZqb2luZWQYASADKAsyDC5wYi5QcmVzZW5jZRIaCgRsZWZ0GAIgAygLMgwucGIuUHJlc2VuY2UiXAoGQ2xvY2tzEigKBXdoaXRlGAEgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEigKBWJsYWNrGAIgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uIjoKCFNlZWtHYW1lEi4KEWdhbWVfdGltZV9jb250cm9sGAEgASgLMhMucGIuR2FtZVRpbWVDb250cm9sIhAKDkNhbmNlbFNlZWtHYW1lImIKClBsYXllckluZm8SDwoHdXNlcl9pZBgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCRINCgVndWVzdBgDIAEoCBISCgphdmF0YXJfdXJsGAQgASgJEg4KBnJhdGluZxgFIAEoBSKnAQoIR2FtZU1vdmUSCwoDZmVuGAEgASgJEhAKA3VjaRgCIAEoCUgAiAEBEhAKA3NhbhgDIAEoCUgBiAEBEhAKA2xhbhgEIAEoCUgCiAEBEjIKCXBsYXllZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIA4gBAUIGCgRfdWNpQgYKBF9zYW5CBgoEX2xhbkIMCgpfcGxheWVkX2F0IhwKCUdhbWVGb3VuZBIPCgdnYW1lX2lkGAEgASgFIskICghHYW1lSW5mbxIPCgdnYW1lX2lkGAEgASgFEiUKDGdhbWVfdmFyaWFudBgCIAEoDjIPLnBiLkdhbWVWYXJpYW50EigKDmdhbWVfdGltZV9raW5kGAMgASgOMhAucGIuR2FtZVRpbWVLaW5kEjAKEmdhbWVfdGltZV9jYXRlZ29yeRgEIAEoDjIULnBiLkdhbWVUaW1lQ2F0ZWdvcnkSIQoKZ2FtZV9zdGF0ZRgFIAEoDjINLnBiLkdhbWVTdGF0ZRIuChFnYW1lX3RpbWVfY29udHJvbBgGIAEoCzITLnBiLkdhbWVUaW1lQ29udHJvbBIYCgVjb2xvchgHIAEoDjIJLnBiLkNvbG9yEgsKA2ZlbhgIIAEoCRILCgNwbHkYCSABKA0SGgoGY2xvY2tzGAogASgLMgoucGIuQ2xvY2tzEg0KBXJhdGVkGAsgASgIEhMKC2xlZ2FsX21vdmVzGAwgAygJEh0KBXdoaXRlGA0gASgLMg4ucGIuUGxheWVySW5mbxIdCgVibGFjaxgOIAEoCzIOLnBiLlBsYXllckluZm8SHAoUcmVjb25uZWN0X3RpbWVvdXRfbXMYDyABKAUSHQoVZmlyc3RfbW92ZV90aW1lb3V0X21zGBAgASgFEiAKCmdhbWVfbW92ZXMYESADKAsyDC5wYi5HYW1lTW92ZRIuCgpzdGFydF90aW1lGBIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgTIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASIwoLZ2FtZV9yZXN1bHQYFCABKA4yDi5wYi5HYW1lUmVzdWx0EjAKEmdhbWVfcmVzdWx0X3N0YXR1cxgVIAEoDjIULnBiLkdhbWVSZXN1bHRTdGF0dXMSDwoHdmVyc2lvbhgWIAEoBRIyCglsYXN0X21vdmUYFyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESQAoTcGVuZGluZ19kcmF3X29mZmVycxgYIAMoCzIjLnBiLkdhbWVJbmZvLlBlbmRpbmdEcmF3T2ZmZXJzRW50cnkSPgoVd2hpdGVfZGlzY29ubmVjdGVkX2F0GBkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBEj4KFWJsYWNrX2Rpc2Nvbm5lY3RlZF9hdBgaIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAogBARpHChZQZW5kaW5nRHJhd09mZmVyc0VudHJ5EgsKA2tleRgBIAEoCRIcCgV2YWx1ZRgCIAEoCzINLnBiLkRyYXdPZmZlcjoCOAFCDAoKX2xhc3RfbW92ZUIYChZfd2hpdGVfZGlzY29ubmVjdGVkX2F0QhgKFl9ibGFja19kaXNjb25uZWN0ZWRfYXQiHAoJQWJvcnRHYW1lEg8KB2dhbWVfaWQYASABKAUiHQoKUmVzaWduR2FtZRIPCgdnYW1lX2lkGAEgASgFIhwKCU9mZmVyRHJhdxIPCgdnYW1lX2lkGAEgASgFIm0KCURyYXdPZmZlchIPCgdnYW1lX2lkGAEgASgFEgsKA3BseRgCIAEoDRISCgpvZmZlcmVkX2J5GAMgASgJEi4KCm9mZmVyZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjQKDERyYXdEZWNsaW5lZBIPCgdnYW1lX2lkGAEgASgFEhMKC2RlY2xpbmVkX2J5GAMgASgJIh4KC0RlY2xpbmVEcmF3Eg8KB2dhbWVfaWQYASABKAUiHQoKQWNjZXB0RHJhdxIPCgdnYW1lX2lkGAEgASgFIiAKDVNlbmRMb2JieUNoYXQSDwoHbWVzc2FnZRgBIAEoCSJWCg5MaXN0TG9iYnlDaGF0cxITCgZjdXJzb3IYASABKAlIAIgBARIWCglwYWdlX3NpemUYAiABKAVIAYgBAUIJCgdfY3Vyc29yQgwKCl9wYWdlX3NpemUigwEKCUxvYmJ5Q2hhdBISCgptZXNzYWdlX2lkGAEgASgJEg8KB21lc3NhZ2UYAiABKAkSIgoEdXNlchgDIAEoCzIULnBiLkNoYXRVc2VyU25hcHNob3QSLQoJcG9zdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJFCg1Mb2JieUNoYXRMaXN0EiIKC2xvYmJ5X2NoYXRzGAEgAygLMg0ucGIuTG9iYnlDaGF0EhAKCGhhc19tb3JlGAIgASgIIjAKDFNlbmRHYW1lQ2hhdBIPCgdnYW1lX2lkGAEgASgFEg8KB21lc3NhZ2UYAiABKAkiZgoNTGlzdEdhbWVDaGF0cxIPCgdnYW1lX2lkGAEgASgFEhMKBmN1cnNvchgCIAEoCUgAiAEBEhYKCXBhZ2Vfc2l6ZRgDIAEoBUgBiAEBQgkKB19jdXJzb3JCDAoKX3BhZ2Vfc2l6ZSIwChBDaGF0VXNlclNuYXBzaG90EgoKAmlkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJIpMBCghHYW1lQ2hhdBIPCgdnYW1lX2lkGAEgASgFEhIKCm1lc3NhZ2VfaWQYAiABKAkSDwoHbWVzc2FnZRgDIAEoCRIiCgR1c2VyGAQgASgLMhQucGIuQ2hhdFVzZXJTbmFwc2hvdBItCglwb3N0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIlMKDEdhbWVDaGF0TGlzdBIPCgdnYW1lX2lkGAEgASgFEiAKCmdhbWVfY2hhdHMYAiADKAsyDC5wYi5HYW1lQ2hhdBIQCghoYXNfbW9yZRgDIAEoCCI4CgtQbGF5TW92ZVVDSRIPCgdnYW1lX2lkGAEgASgFEgsKA3VjaRgCIAEoCRILCgNhY2sYAyABKAUiKwoHTW92ZUFjaxIPCgdnYW1lX2lkGAEgASgFEg8KB3ZlcnNpb24YAiABKAUizQEKCE1vdmVTeW5jEg8KB2dhbWVfaWQYASABKAUSCwoDdWNpGAIgASgJEgsKA3NhbhgDIAEoCRILCgNsYW4YBCABKAkSCwoDZmVuGAUgASgJEgsKA3BseRgGIAEoDRIaCgZjbG9ja3MYByABKAsyCi5wYi5DbG9ja3MSEwoLbGVnYWxfbW92ZXMYCCADKAkSDwoHdmVyc2lvbhgJIAEoBRItCglwbGF5ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIpkBCgxHYW1lRmluaXNoZWQSDwoHZ2FtZV9pZBgBIAEoBRIjCgtnYW1lX3Jlc3VsdBgCIAEoDjIOLnBiLkdhbWVSZXN1bHQSMAoSZ2FtZV9yZXN1bHRfc3RhdHVzGAMgASgOMhQucGIuR2FtZVJlc3VsdFN0YXR1cxIhCgpnYW1lX3N0YXRlGAQgASgOMg0ucGIuR2FtZVN0YXRlIlsKClBsYXllckxlZnQSDwoHZ2FtZV9pZBgBIAEoBRIPCgd1c2VyX2lkGAIgASgJEisKB2xlZnRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wImMKDlBsYXllclJlam9pbmVkEg8KB2dhbWVfaWQYASABKAUSDwoHdXNlcl9pZBgCIAEoCRIvCgtyZWpvaW5lZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAqQAoFQ29sb3ISFQoRQ09MT1JfVU5TUEVDSUZJRUQQABIPCgtDT0xPUl9XSElURRABEg8KC0NPTE9SX0JMQUNLEAIqjwIKC0dhbWVWYXJpYW50EhwKGEdBTUVfVkFSSUFOVF9VTlNQRUNJRklFRBAAEhkKFUdBTUVfVkFSSUFOVF9TVEFOREFSRBABEhcKE0dBTUVfVkFSSUFOVF9BVE9NSUMQAhIbChdHQU1FX1ZBUklBTlRfQ1JBWllIT1VTRRADEhkKFUdBTUVfVkFSSUFOVF9DSEVTUzk2MBAEEiEKHUdBTUVfVkFSSUFOVF9LSU5HX09GX1RIRV9ISUxMEAUSHAoYR0FNRV9WQVJJQU5UX1RIUkVFX0NIRUNLEAYSFgoSR0FNRV9WQVJJQU5UX0hPUkRFEAcSHQoZR0FNRV9WQVJJQU5UX1JBQ0lOR19LSU5HUxAIKowBCgxHYW1lVGltZUtpbmQSHgoaR0FNRV9USU1FX0tJTkRfVU5TUEVDSUZJRUQQABIbChdHQU1FX1RJTUVfS0lORF9SRUFMVElNRRABEiEKHUdBTUVfVElNRV9LSU5EX0NPUlJFU1BPTkRFTkNFEAISHAoYR0FNRV9USU1FX0tJTkRfVU5MSU1JVEVEEAMq1wEKEEdhbWVUaW1lQ2F0ZWdvcnkSIgoeR0FNRV9USU1FX0NBVEVHT1JZX1VOU1BFQ0lGSUVEEAASIgoeR0FNRV9USU1FX0NBVEVHT1JZX0hZUEVSQlVMTEVUEAESHQoZR0FNRV9USU1FX0NBVEVHT1JZX0JVTExFVBACEhwKGEdBTUVfVElNRV9DQVRFR09SWV9CTElUWhADEhwKGEdBTUVfVElNRV9DQVRFR09SWV9SQVBJRBAEEiAKHEdBTUVfVElNRV9DQVRFR09SWV9DTEFTU0lDQUwQBSqSAQoKR2FtZVJlc3VsdBIbChdHQU1FX1JFU1VMVF9VTlNQRUNJRklFRBAAEhkKFUdBTUVfUkVTVUxUX1dISVRFX1dPThABEhkKFUdBTUVfUkVTVUxUX0JMQUNLX1dPThACEhQKEEdBTUVfUkVTVUxUX0RSQVcQAxIbChdHQU1FX1JFU1VMVF9JTlRFUlJVUFRFRBAEKsoEChBHYW1lUmVzdWx0U3RhdHVzEiIKHkdBTUVfUkVTVUxUX1NUQVRVU19VTlNQRUNJRklFRBAAEiAKHEdBTUVfUkVTVUxUX1NUQVRVU19DSEVDS01BVEUQARIsCihHQU1FX1JFU1VMVF9TVEFUVVNfSU5TVUZGSUNJRU5UX01BVEVSSUFMEAISKwonR0FNRV9SRVNVTFRfU1RBVFVTX1RIUkVFRk9MRF9SRVBFVElUSU9OEAMSKgomR0FNRV9SRVNVTFRfU1RBVFVTX0ZJVkVGT0xEX1JFUEVUSVRJT04QBBImCiJHQU1FX1JFU1VMVF9TVEFUVVNfRklGVFlfTU9WRV9SVUxFEAUSLAooR0FNRV9SRVNVTFRfU1RBVFVTX1NFVkVOVFlGSVZFX01PVkVfUlVMRRAGEiAKHEdBTUVfUkVTVUxUX1NUQVRVU19TVEFMRU1BVEUQBxIiCh5HQU1FX1JFU1VMVF9TVEFUVVNfUkVTSUdOQVRJT04QCBIiCh5HQU1FX1JFU1VMVF9TVEFUVVNfRFJBV19BR1JFRUQQCRIeChpHQU1FX1JFU1VMVF9TVEFUVVNfRkxBR0dFRBAKEiMKH0dBTUVfUkVTVUxUX1NUQVRVU19BREpVRElDQVRJT04QCxIgChxHQU1FX1JFU1VMVF9TVEFUVVNfVElNRURfT1VUEAwSHgoaR0FNRV9SRVNVTFRfU1RBVFVTX0FCT1JURUQQDRIiCh5HQU1FX1JFU1VMVF9TVEFUVVNfSU5URVJSVVBURUQQDipzCglHYW1lU3RhdGUSGgoWR0FNRV9TVEFURV9VTlNQRUNJRklFRBAAEhUKEUdBTUVfU1RBVEVfQUNUSVZFEAESFwoTR0FNRV9TVEFURV9GSU5JU0hFRBACEhoKFkdBTUVfU1RBVEVfSU5URVJSVVBURUQQAyqHAQoOR2FtZVNpZGVDaG9pY2USIAocR0FNRV9TSURFX0NIT0lDRV9VTlNQRUNJRklFRBAAEhsKF0dBTUVfU0lERV9DSE9JQ0VfUkFORE9NEAESGgoWR0FNRV9TSURFX0NIT0lDRV9XSElURRACEhoKFkdBTUVfU0lERV9DSE9JQ0VfQkxBQ0sQA0IeWhxnaXRodWIuY29tL2RhbmtvYmcvanVpY2VyL3BiYgZwcm90bzM", [file_google_protobuf_duration, file_google_protobuf_timestamp]);
+  fileDesc("CgxqdWljZXIucHJvdG8SAnBiIjkKD0dhbWVUaW1lQ29udHJvbBIQCghjbG9ja19tcxgBIAEoBRIUCgxpbmNyZW1lbnRfbXMYAiABKAUilwwKB01lc3NhZ2USHgoHcHJvYmxlbRgBIAEoCzILLnBiLlByb2JsZW1IABIeCgdsYXRlbmN5GAIgASgLMgsucGIuTGF0ZW5jeUgAEiIKCWhlYXJ0YmVhdBgDIAEoCzINLnBiLkhlYXJ0YmVhdEgAEiEKCWxlYXZlX3RhYhgEIAEoCzIMLnBiLkxlYXZlVGFiSAASIwoKbGVhdmVfc2l0ZRgFIAEoCzINLnBiLkxlYXZlU2l0ZUgAEi8KEGNsaWVudF9jb25uZWN0ZWQYBiABKAsyEy5wYi5DbGllbnRDb25uZWN0ZWRIABI1ChNjbGllbnRfZGlzY29ubmVjdGVkGAcgASgLMhYucGIuQ2xpZW50RGlzY29ubmVjdGVkSAASNQoTaW5pdGlhbGl6ZV9jaGFubmVscxgIIAEoCzIWLnBiLkluaXRpYWxpemVDaGFubmVsc0gAEi8KEGluaXRpYWxfY2hhbm5lbHMYCSABKAsyEy5wYi5Jbml0aWFsQ2hhbm5lbHNIABIrCg5wcmVzZW5jZV9zdGF0ZRgKIAEoCzIRLnBiLlByZXNlbmNlU3RhdGVIABIpCg1wcmVzZW5jZV9kaWZmGAsgASgLMhAucGIuUHJlc2VuY2VEaWZmSAASLAoPc2VuZF9sb2JieV9jaGF0GAwgASgLMhEucGIuU2VuZExvYmJ5Q2hhdEgAEi4KEGxpc3RfbG9iYnlfY2hhdHMYDiABKAsyEi5wYi5MaXN0TG9iYnlDaGF0c0gAEiMKCmxvYmJ5X2NoYXQYDSABKAsyDS5wYi5Mb2JieUNoYXRIABIoCgtsb2JieV9jaGF0cxgPIAEoCzIRLnBiLkxvYmJ5Q2hhdExpc3RIABIhCglzZWVrX2dhbWUYECABKAsyDC5wYi5TZWVrR2FtZUgAEi4KEGNhbmNlbF9zZWVrX2dhbWUYESABKAsyEi5wYi5DYW5jZWxTZWVrR2FtZUgAEiMKCmdhbWVfZm91bmQYEiABKAsyDS5wYi5HYW1lRm91bmRIABIjCgphYm9ydF9nYW1lGBMgASgLMg0ucGIuQWJvcnRHYW1lSAASJQoLcmVzaWduX2dhbWUYFCABKAsyDi5wYi5SZXNpZ25HYW1lSAASIwoKb2ZmZXJfZHJhdxgVIAEoCzINLnBiLk9mZmVyRHJhd0gAEiUKC2FjY2VwdF9kcmF3GBYgASgLMg4ucGIuQWNjZXB0RHJhd0gAEicKDGRlY2xpbmVfZHJhdxgXIAEoCzIPLnBiLkRlY2xpbmVEcmF3SAASKAoNcGxheV9tb3ZlX3VjaRgYIAEoCzIPLnBiLlBsYXlNb3ZlVUNJSAASIQoJbW92ZV9zeW5jGBkgASgLMgwucGIuTW92ZVN5bmNIABIpCg1nYW1lX2ZpbmlzaGVkGBogASgLMhAucGIuR2FtZUZpbmlzaGVkSAASKgoOc2VuZF9nYW1lX2NoYXQYGyABKAsyEC5wYi5TZW5kR2FtZUNoYXRIABIsCg9saXN0X2dhbWVfY2hhdHMYHCABKAsyES5wYi5MaXN0R2FtZUNoYXRzSAASIQoJZ2FtZV9jaGF0GB0gASgLMgwucGIuR2FtZUNoYXRIABImCgpnYW1lX2NoYXRzGB4gASgLMhAucGIuR2FtZUNoYXRMaXN0SAASKAoNc2Vla19ib3RfZ2FtZRgfIAEoCzIPLnBiLlNlZWtCb3RHYW1lSAASJQoLcGxheWVyX2xlZnQYPiABKAsyDi5wYi5QbGF5ZXJMZWZ0SAASLQoPcGxheWVyX3Jlam9pbmVkGD8gASgLMhIucGIuUGxheWVyUmVqb2luZWRIABIpCg1kcmF3X2RlY2xpbmVkGEAgASgLMhAucGIuRHJhd0RlY2xpbmVkSAASIwoKZHJhd19vZmZlchhBIAEoCzINLnBiLkRyYXdPZmZlckgAEh8KCG1vdmVfYWNrGEIgASgLMgsucGIuTW92ZUFja0gAEiEKCWdhbWVfaW5mbxhDIAEoCzIMLnBiLkdhbWVJbmZvSAASGAoEZWNobxhFIAEoCzIILnBiLkVjaG9IAEIHCgVldmVudCIXCgRFY2hvEg8KB21lc3NhZ2UYASABKAkiPAoJSGVhcnRiZWF0Eg8KB3VzZXJfaWQYASABKAkSDwoHY29ubl9pZBgCIAEoCRINCgVndWVzdBgDIAEoCCI7CghMZWF2ZVRhYhIPCgd1c2VyX2lkGAEgASgJEg8KB2Nvbm5faWQYAiABKAkSDQoFZ3Vlc3QYAyABKAgiPAoJTGVhdmVTaXRlEg8KB3VzZXJfaWQYASABKAkSDwoHY29ubl9pZBgCIAEoCRINCgVndWVzdBgDIAEoCCIdCgdMYXRlbmN5EhIKCmxhdGVuY3lfbXMYASABKAUiGgoHUHJvYmxlbRIPCgdtZXNzYWdlGAEgASgJIlQKD0NsaWVudENvbm5lY3RlZBIPCgd1c2VyX2lkGAEgASgJEg8KB2Nvbm5faWQYAiABKAkSDQoFZ3Vlc3QYAyABKAgSEAoIY2hhbm5lbHMYBCADKAkiRQoSQ2xpZW50RGlzY29ubmVjdGVkEg8KB3VzZXJfaWQYASABKAkSDwoHY29ubl9pZBgCIAEoCRINCgVndWVzdBgDIAEoCCJTChJJbml0aWFsaXplQ2hhbm5lbHMSDwoHdXNlcl9pZBgBIAEoCRIPCgdjb25uX2lkGAIgASgJEg0KBWd1ZXN0GAMgASgIEgwKBHBhdGgYBCABKAkiIwoPSW5pdGlhbENoYW5uZWxzEhAKCGNoYW5uZWxzGAEgAygJIk0KCFByZXNlbmNlEg8KB3VzZXJfaWQYASABKAkSEAoIdXNlcm5hbWUYAiABKAkSDQoFZ3Vlc3QYAyABKAgSDwoHY2hhbm5lbBgEIAEoCSIwCg1QcmVzZW5jZVN0YXRlEh8KCXByZXNlbmNlcxgBIAMoCzIMLnBiLlByZXNlbmNlIkgKDFByZXNlbmNlRGlmZhIcCgZqb2luZWQYASADKAsyDC5wYi5QcmVzZW5jZRIaCgRsZWZ0GAIgAygLMgwucGIuUHJlc2VuY2UiXAoGQ2xvY2tzEigKBXdoaXRlGAEgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEigKBWJsYWNrGAIgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uIjoKCFNlZWtHYW1lEi4KEWdhbWVfdGltZV9jb250cm9sGAEgASgLMhMucGIuR2FtZVRpbWVDb250cm9sIhAKDkNhbmNlbFNlZWtHYW1lInIKC1NlZWtCb3RHYW1lEi4KEWdhbWVfdGltZV9jb250cm9sGAEgASgLMhMucGIuR2FtZVRpbWVDb250cm9sEhEKCWJvdF9sZXZlbBgCIAEoBRIgCgRzaWRlGAMgASgOMhIucGIuR2FtZVNpZGVDaG9pY2UidQoKUGxheWVySW5mbxIPCgd1c2VyX2lkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEg0KBWd1ZXN0GAMgASgIEhIKCmF2YXRhcl91cmwYBCABKAkSDgoGcmF0aW5nGAUgASgFEhEKCWJvdF9sZXZlbBgGIAEoBSKnAQoIR2FtZU1vdmUSCwoDZmVuGAEgASgJEhAKA3VjaRgCIAEoCUgAiAEBEhAKA3NhbhgDIAEoCUgBiAEBEhAKA2xhbhgEIAEoCUgCiAEBEjIKCXBsYXllZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIA4gBAUIGCgRfdWNpQgYKBF9zYW5CBgoEX2xhbkIMCgpfcGxheWVkX2F0IhwKCUdhbWVGb3VuZBIPCgdnYW1lX2lkGAEgASgFIskICghHYW1lSW5mbxIPCgdnYW1lX2lkGAEgASgFEiUKDGdhbWVfdmFyaWFudBgCIAEoDjIPLnBiLkdhbWVWYXJpYW50EigKDmdhbWVfdGltZV9raW5kGAMgASgOMhAucGIuR2FtZVRpbWVLaW5kEjAKEmdhbWVfdGltZV9jYXRlZ29yeRgEIAEoDjIULnBiLkdhbWVUaW1lQ2F0ZWdvcnkSIQoKZ2FtZV9zdGF0ZRgFIAEoDjINLnBiLkdhbWVTdGF0ZRIuChFnYW1lX3RpbWVfY29udHJvbBgGIAEoCzITLnBiLkdhbWVUaW1lQ29udHJvbBIYCgVjb2xvchgHIAEoDjIJLnBiLkNvbG9yEgsKA2ZlbhgIIAEoCRILCgNwbHkYCSABKA0SGgoGY2xvY2tzGAogASgLMgoucGIuQ2xvY2tzEg0KBXJhdGVkGAsgASgIEhMKC2xlZ2FsX21vdmVzGAwgAygJEh0KBXdoaXRlGA0gASgLMg4ucGIuUGxheWVySW5mbxIdCgVibGFjaxgOIAEoCzIOLnBiLlBsYXllckluZm8SHAoUcmVjb25uZWN0X3RpbWVvdXRfbXMYDyABKAUSHQoVZmlyc3RfbW92ZV90aW1lb3V0X21zGBAgASgFEiAKCmdhbWVfbW92ZXMYESADKAsyDC5wYi5HYW1lTW92ZRIuCgpzdGFydF90aW1lGBIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfdGltZRgTIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASIwoLZ2FtZV9yZXN1bHQYFCABKA4yDi5wYi5HYW1lUmVzdWx0EjAKEmdhbWVfcmVzdWx0X3N0YXR1cxgVIAEoDjIULnBiLkdhbWVSZXN1bHRTdGF0dXMSDwoHdmVyc2lvbhgWIAEoBRIyCglsYXN0X21vdmUYFyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESQAoTcGVuZGluZ19kcmF3X29mZmVycxgYIAMoCzIjLnBiLkdhbWVJbmZvLlBlbmRpbmdEcmF3T2ZmZXJzRW50cnkSPgoVd2hpdGVfZGlzY29ubmVjdGVkX2F0GBkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBEj4KFWJsYWNrX2Rpc2Nvbm5lY3RlZF9hdBgaIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAogBARpHChZQZW5kaW5nRHJhd09mZmVyc0VudHJ5EgsKA2tleRgBIAEoCRIcCgV2YWx1ZRgCIAEoCzINLnBiLkRyYXdPZmZlcjoCOAFCDAoKX2xhc3RfbW92ZUIYChZfd2hpdGVfZGlzY29ubmVjdGVkX2F0QhgKFl9ibGFja19kaXNjb25uZWN0ZWRfYXQiHAoJQWJvcnRHYW1lEg8KB2dhbWVfaWQYASABKAUiHQoKUmVzaWduR2FtZRIPCgdnYW1lX2lkGAEgASgFIhwKCU9mZmVyRHJhdxIPCgdnYW1lX2lkGAEgASgFIm0KCURyYXdPZmZlchIPCgdnYW1lX2lkGAEgASgFEgsKA3BseRgCIAEoDRISCgpvZmZlcmVkX2J5GAMgASgJEi4KCm9mZmVyZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjQKDERyYXdEZWNsaW5lZBIPCgdnYW1lX2lkGAEgASgFEhMKC2RlY2xpbmVkX2J5GAMgASgJIh4KC0RlY2xpbmVEcmF3Eg8KB2dhbWVfaWQYASABKAUiHQoKQWNjZXB0RHJhdxIPCgdnYW1lX2lkGAEgASgFIiAKDVNlbmRMb2JieUNoYXQSDwoHbWVzc2FnZRgBIAEoCSJWCg5MaXN0TG9iYnlDaGF0cxITCgZjdXJzb3IYASABKAlIAIgBARIWCglwYWdlX3NpemUYAiABKAVIAYgBAUIJCgdfY3Vyc29yQgwKCl9wYWdlX3NpemUigwEKCUxvYmJ5Q2hhdBISCgptZXNzYWdlX2lkGAEgASgJEg8KB21lc3NhZ2UYAiABKAkSIgoEdXNlchgDIAEoCzIULnBiLkNoYXRVc2VyU25hcHNob3QSLQoJcG9zdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJFCg1Mb2JieUNoYXRMaXN0EiIKC2xvYmJ5X2NoYXRzGAEgAygLMg0ucGIuTG9iYnlDaGF0EhAKCGhhc19tb3JlGAIgASgIIjAKDFNlbmRHYW1lQ2hhdBIPCgdnYW1lX2lkGAEgASgFEg8KB21lc3NhZ2UYAiABKAkiZgoNTGlzdEdhbWVDaGF0cxIPCgdnYW1lX2lkGAEgASgFEhMKBmN1cnNvchgCIAEoCUgAiAEBEhYKCXBhZ2Vfc2l6ZRgDIAEoBUgBiAEBQgkKB19jdXJzb3JCDAoKX3BhZ2Vfc2l6ZSIwChBDaGF0VXNlclNuYXBzaG90EgoKAmlkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJIpMBCghHYW1lQ2hhdBIPCgdnYW1lX2lkGAEgASgFEhIKCm1lc3NhZ2VfaWQYAiABKAkSDwoHbWVzc2FnZRgDIAEoCRIiCgR1c2VyGAQgASgLMhQucGIuQ2hhdFVzZXJTbmFwc2hvdBItCglwb3N0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIlMKDEdhbWVDaGF0TGlzdBIPCgdnYW1lX2lkGAEgASgFEiAKCmdhbWVfY2hhdHMYAiADKAsyDC5wYi5HYW1lQ2hhdBIQCghoYXNfbW9yZRgDIAEoCCI4CgtQbGF5TW92ZVVDSRIPCgdnYW1lX2lkGAEgASgFEgsKA3VjaRgCIAEoCRILCgNhY2sYAyABKAUiKwoHTW92ZUFjaxIPCgdnYW1lX2lkGAEgASgFEg8KB3ZlcnNpb24YAiABKAUizQEKCE1vdmVTeW5jEg8KB2dhbWVfaWQYASABKAUSCwoDdWNpGAIgASgJEgsKA3NhbhgDIAEoCRILCgNsYW4YBCABKAkSCwoDZmVuGAUgASgJEgsKA3BseRgGIAEoDRIaCgZjbG9ja3MYByABKAsyCi5wYi5DbG9ja3MSEwoLbGVnYWxfbW92ZXMYCCADKAkSDwoHdmVyc2lvbhgJIAEoBRItCglwbGF5ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIpkBCgxHYW1lRmluaXNoZWQSDwoHZ2FtZV9pZBgBIAEoBRIjCgtnYW1lX3Jlc3VsdBgCIAEoDjIOLnBiLkdhbWVSZXN1bHQSMAoSZ2FtZV9yZXN1bHRfc3RhdHVzGAMgASgOMhQucGIuR2FtZVJlc3VsdFN0YXR1cxIhCgpnYW1lX3N0YXRlGAQgASgOMg0ucGIuR2FtZVN0YXRlIlsKClBsYXllckxlZnQSDwoHZ2FtZV9pZBgBIAEoBRIPCgd1c2VyX2lkGAIgASgJEisKB2xlZnRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wImMKDlBsYXllclJlam9pbmVkEg8KB2dhbWVfaWQYASABKAUSDwoHdXNlcl9pZBgCIAEoCRIvCgtyZWpvaW5lZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAqQAoFQ29sb3ISFQoRQ09MT1JfVU5TUEVDSUZJRUQQABIPCgtDT0xPUl9XSElURRABEg8KC0NPTE9SX0JMQUNLEAIqjwIKC0dhbWVWYXJpYW50EhwKGEdBTUVfVkFSSUFOVF9VTlNQRUNJRklFRBAAEhkKFUdBTUVfVkFSSUFOVF9TVEFOREFSRBABEhcKE0dBTUVfVkFSSUFOVF9BVE9NSUMQAhIbChdHQU1FX1ZBUklBTlRfQ1JBWllIT1VTRRADEhkKFUdBTUVfVkFSSUFOVF9DSEVTUzk2MBAEEiEKHUdBTUVfVkFSSUFOVF9LSU5HX09GX1RIRV9ISUxMEAUSHAoYR0FNRV9WQVJJQU5UX1RIUkVFX0NIRUNLEAYSFgoSR0FNRV9WQVJJQU5UX0hPUkRFEAcSHQoZR0FNRV9WQVJJQU5UX1JBQ0lOR19LSU5HUxAIKowBCgxHYW1lVGltZUtpbmQSHgoaR0FNRV9USU1FX0tJTkRfVU5TUEVDSUZJRUQQABIbChdHQU1FX1RJTUVfS0lORF9SRUFMVElNRRABEiEKHUdBTUVfVElNRV9LSU5EX0NPUlJFU1BPTkRFTkNFEAISHAoYR0FNRV9USU1FX0tJTkRfVU5MSU1JVEVEEAMq1wEKEEdhbWVUaW1lQ2F0ZWdvcnkSIgoeR0FNRV9USU1FX0NBVEVHT1JZX1VOU1BFQ0lGSUVEEAASIgoeR0FNRV9USU1FX0NBVEVHT1JZX0hZUEVSQlVMTEVUEAESHQoZR0FNRV9USU1FX0NBVEVHT1JZX0JVTExFVBACEhwKGEdBTUVfVElNRV9DQVRFR09SWV9CTElUWhADEhwKGEdBTUVfVElNRV9DQVRFR09SWV9SQVBJRBAEEiAKHEdBTUVfVElNRV9DQVRFR09SWV9DTEFTU0lDQUwQBSqSAQoKR2FtZVJlc3VsdBIbChdHQU1FX1JFU1VMVF9VTlNQRUNJRklFRBAAEhkKFUdBTUVfUkVTVUxUX1dISVRFX1dPThABEhkKFUdBTUVfUkVTVUxUX0JMQUNLX1dPThACEhQKEEdBTUVfUkVTVUxUX0RSQVcQAxIbChdHQU1FX1JFU1VMVF9JTlRFUlJVUFRFRBAEKrUGChBHYW1lUmVzdWx0U3RhdHVzEiIKHkdBTUVfUkVTVUxUX1NUQVRVU19VTlNQRUNJRklFRBAAEiAKHEdBTUVfUkVTVUxUX1NUQVRVU19DSEVDS01BVEUQARIsCihHQU1FX1JFU1VMVF9TVEFUVVNfSU5TVUZGSUNJRU5UX01BVEVSSUFMEAISKwonR0FNRV9SRVNVTFRfU1RBVFVTX1RIUkVFRk9MRF9SRVBFVElUSU9OEAMSKgomR0FNRV9SRVNVTFRfU1RBVFVTX0ZJVkVGT0xEX1JFUEVUSVRJT04QBBImCiJHQU1FX1JFU1VMVF9TVEFUVVNfRklGVFlfTU9WRV9SVUxFEAUSLAooR0FNRV9SRVNVTFRfU1RBVFVTX1NFVkVOVFlGSVZFX01PVkVfUlVMRRAGEiAKHEdBTUVfUkVTVUxUX1NUQVRVU19TVEFMRU1BVEUQBxIiCh5HQU1FX1JFU1VMVF9TVEFUVVNfUkVTSUdOQVRJT04QCBIiCh5HQU1FX1JFU1VMVF9TVEFUVVNfRFJBV19BR1JFRUQQCRIeChpHQU1FX1JFU1VMVF9TVEFUVVNfRkxBR0dFRBAKEiMKH0dBTUVfUkVTVUxUX1NUQVRVU19BREpVRElDQVRJT04QCxIgChxHQU1FX1JFU1VMVF9TVEFUVVNfVElNRURfT1VUEAwSHgoaR0FNRV9SRVNVTFRfU1RBVFVTX0FCT1JURUQQDRIiCh5HQU1FX1JFU1VMVF9TVEFUVVNfSU5URVJSVVBURUQQDhIkCiBHQU1FX1JFU1VMVF9TVEFUVVNfS0lOR19FWFBMT0RFRBAPEicKI0dBTUVfUkVTVUxUX1NUQVRVU19LSU5HX09GX1RIRV9ISUxMEBASIgoeR0FNRV9SRVNVTFRfU1RBVFVTX1RIUkVFX0NIRUNLEBESKgomR0FNRV9SRVNVTFRfU1RBVFVTX0FMTF9QSUVDRVNfQ0FQVFVSRUQQEhIkCiBHQU1FX1JFU1VMVF9TVEFUVVNfUkFDRV9GSU5JU0hFRBATEiQKIEdBTUVfUkVTVUxUX1NUQVRVU19ERUFEX1BPU0lUSU9OEBQqcwoJR2FtZVN0YXRlEhoKFkdBTUVfU1RBVEVfVU5TUEVDSUZJRUQQABIVChFHQU1FX1NUQVRFX0FDVElWRRABEhcKE0dBTUVfU1RBVEVfRklOSVNIRUQQAhIaChZHQU1FX1NUQVRFX0lOVEVSUlVQVEVEEAMqhwEKDkdhbWVTaWRlQ2hvaWNlEiAKHEdBTUVfU0lERV9DSE9JQ0VfVU5TUEVDSUZJRUQQABIbChdHQU1FX1NJREVfQ0hPSUNFX1JBTkRPTRABEhoKFkdBTUVfU0lERV9DSE9JQ0VfV0hJVEUQAhIaChZHQU1FX1NJREVfQ0hPSUNFX0JMQUNLEANCHlocZ2l0aHViLmNvbS9kYW5rb2JnL2p1aWNlci9wYmIGcHJvdG8z", [file_google_protobuf_duration, file_google_protobuf_timestamp]);
 
 /**
  * GameTimeControl is game time control
@@ -227,6 +227,12 @@ export type Message = Message$1<"pb.Message"> & {
      */
     value: GameChatList;
     case: "gameChats";
+  } | {
+    /**
+     * @generated from field: pb.SeekBotGame seek_bot_game = 31;
+     */
+    value: SeekBotGame;
+    case: "seekBotGame";
   } | {
     /**
      * @generated from field: pb.PlayerLeft player_left = 62;
@@ -674,6 +680,35 @@ export type CancelSeekGame = Message$1<"pb.CancelSeekGame"> & {
 export const CancelSeekGameSchema: GenMessage<CancelSeekGame> = /*@__PURE__*/
   messageDesc(file_juicer, 17);
 
+/**
+ * SeekBotGame starts the unrated game against the built-in bot
+ *
+ * @generated from message pb.SeekBotGame
+ */
+export type SeekBotGame = Message$1<"pb.SeekBotGame"> & {
+  /**
+   * @generated from field: pb.GameTimeControl game_time_control = 1;
+   */
+  gameTimeControl?: GameTimeControl | undefined;
+
+  /**
+   * @generated from field: int32 bot_level = 2;
+   */
+  botLevel: number;
+
+  /**
+   * @generated from field: pb.GameSideChoice side = 3;
+   */
+  side: GameSideChoice;
+};
+
+/**
+ * Describes the message pb.SeekBotGame.
+ * Use `create(SeekBotGameSchema)` to create a new message.
+ */
+export const SeekBotGameSchema: GenMessage<SeekBotGame> = /*@__PURE__*/
+  messageDesc(file_juicer, 18);
+
 /**
  * PlayerInfo is info about player
  *
@@ -704,6 +739,11 @@ export type PlayerInfo = Message$1<"pb.PlayerInfo"> & {
    * @generated from field: int32 rating = 5;
    */
   rating: number;
+
+  /**
+   * @generated from field: int32 bot_level = 6;
+   */
+  botLevel: number;
 };
 
 /**
@@ -711,7 +751,7 @@ export type PlayerInfo = Message$1<"pb.PlayerInfo"> & {
  * Use `create(PlayerInfoSchema)` to create a new message.
  */
 export const PlayerInfoSchema: GenMessage<PlayerInfo> = /*@__PURE__*/
-  messageDesc(file_juicer, 18);
+  messageDesc(file_juicer, 19);
 
 /**
  * GameMove is the game move
@@ -750,7 +790,7 @@ export type GameMove = Message$1<"pb.GameMove"> & {
  * Use `create(GameMoveSchema)` to create a new message.
  */
 export const GameMoveSchema: GenMessage<GameMove> = /*@__PURE__*/
-  messageDesc(file_juicer, 19);
+  messageDesc(file_juicer, 20);
 
 /**
  * GameFound signals that the game was found
@@ -769,7 +809,7 @@ export type GameFound = Message$1<"pb.GameFound"> & {
  * Use `create(GameFoundSchema)` to create a new message.
  */
 export const GameFoundSchema: GenMessage<GameFound> = /*@__PURE__*/
-  messageDesc(file_juicer, 20);
+  messageDesc(file_juicer, 21);
 
 /**
  * GameInfo holds game info
@@ -913,7 +953,7 @@ export type GameInfo = Message$1<"pb.GameInfo"> & {
  * Use `create(GameInfoSchema)` to create a new message.
  */
 export const GameInfoSchema: GenMessage<GameInfo> = /*@__PURE__*/
-  messageDesc(file_juicer, 21);
+  messageDesc(file_juicer, 22);
 
 /**
  * AbortGame is game abort message
@@ -932,7 +972,7 @@ export type AbortGame = Message$1<"pb.AbortGame"> & {
  * Use `create(AbortGameSchema)` to create a new message.
  */
 export const AbortGameSchema: GenMessage<AbortGame> = /*@__PURE__*/
-  messageDesc(file_juicer, 22);
+  messageDesc(file_juicer, 23);
 
 /**
  * ResignGame is game resign message
@@ -951,7 +991,7 @@ export type ResignGame = Message$1<"pb.ResignGame"> & {
  * Use `create(ResignGameSchema)` to create a new message.
  */
 export const ResignGameSchema: GenMessage<ResignGame> = /*@__PURE__*/
-  messageDesc(file_juicer, 23);
+  messageDesc(file_juicer, 24);
 
 /**
  * OfferDraw is game draw offer message
@@ -970,7 +1010,7 @@ export type OfferDraw = Message$1<"pb.OfferDraw"> & {
  * Use `create(OfferDrawSchema)` to create a new message.
  */
 export const OfferDrawSchema: GenMessage<OfferDraw> = /*@__PURE__*/
-  messageDesc(file_juicer, 24);
+  messageDesc(file_juicer, 25);
 
 /**
  * DrawOffer is game draw offer response message
@@ -1004,7 +1044,7 @@ export type DrawOffer = Message$1<"pb.DrawOffer"> & {
  * Use `create(DrawOfferSchema)` to create a new message.
  */
 export const DrawOfferSchema: GenMessage<DrawOffer> = /*@__PURE__*/
-  messageDesc(file_juicer, 25);
+  messageDesc(file_juicer, 26);
 
 /**
  * DrawDeclined is draw offer declined response message
@@ -1028,7 +1068,7 @@ export type DrawDeclined = Message$1<"pb.DrawDeclined"> & {
  * Use `create(DrawDeclinedSchema)` to create a new message.
  */
 export const DrawDeclinedSchema: GenMessage<DrawDeclined> = /*@__PURE__*/
-  messageDesc(file_juicer, 26);
+  messageDesc(file_juicer, 27);
 
 /**
  * DeclineDraw is game draw decline message
@@ -1047,7 +1087,7 @@ export type DeclineDraw = Message$1<"pb.DeclineDraw"> & {
  * Use `create(DeclineDrawSchema)` to create a new message.
  */
 export const DeclineDrawSchema: GenMessage<DeclineDraw> = /*@__PURE__*/
-  messageDesc(file_juicer, 27);
+  messageDesc(file_juicer, 28);
 
 /**
  * AcceptDraw is game accept draw message
@@ -1066,7 +1106,7 @@ export type AcceptDraw = Message$1<"pb.AcceptDraw"> & {
  * Use `create(AcceptDrawSchema)` to create a new message.
  */
 export const AcceptDrawSchema: GenMessage<AcceptDraw> = /*@__PURE__*/
-  messageDesc(file_juicer, 28);
+  messageDesc(file_juicer, 29);
 
 /**
  * SendLobbyChat is the msg sent to lobby
@@ -1085,7 +1125,7 @@ export type SendLobbyChat = Message$1<"pb.SendLobbyChat"> & {
  * Use `create(SendLobbyChatSchema)` to create a new message.
  */
 export const SendLobbyChatSchema: GenMessage<SendLobbyChat> = /*@__PURE__*/
-  messageDesc(file_juicer, 29);
+  messageDesc(file_juicer, 30);
 
 /**
  * ListLobbyChats fetches the lobby chat messages
@@ -1109,7 +1149,7 @@ export type ListLobbyChats = Message$1<"pb.ListLobbyChats"> & {
  * Use `create(ListLobbyChatsSchema)` to create a new message.
  */
 export const ListLobbyChatsSchema: GenMessage<ListLobbyChats> = /*@__PURE__*/
-  messageDesc(file_juicer, 30);
+  messageDesc(file_juicer, 31);
 
 /**
  * LobbyChat is the lobby chat msg received
@@ -1143,7 +1183,7 @@ export type LobbyChat = Message$1<"pb.LobbyChat"> & {
  * Use `create(LobbyChatSchema)` to create a new message.
  */
 export const LobbyChatSchema: GenMessage<LobbyChat> = /*@__PURE__*/
-  messageDesc(file_juicer, 31);
+  messageDesc(file_juicer, 32);
 
 /**
  * LobbyChatList is the list of lobby chat messages
@@ -1167,7 +1207,7 @@ export type LobbyChatList = Message$1<"pb.LobbyChatList"> & {
  * Use `create(LobbyChatListSchema)` to create a new message.
  */
 export const LobbyChatListSchema: GenMessage<LobbyChatList> = /*@__PURE__*/
-  messageDesc(file_juicer, 32);
+  messageDesc(file_juicer, 33);
 
 /**
  * SendGameChat is the msg sent to game
@@ -1191,7 +1231,7 @@ export type SendGameChat = Message$1<"pb.SendGameChat"> & {
  * Use `create(SendGameChatSchema)` to create a new message.
  */
 export const SendGameChatSchema: GenMessage<SendGameChat> = /*@__PURE__*/
-  messageDesc(file_juicer, 33);
+  messageDesc(file_juicer, 34);
 
 /**
  * ListGameChats fetches the game chat messages
@@ -1220,7 +1260,7 @@ export type ListGameChats = Message$1<"pb.ListGameChats"> & {
  * Use `create(ListGameChatsSchema)` to create a new message.
  */
 export const ListGameChatsSchema: GenMessage<ListGameChats> = /*@__PURE__*/
-  messageDesc(file_juicer, 34);
+  messageDesc(file_juicer, 35);
 
 /**
  * ChatUserSnapshot is userinfo for chat msgs
@@ -1244,7 +1284,7 @@ export type ChatUserSnapshot = Message$1<"pb.ChatUserSnapshot"> & {
  * Use `create(ChatUserSnapshotSchema)` to create a new message.
  */
 export const ChatUserSnapshotSchema: GenMessage<ChatUserSnapshot> = /*@__PURE__*/
-  messageDesc(file_juicer, 35);
+  messageDesc(file_juicer, 36);
 
 /**
  * GameChat is the game chat msg received
@@ -1283,7 +1323,7 @@ export type GameChat = Message$1<"pb.GameChat"> & {
  * Use `create(GameChatSchema)` to create a new message.
  */
 export const GameChatSchema: GenMessage<GameChat> = /*@__PURE__*/
-  messageDesc(file_juicer, 36);
+  messageDesc(file_juicer, 37);
 
 /**
  * GameChatList is the list of game chat messages
@@ -1312,7 +1352,7 @@ export type GameChatList = Message$1<"pb.GameChatList"> & {
  * Use `create(GameChatListSchema)` to create a new message.
  */
 export const GameChatListSchema: GenMessage<GameChatList> = /*@__PURE__*/
-  messageDesc(file_juicer, 37);
+  messageDesc(file_juicer, 38);
 
 /**
  * PlayMoveUCI plays a game move
@@ -1341,7 +1381,7 @@ export type PlayMoveUCI = Message$1<"pb.PlayMoveUCI"> & {
  * Use `create(PlayMoveUCISchema)` to create a new message.
  */
 export const PlayMoveUCISchema: GenMessage<PlayMoveUCI> = /*@__PURE__*/
-  messageDesc(file_juicer, 38);
+  messageDesc(file_juicer, 39);
 
 /**
  * MoveAck is move acknowledge msg
@@ -1365,7 +1405,7 @@ export type MoveAck = Message$1<"pb.MoveAck"> & {
  * Use `create(MoveAckSchema)` to create a new message.
  */
 export const MoveAckSchema: GenMessage<MoveAck> = /*@__PURE__*/
-  messageDesc(file_juicer, 39);
+  messageDesc(file_juicer, 40);
 
 /**
  * MoveSync sends the move sync info
@@ -1429,7 +1469,7 @@ export type MoveSync = Message$1<"pb.MoveSync"> & {
  * Use `create(MoveSyncSchema)` to create a new message.
  */
 export const MoveSyncSchema: GenMessage<MoveSync> = /*@__PURE__*/
-  messageDesc(file_juicer, 40);
+  messageDesc(file_juicer, 41);
 
 /**
  * GameFinished signals the game is over
@@ -1463,7 +1503,7 @@ export type GameFinished = Message$1<"pb.GameFinished"> & {
  * Use `create(GameFinishedSchema)` to create a new message.
  */
 export const GameFinishedSchema: GenMessage<GameFinished> = /*@__PURE__*/
-  messageDesc(file_juicer, 41);
+  messageDesc(file_juicer, 42);
 
 /**
  * PlayerLeft signals that player disconnected from the game
@@ -1492,7 +1532,7 @@ export type PlayerLeft = Message$1<"pb.PlayerLeft"> & {
  * Use `create(PlayerLeftSchema)` to create a new message.
  */
 export const PlayerLeftSchema: GenMessage<PlayerLeft> = /*@__PURE__*/
-  messageDesc(file_juicer, 42);
+  messageDesc(file_juicer, 43);
 
 /**
  * PlayerRejoined signals that player reconnected to the game
@@ -1521,7 +1561,7 @@ export type PlayerRejoined = Message$1<"pb.PlayerRejoined"> & {
  * Use `create(PlayerRejoinedSchema)` to create a new message.
  */
 export const PlayerRejoinedSchema: GenMessage<PlayerRejoined> = /*@__PURE__*/
-  messageDesc(file_juicer, 43);
+  messageDesc(file_juicer, 44);
 
 /**
  * Color is the player color
@@ -1803,6 +1843,36 @@ export enum GameResultStatus {
    * @generated from enum value: GAME_RESULT_STATUS_INTERRUPTED = 14;
    */
   INTERRUPTED = 14,
+
+  /**
+   * @generated from enum value: GAME_RESULT_STATUS_KING_EXPLODED = 15;
+   */
+  KING_EXPLODED = 15,
+
+  /**
+   * @generated from enum value: GAME_RESULT_STATUS_KING_OF_THE_HILL = 16;
+   */
+  KING_OF_THE_HILL = 16,
+
+  /**
+   * @generated from enum value: GAME_RESULT_STATUS_THREE_CHECK = 17;
+   */
+  THREE_CHECK = 17,
+
+  /**
+   * @generated from enum value: GAME_RESULT_STATUS_ALL_PIECES_CAPTURED = 18;
+   */
+  ALL_PIECES_CAPTURED = 18,
+
+  /**
+   * @generated from enum value: GAME_RESULT_STATUS_RACE_FINISHED = 19;
+   */
+  RACE_FINISHED = 19,
+
+  /**
+   * @generated from enum value: GAME_RESULT_STATUS_DEAD_POSITION = 20;
+   */
+  DEAD_POSITION = 20,
 }
 
 /**